@@ -46,6 +46,7 @@ const (
 	CacheKeyAssets      = "assets"
 	CacheKeyModules     = "modules"
 	CacheKeyGetResource = "getresource"
+	CacheKeyTemplates   = "templates"
 )
 
 type Configs map[string]FileCacheConfig
@@ -74,6 +75,12 @@ var defaultCacheConfigs = Configs{
 		MaxAge: -1, // Never expire
 		Dir:    cacheDirProject,
 	},
+	CacheKeyTemplates: {
+		// The cache keys include the Hugo version, so let the entries
+		// expire to not keep the templates of old versions forever.
+		MaxAge: 30 * 24 * time.Hour,
+		Dir:    cacheDirProject,
+	},
 }
 
 type FileCacheConfig struct {
@@ -125,6 +132,11 @@ func (f Caches) GetResourceCache() *Cache {
 	return f[CacheKeyGetResource]
 }
 
+// TemplatesCache gets the file cache for parsed templates.
+func (f Caches) TemplatesCache() *Cache {
+	return f[CacheKeyTemplates]
+}
+
 func DecodeConfig(fs afero.Fs, bcfg config.BaseConfig, m map[string]any) (Configs, error) {
 	c := make(Configs)
 	valid := make(map[string]bool)
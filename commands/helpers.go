@@ -83,12 +83,14 @@ func flagsToCfgWithAdditionalConfigBase(cd *simplecobra.Commandeer, cfg config.P
 
 	// Flags that we for some reason don't want to expose in the site config.
 	internalKeySet := map[string]bool{
-		"quiet":          true,
-		"verbose":        true,
-		"watch":          true,
-		"liveReloadPort": true,
-		"renderToMemory": true,
-		"clock":          true,
+		"quiet":            true,
+		"verbose":          true,
+		"watch":            true,
+		"liveReloadPort":   true,
+		"renderToMemory":   true,
+		"dryRun":           true,
+		"printDryRunFiles": true,
+		"clock":            true,
 	}
 
 	cmd := cd.CobraCommand
@@ -97,13 +99,17 @@ func flagsToCfgWithAdditionalConfigBase(cd *simplecobra.Commandeer, cfg config.P
 	flags.VisitAll(func(f *pflag.Flag) {
 		if f.Changed {
 			targetKey := f.Name
-			if internalKeySet[targetKey] {
-				targetKey = "internal." + targetKey
-			} else if mapped, ok := keyMap[targetKey]; ok {
+			if mapped, ok := keyMap[targetKey]; ok {
 				targetKey = mapped
 			}
-			setValueFromFlag(flags, f.Name, cfg, targetKey, false)
+			if internalKeySet[f.Name] {
+				setValueFromFlag(flags, f.Name, cfg, "internal."+targetKey, false)
+			} else {
+				setValueFromFlag(flags, f.Name, cfg, targetKey, false)
+			}
 			if additionalConfigBase != "" {
+				// Flags internal to the site config may still be part of
+				// a command's config, e.g. dryRun for deploy.
 				setValueFromFlag(flags, f.Name, cfg, additionalConfigBase+"."+targetKey, true)
 			}
 		}
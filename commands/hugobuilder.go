@@ -22,6 +22,7 @@ import (
 	"runtime"
 	"runtime/pprof"
 	"runtime/trace"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -29,6 +30,7 @@ import (
 
 	"github.com/bep/logg"
 	"github.com/bep/simplecobra"
+	"github.com/dustin/go-humanize"
 	"github.com/fsnotify/fsnotify"
 	"github.com/gohugoio/hugo/common/herrors"
 	"github.com/gohugoio/hugo/common/htime"
@@ -46,6 +48,7 @@ import (
 	"github.com/gohugoio/hugo/livereload"
 	"github.com/gohugoio/hugo/resources/page"
 	"github.com/gohugoio/hugo/watcher"
+	"github.com/spf13/afero"
 	"github.com/spf13/fsync"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
@@ -141,7 +144,26 @@ func (c *hugoBuilder) getDirList() ([]string, error) {
 		return nil, err
 	}
 
-	return helpers.UniqueStringsSorted(h.PathSpec.BaseFs.WatchFilenames()), nil
+	dirs := h.PathSpec.BaseFs.WatchFilenames()
+
+	// Add any additional directories configured in server.watchDirs,
+	// including all of their subdirectories.
+	for _, dir := range h.Configs.Base.Server.WatchDirs {
+		err := afero.Walk(h.Fs.Source, dir, func(path string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if fi.IsDir() {
+				dirs = append(dirs, path)
+			}
+			return nil
+		})
+		if err != nil {
+			c.r.logger.Warnf("Failed to watch directory %q: %s", dir, err)
+		}
+	}
+
+	return helpers.UniqueStringsSorted(dirs), nil
 }
 
 func (c *hugoBuilder) initCPUProfile() (func(), error) {
@@ -388,6 +410,58 @@ func (c *hugoBuilder) build() error {
 		c.r.Println()
 	}
 
+	if c.r.dryRun {
+		return c.printDryRunReport()
+	}
+
+	return nil
+}
+
+// printDryRunReport prints a summary of the files published to memory
+// in a dry run and, if enabled, each file with its size.
+func (c *hugoBuilder) printDryRunReport() error {
+	type publishedFile struct {
+		name string
+		size int64
+	}
+
+	var (
+		publishDir string
+		fs         afero.Fs
+		files      []publishedFile
+		total      int64
+	)
+
+	c.withConf(func(conf *commonConfig) {
+		publishDir = conf.configs.Base.PublishDir
+		fs = conf.fs.PublishDir
+	})
+
+	err := afero.Walk(fs, "", func(filename string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		files = append(files, publishedFile{name: filepath.ToSlash(strings.TrimPrefix(filename, helpers.FilePathSeparator)), size: info.Size()})
+		total += info.Size()
+		return nil
+	})
+	if err != nil && !herrors.IsNotExist(err) {
+		return err
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].name < files[j].name
+	})
+
+	if c.r.printDryRunFiles {
+		for _, f := range files {
+			c.r.Printf("%10s  %s\n", humanize.Bytes(uint64(f.size)), f.name)
+		}
+		c.r.Println()
+	}
+
+	c.r.Printf("Dry run: %d file(s), totaling %s, would be written to %s\n", len(files), humanize.Bytes(uint64(total)), publishDir)
+
 	return nil
 }
 
@@ -415,7 +489,12 @@ func (c *hugoBuilder) copyStaticTo(sourceFs *filesystems.SourceFilesystem) (uint
 		publishDir = filepath.Join(publishDir, sourceFs.PublishFolder)
 	}
 
-	fs := &countingStatFs{Fs: sourceFs.Fs}
+	h, err := c.hugo()
+	if err != nil {
+		return 0, err
+	}
+
+	fs := &countingStatFs{Fs: &skipFilesFs{Fs: sourceFs.Fs, skip: h.IsStaticPipelineFile}}
 
 	syncer := fsync.NewSyncer()
 	c.withConf(func(conf *commonConfig) {
@@ -423,7 +502,9 @@ func (c *hugoBuilder) copyStaticTo(sourceFs *filesystems.SourceFilesystem) (uint
 		syncer.NoChmod = conf.configs.Base.NoChmod
 		syncer.ChmodFilter = chmodFilter
 
-		syncer.DestFs = conf.fs.PublishDirStatic
+		// Note that the HugoSites wraps the publish filesystems, e.g. to set
+		// the configured file permissions.
+		syncer.DestFs = h.Fs.PublishDirStatic
 		// Now that we are using a unionFs for the static directories
 		// We can effectively clean the publishDir on initial sync
 		syncer.Delete = conf.configs.Base.CleanDestinationDir
@@ -442,7 +523,7 @@ func (c *hugoBuilder) copyStaticTo(sourceFs *filesystems.SourceFilesystem) (uint
 
 	// because we are using a baseFs (to get the union right).
 	// set sync src to root
-	err := syncer.Sync(publishDir, helpers.FilePathSeparator)
+	err = syncer.Sync(publishDir, helpers.FilePathSeparator)
 	if err != nil {
 		return 0, err
 	}
@@ -451,7 +532,9 @@ func (c *hugoBuilder) copyStaticTo(sourceFs *filesystems.SourceFilesystem) (uint
 	// Sync runs Stat 2 times for every source file.
 	numFiles := fs.statCounter / 2
 
-	return numFiles, err
+	numPipelineFiles, err := h.PublishStaticPipeline(sourceFs, syncer.DestFs)
+
+	return numFiles + numPipelineFiles, err
 }
 
 func (c *hugoBuilder) doWithPublishDirs(f func(sourceFs *filesystems.SourceFilesystem) (uint64, error)) (map[string]uint64, error) {
@@ -930,7 +1013,7 @@ func (c *hugoBuilder) hugoTry() *hugolib.HugoSites {
 
 func (c *hugoBuilder) loadConfig(cd *simplecobra.Commandeer, running bool) error {
 	cfg := config.New()
-	cfg.Set("renderToDisk", (c.s == nil && !c.r.renderToMemory) || (c.s != nil && c.s.renderToDisk))
+	cfg.Set("renderToDisk", (c.s == nil && !c.r.renderToMemory && !c.r.dryRun) || (c.s != nil && c.s.renderToDisk))
 	watch := c.r.buildWatch || (c.s != nil && c.s.serverWatch)
 	if c.r.environment == "" {
 		// We need to set the environment as early as possible because we need it to load the correct config.
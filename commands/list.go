@@ -16,35 +16,29 @@ package commands
 import (
 	"context"
 	"encoding/csv"
+	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
-	"strconv"
+	"reflect"
 	"strings"
 	"time"
 
 	"github.com/bep/simplecobra"
+	"github.com/gohugoio/hugo/common/hreflect"
+	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/hugolib"
 	"github.com/gohugoio/hugo/resources/page"
 	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/spf13/cast"
 )
 
 // newListCommand creates a new list command and its subcommands.
 func newListCommand() *listCommand {
-	createRecord := func(workingDir string, p page.Page) []string {
-		return []string{
-			filepath.ToSlash(strings.TrimPrefix(p.File().Filename(), workingDir+string(os.PathSeparator))),
-			p.Slug(),
-			p.Title(),
-			p.Date().Format(time.RFC3339),
-			p.ExpiryDate().Format(time.RFC3339),
-			p.PublishDate().Format(time.RFC3339),
-			strconv.FormatBool(p.Draft()),
-			p.Permalink(),
-		}
-	}
+	c := &listCommand{}
 
-	list := func(cd *simplecobra.Commandeer, r *rootCommand, shouldInclude func(page.Page) bool, opts ...any) error {
+	list := func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, shouldInclude func(page.Page) bool, opts ...any) error {
 		bcfg := hugolib.BuildCfg{SkipRender: true}
 		cfg := config.New()
 		for i := 0; i < len(opts); i += 2 {
@@ -55,105 +49,216 @@ func newListCommand() *listCommand {
 			return err
 		}
 
-		writer := csv.NewWriter(r.Out)
-		defer writer.Flush()
-
-		writer.Write([]string{
-			"path",
-			"slug",
-			"title",
-			"date",
-			"expiryDate",
-			"publishDate",
-			"draft",
-			"permalink",
-		})
+		fields := c.fields
+		if len(fields) == 0 {
+			fields = defaultListFields
+		}
 
+		var pages page.Pages
 		for _, p := range h.Pages() {
 			if shouldInclude(p) {
-				record := createRecord(h.Conf.BaseConfig().WorkingDir, p)
+				pages = append(pages, p)
+			}
+		}
+
+		workingDir := h.Conf.BaseConfig().WorkingDir
+
+		switch strings.ToLower(c.format) {
+		case "", "csv":
+			writer := csv.NewWriter(r.Out)
+			defer writer.Flush()
+
+			if err := writer.Write(fields); err != nil {
+				return err
+			}
+
+			for _, p := range pages {
+				record := make([]string, len(fields))
+				for i, field := range fields {
+					v, err := listPageField(ctx, workingDir, p, field)
+					if err != nil {
+						return err
+					}
+					record[i], err = listFieldToString(v)
+					if err != nil {
+						return fmt.Errorf("failed to convert field %q to string: %w", field, err)
+					}
+				}
 				if err := writer.Write(record); err != nil {
 					return err
 				}
-				if err != nil {
-					return err
+			}
+		case "json":
+			records := make([]map[string]any, 0, len(pages))
+			for _, p := range pages {
+				record := make(map[string]any, len(fields))
+				for _, field := range fields {
+					v, err := listPageField(ctx, workingDir, p, field)
+					if err != nil {
+						return err
+					}
+					if t, ok := v.(time.Time); ok {
+						v = t.Format(time.RFC3339)
+					}
+					record[field] = v
 				}
+				records = append(records, record)
 			}
+
+			enc := json.NewEncoder(r.Out)
+			enc.SetEscapeHTML(false)
+			enc.SetIndent("", "  ")
+			return enc.Encode(records)
+		default:
+			return fmt.Errorf("unsupported format %q, must be one of csv or json", c.format)
 		}
 
 		return nil
 	}
 
-	return &listCommand{
-		commands: []simplecobra.Commander{
-			&simpleCommand{
-				name:  "drafts",
-				short: "List all drafts",
-				long:  `List all of the drafts in your content directory.`,
-				run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
-					shouldInclude := func(p page.Page) bool {
-						if !p.Draft() || p.File() == nil {
-							return false
-						}
-						return true
+	c.commands = []simplecobra.Commander{
+		&simpleCommand{
+			name:  "drafts",
+			short: "List all drafts",
+			long:  `List all of the drafts in your content directory.`,
+			run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
+				shouldInclude := func(p page.Page) bool {
+					if !p.Draft() || p.File() == nil {
+						return false
 					}
-					return list(cd, r, shouldInclude,
-						"buildDrafts", true,
-						"buildFuture", true,
-						"buildExpired", true,
-					)
-				},
+					return true
+				}
+				return list(ctx, cd, r, shouldInclude,
+					"buildDrafts", true,
+					"buildFuture", true,
+					"buildExpired", true,
+				)
 			},
-			&simpleCommand{
-				name:  "future",
-				short: "List all posts dated in the future",
-				long:  `List all of the posts in your content directory which will be posted in the future.`,
-				run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
-					shouldInclude := func(p page.Page) bool {
-						if !resource.IsFuture(p) || p.File() == nil {
-							return false
-						}
-						return true
+		},
+		&simpleCommand{
+			name:  "future",
+			short: "List all posts dated in the future",
+			long:  `List all of the posts in your content directory which will be posted in the future.`,
+			run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
+				shouldInclude := func(p page.Page) bool {
+					if !resource.IsFuture(p) || p.File() == nil {
+						return false
 					}
-					return list(cd, r, shouldInclude,
-						"buildFuture", true,
-						"buildDrafts", true,
-					)
-				},
+					return true
+				}
+				return list(ctx, cd, r, shouldInclude,
+					"buildFuture", true,
+					"buildDrafts", true,
+				)
 			},
-			&simpleCommand{
-				name:  "expired",
-				short: "List all posts already expired",
-				long:  `List all of the posts in your content directory which has already expired.`,
-				run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
-					shouldInclude := func(p page.Page) bool {
-						if !resource.IsExpired(p) || p.File() == nil {
-							return false
-						}
-						return true
+		},
+		&simpleCommand{
+			name:  "expired",
+			short: "List all posts already expired",
+			long:  `List all of the posts in your content directory which has already expired.`,
+			run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
+				shouldInclude := func(p page.Page) bool {
+					if !resource.IsExpired(p) || p.File() == nil {
+						return false
 					}
-					return list(cd, r, shouldInclude,
-						"buildExpired", true,
-						"buildDrafts", true,
-					)
-				},
+					return true
+				}
+				return list(ctx, cd, r, shouldInclude,
+					"buildExpired", true,
+					"buildDrafts", true,
+				)
 			},
-			&simpleCommand{
-				name:  "all",
-				short: "List all posts",
-				long:  `List all of the posts in your content directory, include drafts, future and expired pages.`,
-				run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
-					shouldInclude := func(p page.Page) bool {
-						return p.File() != nil
-					}
-					return list(cd, r, shouldInclude, "buildDrafts", true, "buildFuture", true, "buildExpired", true)
-				},
+		},
+		&simpleCommand{
+			name:  "all",
+			short: "List all posts",
+			long:  `List all of the posts in your content directory, include drafts, future and expired pages.`,
+			run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
+				shouldInclude := func(p page.Page) bool {
+					return p.File() != nil
+				}
+				return list(ctx, cd, r, shouldInclude, "buildDrafts", true, "buildFuture", true, "buildExpired", true)
 			},
 		},
 	}
+
+	return c
+}
+
+// defaultListFields are the fields listed when no --fields are provided.
+var defaultListFields = []string{
+	"path",
+	"slug",
+	"title",
+	"date",
+	"expiryDate",
+	"publishDate",
+	"draft",
+	"permalink",
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// listPageField resolves field from p. The field "path" is the content
+// filename relative to workingDir, fields prefixed with "params." are looked up
+// in the page params (nested keys separated by a dot), and any other field is
+// the result of the page method with that name (case insensitive), e.g.
+// "wordCount" or "lastmod".
+func listPageField(ctx context.Context, workingDir string, p page.Page, field string) (any, error) {
+	lower := strings.ToLower(field)
+
+	if lower == "path" {
+		return filepath.ToSlash(strings.TrimPrefix(p.File().Filename(), workingDir+string(os.PathSeparator))), nil
+	}
+
+	if strings.HasPrefix(lower, "params.") {
+		return maps.GetNestedParam(strings.TrimPrefix(lower, "params."), ".", p.Params())
+	}
+
+	v := reflect.ValueOf(p)
+	tp := v.Type()
+	for i := 0; i < tp.NumMethod(); i++ {
+		m := tp.Method(i)
+		if !strings.EqualFold(m.Name, field) {
+			continue
+		}
+		// The first argument is the receiver.
+		var args []reflect.Value
+		if m.Type.NumIn() == 2 && hreflect.IsContextType(m.Type.In(1)) {
+			args = append(args, reflect.ValueOf(ctx))
+		} else if m.Type.NumIn() != 1 {
+			return nil, fmt.Errorf("field %q: method %s requires arguments", field, m.Name)
+		}
+		if m.Type.NumOut() == 0 || m.Type.NumOut() > 2 || (m.Type.NumOut() == 2 && m.Type.Out(1) != errorType) {
+			return nil, fmt.Errorf("field %q: method %s has unsupported return values", field, m.Name)
+		}
+		out := v.Method(i).Call(args)
+		if len(out) == 2 && !out[1].IsNil() {
+			return nil, fmt.Errorf("field %q: %w", field, out[1].Interface().(error))
+		}
+		return out[0].Interface(), nil
+	}
+
+	return nil, fmt.Errorf("field %q: no such page method or param", field)
+}
+
+// listFieldToString converts v to its CSV representation.
+func listFieldToString(v any) (string, error) {
+	switch vv := v.(type) {
+	case nil:
+		return "", nil
+	case time.Time:
+		return vv.Format(time.RFC3339), nil
+	case []string:
+		return strings.Join(vv, ","), nil
+	}
+	return cast.ToStringE(v)
 }
 
 type listCommand struct {
+	format string
+	fields []string
+
 	commands []simplecobra.Commander
 }
 
@@ -175,7 +280,14 @@ func (c *listCommand) Init(cd *simplecobra.Commandeer) error {
 	cmd.Short = "Listing out various types of content"
 	cmd.Long = `Listing out various types of content.
 
-List requires a subcommand, e.g. hugo list drafts`
+List requires a subcommand, e.g. hugo list drafts
+
+Use --fields to select the page fields to list, e.g.:
+
+  hugo list all --format json --fields path,title,draft,lastmod,wordCount,params.author`
+
+	cmd.PersistentFlags().StringVar(&c.format, "format", "csv", "output format (csv|json)")
+	cmd.PersistentFlags().StringSliceVar(&c.fields, "fields", nil, "comma-separated list of page fields to list, e.g. path,title,params.author (default "+strings.Join(defaultListFields, ",")+")")
 
 	cmd.RunE = nil
 	return nil
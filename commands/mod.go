@@ -21,6 +21,7 @@ import (
 
 	"github.com/bep/simplecobra"
 	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/modules"
 	"github.com/gohugoio/hugo/modules/npm"
 	"github.com/spf13/cobra"
 )
@@ -34,12 +35,24 @@ See https://gohugo.io/hugo-modules/ for more information.
 
 `
 
+// newSkipModulesLockCfg creates a config that skips the modules.lock
+// verification when loading the modules, so the commands used to recover from a
+// mismatch still work.
+func newSkipModulesLockCfg() config.Provider {
+	cfg := config.New()
+	cfg.Set("internal.skipModulesLock", true)
+	return cfg
+}
+
 // buildConfigCommands creates a new config command and its subcommands.
 func newModCommands() *modCommands {
 	var (
 		clean   bool
 		pattern string
 		all     bool
+
+		vendorOnly    []string
+		vendorExclude []string
 	)
 
 	npmCommand := &simpleCommand{
@@ -106,13 +119,14 @@ so this may/will change in future versions of Hugo.
 			&simpleCommand{
 				name:  "verify",
 				short: "Verify dependencies.",
-				long:  `Verify checks that the dependencies of the current module, which are stored in a local downloaded source cache, have not been modified since being downloaded.`,
+				long: `Verify checks that the dependencies of the current module, which are stored in a local downloaded source cache, have not been modified since being downloaded.
+It also checks the module content against the hashes in modules.lock, if present.`,
 				withc: func(cmd *cobra.Command, r *rootCommand) {
 					applyLocalFlagsBuildConfig(cmd, r)
 					cmd.Flags().BoolVarP(&clean, "clean", "", false, "delete module cache for dependencies that fail verification")
 				},
 				run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
-					conf, err := r.ConfigFromProvider(r.configVersionID.Load(), flagsToCfg(cd, nil))
+					conf, err := r.ConfigFromProvider(r.configVersionID.Load(), flagsToCfg(cd, newSkipModulesLockCfg()))
 					if err != nil {
 						return err
 					}
@@ -131,7 +145,7 @@ Note that for vendored modules, that is the version listed and not the one from
 					cmd.Flags().BoolVarP(&clean, "clean", "", false, "delete module cache for dependencies that fail verification")
 				},
 				run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
-					conf, err := r.ConfigFromProvider(r.configVersionID.Load(), flagsToCfg(cd, nil))
+					conf, err := r.ConfigFromProvider(r.configVersionID.Load(), flagsToCfg(cd, newSkipModulesLockCfg()))
 					if err != nil {
 						return err
 					}
@@ -149,7 +163,7 @@ Note that for vendored modules, that is the version listed and not the one from
 					cmd.Flags().BoolVarP(&all, "all", "", false, "clean entire module cache")
 				},
 				run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
-					h, err := r.Hugo(flagsToCfg(cd, nil))
+					h, err := r.Hugo(flagsToCfg(cd, newSkipModulesLockCfg()))
 					if err != nil {
 						return err
 					}
@@ -182,16 +196,24 @@ Note that for vendored modules, that is the version listed and not the one from
 				short: "Vendor all module dependencies into the _vendor directory.",
 				long: `Vendor all module dependencies into the _vendor directory.
 	If a module is vendored, that is where Hugo will look for it's dependencies.
+
+	Use the --only and --exclude flags to vendor a subset of the modules, e.g:
+
+		hugo mod vendor --only github.com/gohugoio/testshortcodes
+
+	Modules not vendored will be resolved as usual when building.
 	`,
 				withc: func(cmd *cobra.Command, r *rootCommand) {
 					applyLocalFlagsBuildConfig(cmd, r)
+					cmd.Flags().StringSliceVarP(&vendorOnly, "only", "", nil, `only vendor modules with a path matching these patterns, e.g. "github.com/gohugoio/**"`)
+					cmd.Flags().StringSliceVarP(&vendorExclude, "exclude", "", nil, `do not vendor modules with a path matching these patterns`)
 				},
 				run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
 					h, err := r.Hugo(flagsToCfg(cd, nil))
 					if err != nil {
 						return err
 					}
-					return h.Configs.ModulesClient.Vendor()
+					return h.Configs.ModulesClient.VendorWithOptions(modules.VendorOptions{Only: vendorOnly, Exclude: vendorExclude})
 				},
 			},
 
@@ -260,7 +282,7 @@ Run "go help get" for more information. All flags available for "go get" is also
 								// Found a module.
 								dir := filepath.Dir(path)
 								r.Println("Update module in", dir)
-								cfg := config.New()
+								cfg := newSkipModulesLockCfg()
 								cfg.Set("workingDir", dir)
 								conf, err := r.ConfigFromProvider(r.configVersionID.Load(), flagsToCfg(cd, cfg))
 								if err != nil {
@@ -274,7 +296,7 @@ Run "go help get" for more information. All flags available for "go get" is also
 						})
 						return nil
 					} else {
-						conf, err := r.ConfigFromProvider(r.configVersionID.Load(), flagsToCfg(cd, nil))
+						conf, err := r.ConfigFromProvider(r.configVersionID.Load(), flagsToCfg(cd, newSkipModulesLockCfg()))
 						if err != nil {
 							return err
 						}
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	iofs "io/fs"
 	"net"
 	"net/http"
 	"net/url"
@@ -150,6 +151,75 @@ func (fs *countingStatFs) Stat(name string) (os.FileInfo, error) {
 	return f, err
 }
 
+// skipFilesFs hides the files matched by skip, used to exclude the static
+// files handled by the static pipeline from the static sync.
+type skipFilesFs struct {
+	afero.Fs
+	skip func(filename string) bool
+}
+
+func (fs *skipFilesFs) Stat(name string) (os.FileInfo, error) {
+	fi, err := fs.Fs.Stat(name)
+	if err == nil && !fi.IsDir() && fs.skip(name) {
+		return nil, os.ErrNotExist
+	}
+	return fi, err
+}
+
+func (fs *skipFilesFs) Open(name string) (afero.File, error) {
+	fi, err := fs.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := fs.Fs.Open(name)
+	if err != nil || !fi.IsDir() {
+		return f, err
+	}
+	return &skipFilesDir{File: f, name: name, skip: fs.skip}, nil
+}
+
+type skipFilesDir struct {
+	afero.File
+	name string
+	skip func(filename string) bool
+}
+
+func (f *skipFilesDir) ReadDir(n int) ([]iofs.DirEntry, error) {
+	var (
+		des []iofs.DirEntry
+		err error
+	)
+	if rdf, ok := f.File.(iofs.ReadDirFile); ok {
+		des, err = rdf.ReadDir(n)
+	} else {
+		var fis []os.FileInfo
+		fis, err = f.File.Readdir(n)
+		for _, fi := range fis {
+			des = append(des, iofs.FileInfoToDirEntry(fi))
+		}
+	}
+	i := 0
+	for _, de := range des {
+		if de.IsDir() || !f.skip(filepath.Join(f.name, de.Name())) {
+			des[i] = de
+			i++
+		}
+	}
+	return des[:i], err
+}
+
+func (f *skipFilesDir) Readdir(n int) ([]os.FileInfo, error) {
+	fis, err := f.File.Readdir(n)
+	i := 0
+	for _, fi := range fis {
+		if fi.IsDir() || !f.skip(filepath.Join(f.name, fi.Name())) {
+			fis[i] = fi
+			i++
+		}
+	}
+	return fis[:i], err
+}
+
 // dynamicEvents contains events that is considered dynamic, as in "not static".
 // Both of these categories will trigger a new build, but the asset events
 // does not fit into the "navigate to changed" logic.
@@ -1040,6 +1110,11 @@ func (s *staticSyncer) isStatic(h *hugolib.HugoSites, filename string) bool {
 func (s *staticSyncer) syncsStaticEvents(staticEvents []fsnotify.Event) error {
 	c := s.c
 
+	h, err := c.hugo()
+	if err != nil {
+		return err
+	}
+
 	syncFn := func(sourceFs *filesystems.SourceFilesystem) (uint64, error) {
 		publishDir := helpers.FilePathSeparator
 
@@ -1052,10 +1127,10 @@ func (s *staticSyncer) syncsStaticEvents(staticEvents []fsnotify.Event) error {
 			syncer.NoTimes = conf.configs.Base.NoTimes
 			syncer.NoChmod = conf.configs.Base.NoChmod
 			syncer.ChmodFilter = chmodFilter
-			syncer.SrcFs = sourceFs.Fs
-			syncer.DestFs = conf.fs.PublishDir
+			syncer.SrcFs = &skipFilesFs{Fs: sourceFs.Fs, skip: h.IsStaticPipelineFile}
+			syncer.DestFs = h.Fs.PublishDir
 			if c.s != nil && c.s.renderStaticToDisk {
-				syncer.DestFs = conf.fs.PublishDirStatic
+				syncer.DestFs = h.Fs.PublishDirStatic
 			}
 		})
 
@@ -1087,6 +1162,16 @@ func (s *staticSyncer) syncsStaticEvents(staticEvents []fsnotify.Event) error {
 				continue
 			}
 
+			if h.IsStaticPipelineFile(relPath) {
+				if _, err := sourceFs.Fs.Stat(relPath); err == nil {
+					logger.Println("Processing", relPath, "to", publishDir)
+					if _, err := h.PublishStaticPipeline(sourceFs, syncer.DestFs, relPath); err != nil {
+						c.r.logger.Errorln(err)
+					}
+					continue
+				}
+			}
+
 			// Remove || rename is harder and will require an assumption.
 			// Hugo takes the following approach:
 			// If the static file exists in any of the static source directories after this event
@@ -1128,7 +1213,7 @@ func (s *staticSyncer) syncsStaticEvents(staticEvents []fsnotify.Event) error {
 		return 0, nil
 	}
 
-	_, err := c.doWithPublishDirs(syncFn)
+	_, err = c.doWithPublishDirs(syncFn)
 	return err
 }
 
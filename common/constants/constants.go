@@ -21,6 +21,8 @@ const (
 	ErrRemoteGetCSV  = "error-remote-getcsv"
 
 	WarnFrontMatterParamsOverrides = "warning-frontmatter-params-overrides"
+	WarnFrontMatterUnsafe          = "warning-frontmatter-unsafe"
+	WarnMenuPageRefNotFound        = "warning-menu-pageref-not-found"
 )
 
 // Field/method names with special meaning.
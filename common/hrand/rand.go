@@ -0,0 +1,87 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hrand provides the seeded random number generators used in a build.
+package hrand
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"sync"
+)
+
+// Source provides random number generators derived from a single seed.
+// It is safe for concurrent use.
+type Source struct {
+	seed int64
+
+	mu sync.Mutex
+	m  map[string]*Rand
+}
+
+// NewSource creates a new Source with the given seed.
+func NewSource(seed int64) *Source {
+	return &Source{
+		seed: seed,
+		m:    make(map[string]*Rand),
+	}
+}
+
+// Seed returns the seed used for all random number generators.
+func (s *Source) Seed() int64 {
+	return s.seed
+}
+
+// Rand returns the random number generator for key, e.g. the page being
+// rendered. Pages are rendered in parallel, so giving each page its own
+// generator makes the numbers drawn independent of the rendering order.
+func (s *Source) Rand(key string) *Rand {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, found := s.m[key]
+	if !found {
+		h := fnv.New64a()
+		h.Write([]byte(key))
+		r = &Rand{r: rand.New(rand.NewSource(s.seed ^ int64(h.Sum64())))}
+		s.m[key] = r
+	}
+	return r
+}
+
+// Reset resets all random number generators to their initial state.
+func (s *Source) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m = make(map[string]*Rand)
+}
+
+// Rand is a random number generator that is safe for concurrent use.
+type Rand struct {
+	mu sync.Mutex
+	r  *rand.Rand
+}
+
+// Float64 returns a pseudo-random number in the half-open interval [0.0,1.0).
+func (r *Rand) Float64() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.r.Float64()
+}
+
+// Perm returns a pseudo-random permutation of the integers in the half-open interval [0,n).
+func (r *Rand) Perm(n int) []int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.r.Perm(n)
+}
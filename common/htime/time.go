@@ -14,7 +14,10 @@
 package htime
 
 import (
+	"encoding/json"
+	"fmt"
 	"log"
+	"math"
 	"strings"
 	"time"
 
@@ -99,23 +102,8 @@ func (f TimeFormatter) Format(t time.Time, layout string) string {
 
 	if layout[0] == ':' {
 		// It may be one of Hugo's custom layouts.
-		switch strings.ToLower(layout[1:]) {
-		case "date_full":
-			return f.ltr.FmtDateFull(t)
-		case "date_long":
-			return f.ltr.FmtDateLong(t)
-		case "date_medium":
-			return f.ltr.FmtDateMedium(t)
-		case "date_short":
-			return f.ltr.FmtDateShort(t)
-		case "time_full":
-			return f.ltr.FmtTimeFull(t)
-		case "time_long":
-			return f.ltr.FmtTimeLong(t)
-		case "time_medium":
-			return f.ltr.FmtTimeMedium(t)
-		case "time_short":
-			return f.ltr.FmtTimeShort(t)
+		if s, found := f.formatNamed(t, strings.ToLower(layout[1:])); found {
+			return s
 		}
 	}
 
@@ -139,6 +127,70 @@ func (f TimeFormatter) Format(t time.Time, layout string) string {
 	return s
 }
 
+// FormatNamed formats t using the named preset for the current language.
+// The name is one of short, medium, long or full, optionally prefixed with
+// date_ (the default) or time_.
+func (f TimeFormatter) FormatNamed(t time.Time, name string) (string, error) {
+	name = strings.ToLower(name)
+	if !strings.HasPrefix(name, "date_") && !strings.HasPrefix(name, "time_") {
+		name = "date_" + name
+	}
+	s, found := f.formatNamed(t, name)
+	if !found {
+		return "", fmt.Errorf("unknown named layout %q; must be one of short, medium, long or full, optionally prefixed with date_ or time_", strings.TrimPrefix(name, "date_"))
+	}
+	return s, nil
+}
+
+// namedLayoutFallbacks are used when the current language lacks a preset.
+var namedLayoutFallbacks = map[string]string{
+	"date_full":   "Monday, January 2, 2006",
+	"date_long":   "January 2, 2006",
+	"date_medium": "Jan 2, 2006",
+	"date_short":  "1/2/06",
+	"time_full":   "3:04:05 pm MST",
+	"time_long":   "3:04:05 pm MST",
+	"time_medium": "3:04:05 pm",
+	"time_short":  "3:04 pm",
+}
+
+func (f TimeFormatter) formatNamed(t time.Time, name string) (string, bool) {
+	var s string
+	switch name {
+	case "date_full":
+		s = f.ltr.FmtDateFull(t)
+	case "date_long":
+		s = f.ltr.FmtDateLong(t)
+	case "date_medium":
+		s = f.ltr.FmtDateMedium(t)
+	case "date_short":
+		s = f.ltr.FmtDateShort(t)
+	case "time_full":
+		s = f.ltr.FmtTimeFull(t)
+	case "time_long":
+		s = f.ltr.FmtTimeLong(t)
+	case "time_medium":
+		s = f.ltr.FmtTimeMedium(t)
+	case "time_short":
+		s = f.ltr.FmtTimeShort(t)
+	default:
+		return "", false
+	}
+	if s == "" {
+		s = f.Format(t, namedLayoutFallbacks[name])
+	}
+	return s, true
+}
+
+// ToTimeInDefaultLocationE converts i to a time.Time, using location for
+// values without time zone information.
+//
+// Numbers, e.g. timestamps from a JSON API, are interpreted as time since the
+// Unix epoch, in a unit determined by their magnitude, see UnixToTime.
+//
+// Strings are parsed as dates in the common formats, including ISO 8601
+// variants without seconds, with hour-only offsets and in the basic format,
+// e.g. "2024-01-02T15:04", "2024-01-02T15:04:05+02" and "20240102T150405Z".
 func ToTimeInDefaultLocationE(i any, location *time.Location) (tim time.Time, err error) {
 	switch vv := i.(type) {
 	case AsTimeProvider:
@@ -148,9 +200,125 @@ func ToTimeInDefaultLocationE(i any, location *time.Location) (tim time.Time, er
 	// convert back them into string and use `cast`
 	// TODO(bep) add tests, make sure we really need this.
 	case time.Time:
-		i = vv.Format(time.RFC3339)
+		i = vv.Format(time.RFC3339Nano)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return UnixToTime(cast.ToInt64(vv), location), nil
+	case float32, float64:
+		return unixFloatToTime(cast.ToFloat64(vv), location), nil
+	case json.Number:
+		if n, err := vv.Int64(); err == nil {
+			return UnixToTime(n, location), nil
+		}
+		f, err := vv.Float64()
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unable to cast %#v of type %T to Time", i, i)
+		}
+		return unixFloatToTime(f, location), nil
+	}
+
+	tim, err = cast.ToTimeInDefaultLocationE(i, location)
+	if err != nil {
+		if s, ok := i.(string); ok {
+			if t, ok := parseISO8601(s, location); ok {
+				return t, nil
+			}
+		}
+	}
+	return
+}
+
+// The limits of the Unix timestamps interpreted as seconds, milliseconds and
+// microseconds, see UnixToTime. Each is roughly the year 5138 in its unit.
+const (
+	unixSecondsLimit      = 1e11
+	unixMillisecondsLimit = 1e14
+	unixMicrosecondsLimit = 1e17
+)
+
+// UnixToTime converts n to a time.Time in location (time.Local if nil).
+// The unit of n is determined by its magnitude: an absolute value below 1e11
+// is seconds, below 1e14 milliseconds, below 1e17 microseconds, and
+// nanoseconds otherwise. This means that a timestamp in milliseconds
+// before 1973-03-03 is interpreted as seconds.
+func UnixToTime(n int64, location *time.Location) time.Time {
+	var t time.Time
+	switch unixUnit(math.Abs(float64(n))) {
+	case time.Second:
+		t = time.Unix(n, 0)
+	case time.Millisecond:
+		t = time.UnixMilli(n)
+	case time.Microsecond:
+		t = time.UnixMicro(n)
+	default:
+		t = time.Unix(0, n)
+	}
+
+	return inLocation(t, location)
+}
+
+// unixFloatToTime is UnixToTime for floats, keeping any fraction,
+// e.g. fractional seconds.
+func unixFloatToTime(f float64, location *time.Location) time.Time {
+	n, frac := math.Modf(f)
+	t := UnixToTime(int64(n), location)
+	if frac == 0 {
+		return t
+	}
+	return t.Add(time.Duration(math.Round(frac * float64(unixUnit(math.Abs(f))))))
+}
+
+func unixUnit(abs float64) time.Duration {
+	switch {
+	case abs < unixSecondsLimit:
+		return time.Second
+	case abs < unixMillisecondsLimit:
+		return time.Millisecond
+	case abs < unixMicrosecondsLimit:
+		return time.Microsecond
+	default:
+		return time.Nanosecond
+	}
+}
+
+func inLocation(t time.Time, location *time.Location) time.Time {
+	if location == nil {
+		return t
+	}
+	return t.In(location)
+}
+
+// iso8601Formats are ISO 8601 variants not handled by cast.
+var iso8601Formats = []struct {
+	layout  string
+	hasZone bool
+}{
+	{"2006-01-02T15:04Z07:00", true},
+	{"2006-01-02T15:04Z0700", true},
+	{"2006-01-02T15:04", false},
+	{"2006-01-02 15:04", false},
+	{"2006-01-02T15:04:05Z07", true},
+	{"2006-01-02T15:04Z07", true},
+	{"20060102T150405Z0700", true},
+	{"20060102T150405", false},
+	{"20060102T1504Z0700", true},
+	{"20060102T1504", false},
+	{"20060102", false},
+}
+
+func parseISO8601(s string, location *time.Location) (time.Time, bool) {
+	if location == nil {
+		location = time.Local
+	}
+	for _, f := range iso8601Formats {
+		if f.hasZone {
+			if t, err := time.Parse(f.layout, s); err == nil {
+				return t, true
+			}
+		} else if t, err := time.ParseInLocation(f.layout, s, location); err == nil {
+			return t, true
+		}
 	}
-	return cast.ToTimeInDefaultLocationE(i, location)
+	return time.Time{}, false
 }
 
 // Now returns time.Now() or time value based on the `clock` flag.
@@ -14,10 +14,12 @@
 package htime
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
 	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/locales"
 	translators "github.com/gohugoio/localescompressed"
 )
 
@@ -108,6 +110,43 @@ func TestTimeFormatter(t *testing.T) {
 	})
 }
 
+func TestTimeFormatterFormatNamed(t *testing.T) {
+	c := qt.New(t)
+
+	june06, _ := time.Parse("2006-Jan-02", "2018-Jun-06")
+
+	c.Run("Fallback", func(c *qt.C) {
+		f := NewTimeFormatter(noDatePresetsTranslator{translators.GetTranslator("nn")})
+
+		got, err := f.FormatNamed(june06, "medium")
+		c.Assert(err, qt.IsNil)
+		c.Assert(got, qt.Equals, "juni 6, 2018")
+		got, err = f.FormatNamed(june06, "full")
+		c.Assert(err, qt.IsNil)
+		c.Assert(got, qt.Equals, "onsdag, juni 6, 2018")
+		got, err = f.FormatNamed(june06, "short")
+		c.Assert(err, qt.IsNil)
+		c.Assert(got, qt.Equals, "06.06.2018")
+		c.Assert(f.Format(june06, ":date_medium"), qt.Equals, "juni 6, 2018")
+	})
+
+	c.Run("Unknown", func(c *qt.C) {
+		f := NewTimeFormatter(translators.GetTranslator("en"))
+		_, err := f.FormatNamed(june06, "huge")
+		c.Assert(err, qt.ErrorMatches, `unknown named layout "huge".*`)
+	})
+}
+
+// noDatePresetsTranslator is a translator without the medium, long and full
+// date presets.
+type noDatePresetsTranslator struct {
+	locales.Translator
+}
+
+func (noDatePresetsTranslator) FmtDateMedium(t time.Time) string { return "" }
+func (noDatePresetsTranslator) FmtDateLong(t time.Time) string   { return "" }
+func (noDatePresetsTranslator) FmtDateFull(t time.Time) string   { return "" }
+
 func BenchmarkTimeFormatter(b *testing.B) {
 	june06, _ := time.Parse("2006-Jan-02", "2018-Jun-06")
 
@@ -142,3 +181,62 @@ func BenchmarkTimeFormatter(b *testing.B) {
 		}
 	})
 }
+
+func TestToTimeInDefaultLocationE(t *testing.T) {
+	c := qt.New(t)
+
+	oslo, err := time.LoadLocation("Europe/Oslo")
+	c.Assert(err, qt.IsNil)
+
+	for _, test := range []struct {
+		name   string
+		value  any
+		expect any
+	}{
+		// Unix timestamps.
+		{"Seconds", 1700000000, "2023-11-14T23:13:20+01:00"},
+		{"Seconds int64", int64(1700000000), "2023-11-14T23:13:20+01:00"},
+		{"Seconds uint32", uint32(1700000000), "2023-11-14T23:13:20+01:00"},
+		{"Seconds negative", -86400, "1969-12-31T01:00:00+01:00"},
+		{"Seconds float", 1700000000.0, "2023-11-14T23:13:20+01:00"},
+		{"Seconds float fraction", 1700000000.25, "2023-11-14T23:13:20.25+01:00"},
+		{"Milliseconds", int64(1700000000123), "2023-11-14T23:13:20.123+01:00"},
+		{"Milliseconds float", 1700000000123.0, "2023-11-14T23:13:20.123+01:00"},
+		{"Microseconds", int64(1700000000123456), "2023-11-14T23:13:20.123456+01:00"},
+		{"Nanoseconds", int64(1700000000123456789), "2023-11-14T23:13:20.123456789+01:00"},
+		{"JSON number", json.Number("1700000000123"), "2023-11-14T23:13:20.123+01:00"},
+		{"JSON number float", json.Number("1700000000.5"), "2023-11-14T23:13:20.5+01:00"},
+		{"Largest seconds", int64(99999999999), "5138-11-16T10:46:39+01:00"},
+		{"Smallest milliseconds", int64(100000000000), "1973-03-03T10:46:40+01:00"},
+
+		// ISO 8601 variants.
+		{"Date", "2024-01-02", "2024-01-02T00:00:00+01:00"},
+		{"RFC 3339", "2024-01-02T15:04:05Z", "2024-01-02T15:04:05Z"},
+		{"Fraction", "2024-01-02T15:04:05.123+02:00", "2024-01-02T15:04:05.123+02:00"},
+		{"No seconds", "2024-01-02T15:04", "2024-01-02T15:04:00+01:00"},
+		{"No seconds, space", "2024-01-02 15:04", "2024-01-02T15:04:00+01:00"},
+		{"No seconds, UTC", "2024-01-02T15:04Z", "2024-01-02T15:04:00Z"},
+		{"No seconds, offset", "2024-01-02T15:04+05:30", "2024-01-02T15:04:00+05:30"},
+		{"Hour offset", "2024-01-02T15:04:05+02", "2024-01-02T15:04:05+02:00"},
+		{"Basic", "20240102T150405Z", "2024-01-02T15:04:05Z"},
+		{"Basic, offset", "20240102T150405-0700", "2024-01-02T15:04:05-07:00"},
+		{"Basic, no zone", "20240102T150405", "2024-01-02T15:04:05+01:00"},
+		{"Basic, no seconds", "20240102T1504Z", "2024-01-02T15:04:00Z"},
+		{"Basic date", "20240102", "2024-01-02T00:00:00+01:00"},
+
+		// Failures.
+		{"Invalid", "2024-13-45", false},
+		{"Invalid JSON number", json.Number("abc"), false},
+		{"Invalid type", []string{"2024-01-02"}, false},
+	} {
+		c.Run(test.name, func(c *qt.C) {
+			got, err := ToTimeInDefaultLocationE(test.value, oslo)
+			if b, ok := test.expect.(bool); ok && !b {
+				c.Assert(err, qt.IsNotNil)
+				return
+			}
+			c.Assert(err, qt.IsNil)
+			c.Assert(got.Format(time.RFC3339Nano), qt.Equals, test.expect)
+		})
+	}
+}
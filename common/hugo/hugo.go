@@ -106,6 +106,11 @@ func (i HugoInfo) WorkingDir() string {
 	return i.conf.WorkingDir()
 }
 
+// RandomSeed returns the seed used by the template functions that use randomness.
+func (i HugoInfo) RandomSeed() int64 {
+	return i.conf.RandomSeed()
+}
+
 // Deps gets a list of dependencies for this Hugo build.
 func (i HugoInfo) Deps() []*Dependency {
 	return i.deps
@@ -116,6 +121,7 @@ type ConfigProvider interface {
 	Environment() string
 	Running() bool
 	WorkingDir() string
+	RandomSeed() int64
 }
 
 // NewInfo creates a new Hugo Info object.
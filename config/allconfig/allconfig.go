@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/gohugoio/hugo/cache/filecache"
+	"github.com/gohugoio/hugo/common/htime"
 	"github.com/gohugoio/hugo/common/hugo"
 	"github.com/gohugoio/hugo/common/loggers"
 	"github.com/gohugoio/hugo/common/maps"
@@ -66,6 +67,9 @@ type InternalConfig struct {
 	Clock          string
 	Watch          bool
 	LiveReloadPort int
+
+	// Skip verifying modules.lock, set by the "hugo mod" commands.
+	SkipModulesLock bool
 }
 
 // All non-params config keys for language.
@@ -161,15 +165,24 @@ type Config struct {
 	// Sitemap configuration.
 	Sitemap config.SitemapConfig `mapstructure:"-"`
 
+	// Reading time configuration.
+	ReadingTime config.ReadingTimeConfig `mapstructure:"-"`
+
 	// Related content configuration.
 	Related related.Config `mapstructure:"-"`
 
 	// Server configuration.
 	Server config.Server `mapstructure:"-"`
 
+	// Static pipeline configuration.
+	StaticPipeline config.StaticPipeline `mapstructure:"-"`
+
 	// Privacy configuration.
 	Privacy privacy.Config `mapstructure:"-"`
 
+	// Shortcodes configuration.
+	Shortcodes config.ShortcodesConfig `mapstructure:"-"`
+
 	// Security configuration.
 	Security security.Config `mapstructure:"-"`
 
@@ -180,6 +193,10 @@ type Config struct {
 	// <docsmeta>{"refs": ["config:languages:params"] }</docsmeta>
 	Params maps.Params `mapstructure:"-"`
 
+	// Optional schema to validate the user provided parameters against.
+	// <docsmeta>{"identifiers": ["paramsSchema"] }</docsmeta>
+	ParamsSchema config.ParamsSchema `mapstructure:"-"`
+
 	// The languages configuration sections maps a language code (a string) to a configuration object for that language.
 	Languages map[string]langs.LanguageConfig `mapstructure:"-"`
 
@@ -249,8 +266,7 @@ func (c *Config) CompileConfig(logger loggers.Logger) error {
 			kind = newKind
 		}
 		if kinds.GetKindAny(kind) == "" {
-			logger.Warnf("Unknown kind %q in disableKinds configuration.", kind)
-			continue
+			return fmt.Errorf("unknown kind %q in disableKinds configuration", kind)
 		}
 		disabledKinds[kind] = true
 	}
@@ -309,6 +325,14 @@ func (c *Config) CompileConfig(logger loggers.Logger) error {
 	if err != nil {
 		return err
 	}
+	// The redirect map needs absolute URLs to redirect to.
+	// The server sets its own baseURL, so we only validate it when building.
+	// Note that protocol-relative URLs, e.g. //example.org/, are allowed.
+	if c.Build.RedirectMap != "" && !c.Internal.Running && c.BaseURL != "" {
+		if u := baseURL.URL(); u.Opaque != "" || (u.Host == "" && (u.Scheme == "" || u.Scheme == "http" || u.Scheme == "https")) {
+			return fmt.Errorf("invalid baseURL %q: build.redirectMap requires an absolute URL with a scheme, e.g. \"https://example.org/\"", c.BaseURL)
+		}
+	}
 
 	isUglyURL := func(section string) bool {
 		switch v := c.UglyURLs.(type) {
@@ -345,13 +369,25 @@ func (c *Config) CompileConfig(logger loggers.Logger) error {
 
 	var clock time.Time
 	if c.Internal.Clock != "" {
-		var err error
-		clock, err = time.Parse(time.RFC3339, c.Internal.Clock)
+		// A clock without time zone information is in the site's time zone,
+		// the same as front matter dates.
+		location, err := time.LoadLocation(c.TimeZone)
+		if err != nil {
+			return fmt.Errorf("invalid timeZone: %w", err)
+		}
+		clock, err = htime.ToTimeInDefaultLocationE(c.Internal.Clock, location)
 		if err != nil {
 			return fmt.Errorf("failed to parse clock: %s", err)
 		}
 	}
 
+	var randomSeed int64
+	if c.RandomSeed != nil {
+		randomSeed = *c.RandomSeed
+	} else {
+		randomSeed = time.Now().UnixNano()
+	}
+
 	c.C = &ConfigCompiled{
 		Timeout:           timeout,
 		BaseURL:           baseURL,
@@ -365,6 +401,7 @@ func (c *Config) CompileConfig(logger loggers.Logger) error {
 		IgnoreFile:        ignoreFile,
 		MainSections:      c.MainSections,
 		Clock:             clock,
+		RandomSeed:        randomSeed,
 		transientErr:      transientErr,
 	}
 
@@ -401,6 +438,7 @@ type ConfigCompiled struct {
 	IgnoreFile        func(filename string) bool
 	MainSections      []string
 	Clock             time.Time
+	RandomSeed        int64
 
 	// This is set to the last transient error found during config compilation.
 	// With themes/modules we compute the configuration in multiple passes, and
@@ -485,6 +523,15 @@ type RootConfig struct {
 	// If not set, Hugo will try to guess this from the content.
 	MainSections []string
 
+	// Data directories, relative to the data dir, whose files are deep merged
+	// into one map, e.g. "products" for all files in data/products.
+	MergeDataDirs []string
+
+	// Maps globs matching data files, relative to the data dir, to the JSON Schema
+	// files, relative to the project dir, to validate them against,
+	// e.g. "products.yaml" = "schemas/products.json".
+	DataSchemas map[string]string
+
 	// Enable robots.txt generation.
 	EnableRobotsTXT bool
 
@@ -493,12 +540,22 @@ type RootConfig struct {
 	// <docsmeta>{"identifiers": ["Page"] }</docsmeta>
 	EnableGitInfo bool
 
+	// When enabled, together with EnableGitInfo, Hugo will collect the authors of
+	// all commits to the files in a page bundle, available in .Contributors.
+	// This requires the full Git history of every file, so it is off by default.
+	// <docsmeta>{"identifiers": ["Page"] }</docsmeta>
+	EnableGitContributors bool
+
 	// Enable to track, calculate and print metrics.
 	TemplateMetrics bool
 
 	// Enable to track, print and calculate metric hints.
 	TemplateMetricsHints bool
 
+	// If set, write the render duration of each page, per output format, as JSON to this file.
+	// A relative path is resolved relative to the working directory.
+	ProfilePages string
+
 	// Enable to disable the build lock file.
 	NoBuildLock bool
 
@@ -553,9 +610,17 @@ type RootConfig struct {
 	// Enable to print warnings for missing translation strings.
 	PrintI18nWarnings bool
 
+	// If set, write the translations missing in the build, per language, as JSON to this file at the end of the build.
+	// Use "-" to write to stderr. A relative path is resolved relative to the working directory.
+	MissingTranslations string
+
 	// ENable to print warnings for multiple files published to the same destination.
 	PrintPathWarnings bool
 
+	// The seed used by the template functions that use randomness, e.g. shuffle and math.Rand.
+	// Set this to get reproducible builds. If not set, a time based seed is used.
+	RandomSeed *int64
+
 	// URL to be used as a placeholder when a page reference cannot be found in ref or relref. Is used as-is.
 	RefLinksNotFoundURL string
 
@@ -602,6 +667,10 @@ type RootConfig struct {
 	// A Glob pattern of module paths to ignore in the _vendor folder.
 	IgnoreVendorPaths string
 
+	// Update modules.lock with the content hashes of the current module versions
+	// instead of failing the build on a mismatch.
+	ModUpdate bool
+
 	config.CommonDirs `mapstructure:",squash"`
 
 	// The odd constructs below are kept for backwards compatibility.
@@ -677,6 +746,33 @@ func (c *Configs) transientErr() error {
 	return nil
 }
 
+// validateParams validates the params of each language against the params schema.
+// In strict mode any validation error is returned, else they're logged as warnings.
+func (c *Configs) validateParams(logger loggers.Logger) error {
+	var errs []error
+	seen := make(map[string]bool)
+	for _, l := range c.LanguageConfigSlice {
+		for _, err := range l.ParamsSchema.Validate(l.Params) {
+			// Languages without their own params share the same config.
+			if !seen[err.Error()] {
+				seen[err.Error()] = true
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	if c.Base.ParamsSchema.Strict {
+		return errors.Join(errs...)
+	}
+	for _, err := range errs {
+		logger.Warnf("Params: %s", err)
+	}
+	return nil
+}
+
 func (c *Configs) IsZero() bool {
 	// A config always has at least one language.
 	return c == nil || len(c.Languages) == 0
@@ -2,8 +2,10 @@ package allconfig_test
 
 import (
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/bep/logg"
 	qt "github.com/frankban/quicktest"
 	"github.com/gohugoio/hugo/config/allconfig"
 	"github.com/gohugoio/hugo/hugolib"
@@ -84,3 +86,94 @@ logPathWarnings = true
 	b.Assert(conf.PrintI18nWarnings, qt.Equals, true)
 	b.Assert(conf.PrintPathWarnings, qt.Equals, true)
 }
+
+func TestParamsSchema(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com"
+[params]
+googleAnalytcs = "G-123"
+[paramsSchema]
+strict = STRICT
+[paramsSchema.params]
+googleAnalytics = "string"
+-- layouts/index.html --
+Home.
+`
+
+	_, err := hugolib.TestE(t, strings.ReplaceAll(files, "STRICT", "true"))
+	qt.Assert(t, err, qt.IsNotNil)
+	qt.Assert(t, err.Error(), qt.Contains, `failed to validate params: param "googleanalytcs": not defined in paramsSchema`)
+
+	// Config warnings are logged before the build starts.
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.ReplaceAll(files, "STRICT", "false"),
+			LogLevel:    logg.LevelWarn,
+		},
+	).Init()
+	b.AssertLogContains(`WARN  Params: param "googleanalytcs": not defined in paramsSchema`)
+}
+
+func TestParamsSchemaFromTheme(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com"
+theme = "mytheme"
+[params]
+color = "red"
+size = "large"
+[paramsSchema]
+strict = true
+[paramsSchema.params]
+color = "string"
+-- themes/mytheme/hugo.toml --
+[paramsSchema.params]
+size = "int"
+-- layouts/index.html --
+Home.
+`
+
+	_, err := hugolib.TestE(t, files)
+	qt.Assert(t, err, qt.IsNotNil)
+	qt.Assert(t, err.Error(), qt.Contains, `param "size": expected int, got string`)
+}
+
+func TestBaseURLValidation(t *testing.T) {
+	t.Parallel()
+
+	filesTemplate := `
+-- hugo.toml --
+baseURL = "BASEURL"
+[build]
+redirectMap = "REDIRECTMAP"
+-- layouts/index.html --
+Home.
+`
+
+	newFiles := func(baseURL, redirectMap string) string {
+		return strings.NewReplacer("BASEURL", baseURL, "REDIRECTMAP", redirectMap).Replace(filesTemplate)
+	}
+
+	for _, baseURL := range []string{"example.org", "example.org/docs/", "localhost:1313", "/docs/", "/", "https:///docs/"} {
+		files := newFiles(baseURL, "plain")
+		_, err := hugolib.TestE(t, files)
+		qt.Assert(t, err, qt.IsNotNil)
+		qt.Assert(t, err.Error(), qt.Contains, `invalid baseURL "`+baseURL+`": build.redirectMap requires an absolute URL with a scheme`)
+
+		// The server sets its own baseURL.
+		hugolib.Test(t, files, hugolib.TestOptRunning())
+
+		// The baseURL is only validated when the redirect map is enabled.
+		hugolib.Test(t, newFiles(baseURL, ""))
+	}
+
+	for _, baseURL := range []string{"", "https://example.org", "http://example.org/docs/", "//example.org/", "file:///tmp/public/"} {
+		hugolib.Test(t, newFiles(baseURL, "plain"))
+	}
+}
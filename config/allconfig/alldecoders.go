@@ -131,6 +131,17 @@ var allDecoderSetups = map[string]decodeWeight{
 			return &c.Server
 		},
 	},
+	"staticpipeline": {
+		key: "staticpipeline",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.StaticPipeline, err = config.DecodeStaticPipeline(p.p)
+			return err
+		},
+		getCompiler: func(c *Config) configCompiler {
+			return c.StaticPipeline
+		},
+	},
 	"minify": {
 		key: "minify",
 		decode: func(d decodeWeight, p decodeConfig) error {
@@ -220,6 +231,14 @@ var allDecoderSetups = map[string]decodeWeight{
 			return err
 		},
 	},
+	"readingtime": {
+		key: "readingtime",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.ReadingTime, err = config.DecodeReadingTime(config.DefaultReadingTimeConfig, p.p.GetStringMap(d.key))
+			return err
+		},
+	},
 	"taxonomies": {
 		key: "taxonomies",
 		decode: func(d decodeWeight, p decodeConfig) error {
@@ -311,6 +330,22 @@ var allDecoderSetups = map[string]decodeWeight{
 			return err
 		},
 	},
+	"paramsschema": {
+		key: "paramsschema",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.ParamsSchema, err = config.DecodeParamsSchema(p.p)
+			return err
+		},
+	},
+	"shortcodes": {
+		key: "shortcodes",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.Shortcodes, err = config.DecodeShortcodesConfig(p.p)
+			return err
+		},
+	},
 	"security": {
 		key: "security",
 		decode: func(d decodeWeight, p decodeConfig) error {
@@ -360,6 +395,8 @@ var allDecoderSetups = map[string]decodeWeight{
 				p.c.UglyURLs = vv
 			case string:
 				p.c.UglyURLs = vv == "true"
+			case maps.Params:
+				p.c.UglyURLs = cast.ToStringMapBool(map[string]any(vv))
 			default:
 				p.c.UglyURLs = cast.ToStringMapBool(v)
 			}
@@ -223,6 +223,10 @@ func (c ConfigLanguage) PrintI18nWarnings() bool {
 	return c.config.PrintI18nWarnings
 }
 
+func (c ConfigLanguage) CollectMissingTranslations() bool {
+	return c.config.MissingTranslations != ""
+}
+
 func (c ConfigLanguage) CreateTitle(s string) string {
 	return c.config.C.CreateTitle(s)
 }
@@ -242,3 +246,7 @@ func (c ConfigLanguage) StaticDirs() []string {
 func (c ConfigLanguage) EnableEmoji() bool {
 	return c.config.EnableEmoji
 }
+
+func (c ConfigLanguage) RandomSeed() int64 {
+	return c.m.Base.C.RandomSeed
+}
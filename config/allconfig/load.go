@@ -91,6 +91,11 @@ func LoadConfig(d ConfigSourceDescriptor) (*Configs, error) {
 		return nil, fmt.Errorf("failed to init config: %w", err)
 	}
 
+	// Validate the params after any modules config is merged in.
+	if err := configs.validateParams(d.Logger); err != nil {
+		return nil, fmt.Errorf("failed to validate params: %w", err)
+	}
+
 	loggers.InitGlobalLogger(d.Logger.Level(), configs.Base.PanicOnWarning)
 
 	return configs, nil
@@ -205,6 +210,7 @@ func (l configLoader) applyDefaultConfig() error {
 		"defaultContentLanguageInSubdir":       false,
 		"enableMissingTranslationPlaceholders": false,
 		"enableGitInfo":                        false,
+		"enableGitContributors":                false,
 		"ignoreFiles":                          make([]string, 0),
 		"disableAliases":                       false,
 		"debug":                                false,
@@ -495,6 +501,8 @@ func (l *configLoader) loadModules(configs *Configs) (modules.ModulesConfig, *mo
 		CacheDir:           conf.Caches.CacheDirModules(),
 		ModuleConfig:       conf.Module,
 		IgnoreVendor:       ignoreVendor,
+		UpdateLockFile:     conf.ModUpdate,
+		SkipLockFile:       conf.Internal.SkipModulesLock,
 	})
 
 	moduleConfig, err := modulesClient.Collect()
@@ -4,7 +4,11 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
 	"github.com/spf13/afero"
 )
 
@@ -65,3 +69,38 @@ weight = 4
 		}
 	}
 }
+
+func TestLoadConfigClockTimeZone(t *testing.T) {
+	c := qt.New(t)
+
+	fs := afero.NewMemMapFs()
+	c.Assert(afero.WriteFile(fs, "hugo.toml", []byte(`timeZone = "America/New_York"`), 0o666), qt.IsNil)
+
+	load := func(clock string) (*Configs, error) {
+		flags := config.New()
+		flags.Set("internal", maps.Params{"clock": clock})
+		return LoadConfig(ConfigSourceDescriptor{
+			Fs:       fs,
+			Filename: "hugo.toml",
+			Flags:    flags,
+		})
+	}
+
+	for _, test := range []struct {
+		clock  string
+		expect time.Time
+	}{
+		// No time zone information, use the site's time zone.
+		{"2024-05-01T00:00:00", time.Date(2024, 5, 1, 4, 0, 0, 0, time.UTC)},
+		{"2024-05-01", time.Date(2024, 5, 1, 4, 0, 0, 0, time.UTC)},
+		{"2024-05-01T00:00:00Z", time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{"2024-05-01T00:00:00+09:00", time.Date(2024, 4, 30, 15, 0, 0, 0, time.UTC)},
+	} {
+		configs, err := load(test.clock)
+		c.Assert(err, qt.IsNil)
+		c.Assert(configs.Base.C.Clock.Equal(test.expect), qt.IsTrue, qt.Commentf("%s: %s", test.clock, configs.Base.C.Clock))
+	}
+
+	_, err := load("foo")
+	c.Assert(err, qt.ErrorMatches, `(?s).*failed to parse clock.*`)
+}
@@ -14,14 +14,20 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"path"
+	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/bep/logg"
 	"github.com/gobwas/glob"
 	"github.com/gohugoio/hugo/common/loggers"
+	"github.com/gohugoio/hugo/common/paths"
 	"github.com/gohugoio/hugo/common/types"
 
 	"github.com/gohugoio/hugo/common/herrors"
@@ -81,8 +87,9 @@ type LoadConfigResult struct {
 }
 
 var defaultBuild = BuildConfig{
-	UseResourceCacheWhen: "fallback",
-	BuildStats:           BuildStats{},
+	UseResourceCacheWhen:  "fallback",
+	BuildStats:            BuildStats{},
+	BuildManifestFilename: "hugo_build_manifest.json",
 
 	CacheBusters: []CacheBuster{
 		{
@@ -92,6 +99,12 @@ var defaultBuild = BuildConfig{
 	},
 }
 
+// The formats supported by build.redirectMap.
+const (
+	RedirectMapPlain = "plain"
+	RedirectMapJSON  = "json"
+)
+
 // BuildConfig holds some build related configuration.
 type BuildConfig struct {
 	// When to use the resource file cache.
@@ -107,12 +120,48 @@ type BuildConfig struct {
 	// Note that this was a bool <= v0.115.0.
 	BuildStats BuildStats
 
+	// When enabled, will write a build manifest to the root of the publish
+	// directory listing all published files with their size, hash and
+	// media type.
+	BuildManifest bool
+
+	// The filename of the build manifest, relative to the publish directory.
+	// Default is hugo_build_manifest.json.
+	BuildManifestFilename string
+
+	// When enabled, will write an integrity.json to the root of the publish
+	// directory mapping the path of every fingerprinted resource to its
+	// Subresource Integrity hash.
+	BuildIntegrity bool
+
+	// When set, will write a map from the path of every page alias to the
+	// canonical URL of the page to the root of the publish directory.
+	// Valid values are "plain", for a Netlify style _redirects file,
+	// and "json", for a redirects.json file.
+	RedirectMap string
+
+	// The maximum number of pages to render concurrently.
+	// The default (0) is the number of CPUs, see GetNumWorkerMultiplier.
+	// Lower this to reduce the memory usage when rendering large sites.
+	RenderConcurrency int
+
 	// Can be used to toggle off writing of the IntelliSense /assets/jsconfig.js
 	// file.
 	NoJSConfigInAssets bool
 
 	// Can used to control how the resource cache gets evicted on rebuilds.
 	CacheBusters []CacheBuster
+
+	// The permissions, as an octal string (e.g. "0644"), to set on files
+	// written to the publish directory. The default is to leave them as created.
+	FilePermissions string
+
+	// The permissions, as an octal string (e.g. "0755"), to set on directories
+	// created in the publish directory. The default is to leave them as created.
+	DirPermissions string
+
+	fileMode os.FileMode
+	dirMode  os.FileMode
 }
 
 // BuildStats configures if and what to write to the hugo_stats.json file.
@@ -175,9 +224,47 @@ func (b *BuildConfig) CompileConfig(logger loggers.Logger) error {
 		}
 		b.CacheBusters[i] = cb
 	}
+
+	b.RedirectMap = strings.ToLower(b.RedirectMap)
+	switch b.RedirectMap {
+	case "", RedirectMapPlain, RedirectMapJSON:
+	default:
+		return fmt.Errorf("failed to parse build.redirectMap: unknown format %q, must be %q or %q", b.RedirectMap, RedirectMapPlain, RedirectMapJSON)
+	}
+
+	var err error
+	if b.fileMode, err = parseFileMode(b.FilePermissions); err != nil {
+		return fmt.Errorf("failed to parse build.filePermissions: %w", err)
+	}
+	if b.dirMode, err = parseFileMode(b.DirPermissions); err != nil {
+		return fmt.Errorf("failed to parse build.dirPermissions: %w", err)
+	}
+
 	return nil
 }
 
+// FileMode returns the configured permissions for published files, 0 if not set.
+func (b BuildConfig) FileMode() os.FileMode {
+	return b.fileMode
+}
+
+// DirMode returns the configured permissions for published directories, 0 if not set.
+func (b BuildConfig) DirMode() os.FileMode {
+	return b.dirMode
+}
+
+func parseFileMode(s string) (os.FileMode, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	m, err := strconv.ParseUint(strings.TrimPrefix(strings.TrimPrefix(s, "0o"), "0O"), 8, 32)
+	if err != nil || m == 0 || m > 0o777 {
+		return 0, fmt.Errorf("invalid permissions %q, expected an octal number between 0001 and 0777", s)
+	}
+	return os.FileMode(m), nil
+}
+
 func DecodeBuildConfig(cfg Provider) BuildConfig {
 	m := cfg.GetStringMap("build")
 
@@ -199,6 +286,10 @@ func DecodeBuildConfig(cfg Provider) BuildConfig {
 	}
 
 	b.UseResourceCacheWhen = strings.ToLower(b.UseResourceCacheWhen)
+	b.BuildManifestFilename = strings.TrimPrefix(path.Clean("/"+filepath.ToSlash(b.BuildManifestFilename)), "/")
+	if b.BuildManifestFilename == "" {
+		b.BuildManifestFilename = defaultBuild.BuildManifestFilename
+	}
 	when := b.UseResourceCacheWhen
 	if when != "never" && when != "always" && when != "fallback" {
 		b.UseResourceCacheWhen = "fallback"
@@ -222,11 +313,42 @@ func DecodeSitemap(prototype SitemapConfig, input map[string]any) (SitemapConfig
 	return prototype, err
 }
 
+// ReadingTimeConfig configures the reading time calculation.
+type ReadingTimeConfig struct {
+	// The reading speed for words, e.g. in English. Default is 213.
+	WordsPerMinute int
+	// The reading speed for Chinese, Japanese and Korean characters,
+	// which are counted one by one. Default is 500.
+	CharactersPerMinute int
+}
+
+// DefaultReadingTimeConfig holds the default reading time configuration.
+var DefaultReadingTimeConfig = ReadingTimeConfig{
+	WordsPerMinute:      213,
+	CharactersPerMinute: 500,
+}
+
+func DecodeReadingTime(prototype ReadingTimeConfig, input map[string]any) (ReadingTimeConfig, error) {
+	if err := mapstructure.WeakDecode(input, &prototype); err != nil {
+		return prototype, err
+	}
+	if prototype.WordsPerMinute <= 0 || prototype.CharactersPerMinute <= 0 {
+		return prototype, errors.New("readingTime: wordsPerMinute and charactersPerMinute must be greater than 0")
+	}
+	return prototype, nil
+}
+
 // Config for the dev server.
 type Server struct {
 	Headers   []Headers
 	Redirects []Redirect
 
+	// Additional directories to watch for changes, e.g. directories with data
+	// files read by templates from outside of the project's file systems.
+	// A change in any of these will trigger a rebuild.
+	// Relative paths are resolved relative to the working directory.
+	WatchDirs []string
+
 	compiledHeaders   []glob.Glob
 	compiledRedirects []glob.Glob
 }
@@ -245,6 +367,16 @@ func (s *Server) CompileConfig(logger loggers.Logger) error {
 	return nil
 }
 
+// IsInWatchDirs reports whether filename is inside one of the configured WatchDirs.
+func (s *Server) IsInWatchDirs(filename string) bool {
+	for _, dir := range s.WatchDirs {
+		if filename == dir || strings.HasPrefix(filename, dir+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Server) MatchHeaders(pattern string) []types.KeyValueStr {
 	if s.compiledHeaders == nil {
 		return nil
@@ -308,6 +440,84 @@ type Redirect struct {
 	Force bool
 }
 
+// StaticPipeline configures transformations of static files.
+type StaticPipeline []StaticPipelineRule
+
+// StaticPipelineRule configures the transformations of the static files
+// matching Glob.
+type StaticPipelineRule struct {
+	// Glob pattern matching the path of the file relative to the static
+	// directory, e.g. "**.svg".
+	Glob string
+
+	// The transformations to apply, in order.
+	// Valid values are minify and fingerprint.
+	Transforms []string
+
+	compiledGlob glob.Glob
+}
+
+// Valid static pipeline transformations.
+const (
+	StaticTransformMinify      = "minify"
+	StaticTransformFingerprint = "fingerprint"
+)
+
+func (p StaticPipeline) CompileConfig(logger loggers.Logger) error {
+	for i, r := range p {
+		if r.compiledGlob != nil {
+			continue
+		}
+		g, err := glob.Compile(strings.ToLower(r.Glob), '/')
+		if err != nil {
+			return fmt.Errorf("failed to compile static pipeline glob %q: %w", r.Glob, err)
+		}
+		p[i].compiledGlob = g
+	}
+	return nil
+}
+
+// Match returns the transformations for the static file with the given
+// filename relative to the static directory, nil if none.
+func (p StaticPipeline) Match(filename string) []string {
+	filename = strings.ToLower(strings.TrimPrefix(filepath.ToSlash(filename), "/"))
+	for _, r := range p {
+		if r.compiledGlob != nil && r.compiledGlob.Match(filename) {
+			return r.Transforms
+		}
+	}
+	return nil
+}
+
+func DecodeStaticPipeline(cfg Provider) (StaticPipeline, error) {
+	var p StaticPipeline
+	v := cfg.Get("staticpipeline")
+	if v == nil {
+		return p, nil
+	}
+	if err := mapstructure.WeakDecode(v, &p); err != nil {
+		return p, fmt.Errorf("failed to decode staticPipeline: %w", err)
+	}
+	for i, r := range p {
+		if r.Glob == "" {
+			return p, fmt.Errorf("staticPipeline: glob must be set")
+		}
+		if len(r.Transforms) == 0 {
+			return p, fmt.Errorf("staticPipeline: no transforms set for %q", r.Glob)
+		}
+		for j, t := range r.Transforms {
+			t = strings.ToLower(t)
+			switch t {
+			case StaticTransformMinify, StaticTransformFingerprint:
+				p[i].Transforms[j] = t
+			default:
+				return p, fmt.Errorf("staticPipeline: unknown transform %q for %q; must be one of %s or %s", t, r.Glob, StaticTransformMinify, StaticTransformFingerprint)
+			}
+		}
+	}
+	return p, nil
+}
+
 // CacheBuster configures cache busting for assets.
 type CacheBuster struct {
 	// Trigger for files matching this regexp.
@@ -384,6 +594,11 @@ func DecodeServer(cfg Provider) (Server, error) {
 
 	_ = mapstructure.WeakDecode(cfg.GetStringMap("server"), s)
 
+	workingDir := cfg.GetString("workingDir")
+	for i, dir := range s.WatchDirs {
+		s.WatchDirs[i] = paths.AbsPathify(workingDir, dir)
+	}
+
 	for i, redir := range s.Redirects {
 		// Get it in line with the Hugo server for OK responses.
 		// We currently treat the 404 as a special case, they are always "ugly", so keep them as is.
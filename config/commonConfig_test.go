@@ -15,6 +15,7 @@ package config
 
 import (
 	"errors"
+	"os"
 	"testing"
 
 	"github.com/gohugoio/hugo/common/herrors"
@@ -35,6 +36,15 @@ func TestBuild(t *testing.T) {
 	b := DecodeBuildConfig(v)
 
 	c.Assert(b.UseResourceCacheWhen, qt.Equals, "always")
+	c.Assert(b.RenderConcurrency, qt.Equals, 0)
+
+	v.Set("build", map[string]any{
+		"renderConcurrency": 2,
+	})
+
+	b = DecodeBuildConfig(v)
+
+	c.Assert(b.RenderConcurrency, qt.Equals, 2)
 
 	v.Set("build", map[string]any{
 		"useResourceCacheWhen": "foo",
@@ -189,3 +199,63 @@ func TestBuildConfigCacheBusterstTailwindSetup(t *testing.T) {
 	c.Assert(err, qt.IsNil)
 	c.Assert(m("css"), qt.IsTrue)
 }
+
+func TestBuildConfigPermissions(t *testing.T) {
+	c := qt.New(t)
+	l := loggers.NewDefault()
+
+	conf := DecodeBuildConfig(New())
+	c.Assert(conf.CompileConfig(l), qt.IsNil)
+	c.Assert(conf.FileMode(), qt.Equals, os.FileMode(0))
+	c.Assert(conf.DirMode(), qt.Equals, os.FileMode(0))
+
+	cfg := New()
+	cfg.Set("build", map[string]any{
+		"filePermissions": "0640",
+		"dirPermissions":  "0o750",
+	})
+	conf = DecodeBuildConfig(cfg)
+	c.Assert(conf.CompileConfig(l), qt.IsNil)
+	c.Assert(conf.FileMode(), qt.Equals, os.FileMode(0o640))
+	c.Assert(conf.DirMode(), qt.Equals, os.FileMode(0o750))
+
+	for _, perm := range []string{"rw-r--r--", "0888", "01777", "0"} {
+		cfg := New()
+		cfg.Set("build", map[string]any{"filePermissions": perm})
+		conf = DecodeBuildConfig(cfg)
+		c.Assert(conf.CompileConfig(l), qt.ErrorMatches, `failed to parse build.filePermissions: invalid permissions.*`, qt.Commentf(perm))
+	}
+}
+
+func TestDecodeStaticPipeline(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := New()
+	cfg.Set("staticPipeline", []map[string]any{
+		{"glob": "**.svg", "transforms": []string{"Minify", "fingerprint"}},
+		{"glob": "css/*.css", "transforms": []string{"minify"}},
+	})
+
+	p, err := DecodeStaticPipeline(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(p.CompileConfig(loggers.NewDefault()), qt.IsNil)
+
+	c.Assert(p.Match("images/logo.SVG"), qt.DeepEquals, []string{"minify", "fingerprint"})
+	c.Assert(p.Match("/css/main.css"), qt.DeepEquals, []string{"minify"})
+	c.Assert(p.Match("css/vendor/lib.css"), qt.IsNil)
+	c.Assert(p.Match("main.js"), qt.IsNil)
+
+	for _, test := range []struct {
+		rule   map[string]any
+		expect string
+	}{
+		{map[string]any{"transforms": []string{"minify"}}, `.*glob must be set`},
+		{map[string]any{"glob": "**.svg"}, `.*no transforms set for "\*\*.svg"`},
+		{map[string]any{"glob": "**.svg", "transforms": []string{"gzip"}}, `.*unknown transform "gzip".*`},
+	} {
+		cfg := New()
+		cfg.Set("staticPipeline", []map[string]any{test.rule})
+		_, err := DecodeStaticPipeline(cfg)
+		c.Assert(err, qt.ErrorMatches, test.expect)
+	}
+}
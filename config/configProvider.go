@@ -62,6 +62,7 @@ type AllProvider interface {
 	TemplateMetrics() bool
 	TemplateMetricsHints() bool
 	PrintI18nWarnings() bool
+	CollectMissingTranslations() bool
 	CreateTitle(s string) string
 	IgnoreFile(s string) bool
 	NewContentEditor() string
@@ -70,6 +71,7 @@ type AllProvider interface {
 	IgnoredLogs() map[string]bool
 	WorkingDir() string
 	EnableEmoji() bool
+	RandomSeed() int64
 }
 
 // Provider provides the configuration settings for Hugo.
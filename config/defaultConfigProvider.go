@@ -43,11 +43,14 @@ var (
 		"module":        true,
 		"outputformats": true,
 		"params":        true,
+		"paramsschema":  true,
 		"permalinks":    true,
 		"related":       true,
 		"sitemap":       true,
 		"privacy":       true,
+		"readingtime":   true,
 		"security":      true,
+		"shortcodes":    true,
 		"taxonomies":    true,
 	}
 
@@ -328,6 +331,10 @@ func (c *defaultConfigProvider) determineMergeStrategy(params ...maps.KeyParams)
 	// This will be handled as a special case.
 	case "params":
 		strategy = maps.ParamsMergeStrategyDeep
+	case "paramsschema":
+		if prevIsRoot {
+			strategy = maps.ParamsMergeStrategyDeep
+		}
 	case "outputformats", "mediatypes":
 		if prevIsRoot {
 			strategy = maps.ParamsMergeStrategyShallow
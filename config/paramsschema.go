@@ -0,0 +1,202 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/spf13/cast"
+)
+
+// The param types supported in the params schema.
+const (
+	ParamTypeAny    = "any"
+	ParamTypeBool   = "bool"
+	ParamTypeDate   = "date"
+	ParamTypeFloat  = "float"
+	ParamTypeInt    = "int"
+	ParamTypeMap    = "map"
+	ParamTypeSlice  = "slice"
+	ParamTypeString = "string"
+)
+
+var paramTypes = map[string]bool{
+	ParamTypeAny:    true,
+	ParamTypeBool:   true,
+	ParamTypeDate:   true,
+	ParamTypeFloat:  true,
+	ParamTypeInt:    true,
+	ParamTypeMap:    true,
+	ParamTypeSlice:  true,
+	ParamTypeString: true,
+}
+
+// ParamsSchema describes the expected site params.
+type ParamsSchema struct {
+	// When enabled, unknown params and params of the wrong type fail the
+	// build. If not, they are logged as warnings.
+	Strict bool
+
+	// Maps param names to their type, one of string, bool, int, float, date,
+	// slice, map or any. A nested map describes a nested params section.
+	Params maps.Params
+}
+
+// IsZero returns whether no schema is configured.
+func (s ParamsSchema) IsZero() bool {
+	return len(s.Params) == 0
+}
+
+// DecodeParamsSchema decodes the paramsSchema section in cfg.
+func DecodeParamsSchema(cfg Provider) (ParamsSchema, error) {
+	var s ParamsSchema
+	m := cfg.GetStringMap("paramsSchema")
+	if m == nil {
+		return s, nil
+	}
+
+	if v, found := m["strict"]; found {
+		strict, err := cast.ToBoolE(v)
+		if err != nil {
+			return s, fmt.Errorf("paramsSchema.strict: %w", err)
+		}
+		s.Strict = strict
+	}
+
+	if v, found := m["params"]; found {
+		params, err := maps.ToParamsAndPrepare(v)
+		if err != nil {
+			return s, fmt.Errorf("paramsSchema.params: %w", err)
+		}
+		if err := checkParamsSchema("", params); err != nil {
+			return s, err
+		}
+		s.Params = params
+	}
+
+	return s, nil
+}
+
+func checkParamsSchema(prefix string, schema maps.Params) error {
+	for k, v := range schema {
+		if k == maps.MergeStrategyKey {
+			continue
+		}
+		switch vv := v.(type) {
+		case maps.Params:
+			if err := checkParamsSchema(prefix+k+".", vv); err != nil {
+				return err
+			}
+		case string:
+			if !paramTypes[strings.ToLower(vv)] {
+				return fmt.Errorf("paramsSchema.params.%s%s: unknown type %q", prefix, k, vv)
+			}
+			schema[k] = strings.ToLower(vv)
+		default:
+			return fmt.Errorf("paramsSchema.params.%s%s: expected a type name or a map, got %T", prefix, k, v)
+		}
+	}
+	return nil
+}
+
+// Validate validates params against the schema and returns one error per
+// unknown param or param of the wrong type, sorted by the param's key.
+func (s ParamsSchema) Validate(params maps.Params) []error {
+	if s.IsZero() {
+		return nil
+	}
+	var errs []error
+	validateParams("", s.Params, params, &errs)
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Error() < errs[j].Error()
+	})
+	return errs
+}
+
+func validateParams(prefix string, schema, params maps.Params, errs *[]error) {
+	for k, v := range params {
+		if k == maps.MergeStrategyKey {
+			continue
+		}
+		key := prefix + k
+		expect, found := schema[k]
+		if !found {
+			*errs = append(*errs, fmt.Errorf("param %q: not defined in paramsSchema", key))
+			continue
+		}
+		if v == nil {
+			continue
+		}
+		switch vv := expect.(type) {
+		case maps.Params:
+			m, ok := v.(maps.Params)
+			if !ok {
+				*errs = append(*errs, fmt.Errorf("param %q: expected map, got %T", key, v))
+				continue
+			}
+			validateParams(key+".", vv, m, errs)
+		case string:
+			if !isParamType(vv, v) {
+				*errs = append(*errs, fmt.Errorf("param %q: expected %s, got %T", key, vv, v))
+			}
+		}
+	}
+}
+
+func isParamType(typ string, v any) bool {
+	if typ == ParamTypeAny {
+		return true
+	}
+
+	if typ == ParamTypeDate {
+		switch vv := v.(type) {
+		case time.Time:
+			return true
+		case string:
+			_, err := cast.ToTimeE(vv)
+			return err == nil
+		}
+		return false
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return typ == ParamTypeString
+	case reflect.Bool:
+		return typ == ParamTypeBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return typ == ParamTypeInt || typ == ParamTypeFloat
+	case reflect.Float32, reflect.Float64:
+		if typ == ParamTypeInt {
+			// E.g. JSON numbers.
+			f := rv.Float()
+			return f == math.Trunc(f)
+		}
+		return typ == ParamTypeFloat
+	case reflect.Slice, reflect.Array:
+		return typ == ParamTypeSlice
+	case reflect.Map:
+		return typ == ParamTypeMap
+	}
+
+	return false
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/common/maps"
+)
+
+func TestParamsSchema(t *testing.T) {
+	c := qt.New(t)
+
+	v := New()
+	v.Set("paramsSchema", map[string]any{
+		"strict": true,
+		"params": map[string]any{
+			"googleAnalytics": "string",
+			"count":           "int",
+			"ratio":           "float",
+			"published":       "date",
+			"tags":            "slice",
+			"extra":           "any",
+			"author": map[string]any{
+				"name": "String",
+			},
+		},
+	})
+
+	s, err := DecodeParamsSchema(v)
+	c.Assert(err, qt.IsNil)
+	c.Assert(s.Strict, qt.IsTrue)
+
+	valid := maps.Params{
+		"googleanalytics": "G-123",
+		"count":           float64(3),
+		"ratio":           2,
+		"published":       time.Now(),
+		"tags":            []any{"a"},
+		"extra":           struct{}{},
+		"author":          maps.Params{"name": "Jo"},
+		"_merge":          "deep",
+	}
+	c.Assert(s.Validate(valid), qt.HasLen, 0)
+
+	errs := s.Validate(maps.Params{
+		"googleanalytcs": "G-123",
+		"count":          3.5,
+		"published":      "not a date",
+		"author":         maps.Params{"name": 32},
+	})
+	c.Assert(errs, qt.HasLen, 4)
+	c.Assert(errs[0], qt.ErrorMatches, `param "author.name": expected string, got int`)
+	c.Assert(errs[1], qt.ErrorMatches, `param "count": expected int, got float64`)
+	c.Assert(errs[2], qt.ErrorMatches, `param "googleanalytcs": not defined in paramsSchema`)
+	c.Assert(errs[3], qt.ErrorMatches, `param "published": expected date, got string`)
+
+	c.Assert(ParamsSchema{}.Validate(valid), qt.IsNil)
+
+	v.Set("paramsSchema", map[string]any{
+		"params": map[string]any{
+			"foo": "text",
+		},
+	})
+	_, err = DecodeParamsSchema(v)
+	c.Assert(err, qt.ErrorMatches, `paramsSchema.params.foo: unknown type "text"`)
+}
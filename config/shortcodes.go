@@ -0,0 +1,70 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/spf13/cast"
+)
+
+// ShortcodesConfig configures the shortcodes.
+type ShortcodesConfig struct {
+	// Maps shortcode names to the partial to render for that shortcode,
+	// e.g. "partials/button.html". The partial receives the shortcode context.
+	// A shortcode template with the same name takes precedence.
+	Delegate map[string]string
+}
+
+// DelegatePartial returns the name of the partial template the shortcode
+// with the given name delegates to, e.g. "partials/button.html".
+func (c ShortcodesConfig) DelegatePartial(name string) (string, bool) {
+	v, found := c.Delegate[strings.ToLower(name)]
+	if !found {
+		return "", false
+	}
+	if !strings.HasPrefix(v, "partials/") {
+		v = "partials/" + v
+	}
+	return v, true
+}
+
+// DecodeShortcodesConfig decodes the shortcodes section in cfg.
+func DecodeShortcodesConfig(cfg Provider) (ShortcodesConfig, error) {
+	var c ShortcodesConfig
+	m := cfg.GetStringMap("shortcodes")
+	if m == nil {
+		return c, nil
+	}
+
+	if v, found := m["delegate"]; found {
+		delegate, err := maps.ToStringMapE(v)
+		if err != nil {
+			return c, fmt.Errorf("shortcodes.delegate: %w", err)
+		}
+		delegate = maps.CleanConfigStringMap(delegate)
+		c.Delegate = make(map[string]string, len(delegate))
+		for name, vv := range delegate {
+			partial, err := cast.ToStringE(vv)
+			if err != nil || partial == "" {
+				return c, fmt.Errorf("shortcodes.delegate.%s: must be the name of a partial", name)
+			}
+			c.Delegate[strings.ToLower(name)] = strings.TrimPrefix(partial, "/")
+		}
+	}
+
+	return c, nil
+}
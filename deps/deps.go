@@ -14,6 +14,7 @@ import (
 	"github.com/gohugoio/hugo/cache/dynacache"
 	"github.com/gohugoio/hugo/cache/filecache"
 	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/common/hrand"
 	"github.com/gohugoio/hugo/common/loggers"
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/config/allconfig"
@@ -90,6 +91,10 @@ type Deps struct {
 	// This is common/global for all sites.
 	BuildState *BuildState
 
+	// The random number generators used by the template functions.
+	// This is common/global for all sites.
+	Random *hrand.Source
+
 	*globalErrHandler
 }
 
@@ -106,6 +111,18 @@ func (d Deps) Clone(s page.Site, conf config.AllProvider) (*Deps, error) {
 	return &d, nil
 }
 
+// Rand returns the random number generator for the page being rendered in ctx.
+func (d *Deps) Rand(ctx context.Context) *hrand.Rand {
+	var key string
+	if p, ok := tpl.Context.Page.Get(ctx).(interface {
+		Lang() string
+		Path() string
+	}); ok {
+		key = p.Lang() + p.Path()
+	}
+	return d.Random.Rand(key)
+}
+
 func (d *Deps) SetTempl(t *tpl.TemplateHandlers) {
 	d.tmplHandlers = t
 }
@@ -134,6 +151,10 @@ func (d *Deps) Init() error {
 		d.BuildState = &BuildState{}
 	}
 
+	if d.Random == nil {
+		d.Random = hrand.NewSource(d.Conf.RandomSeed())
+	}
+
 	if d.BuildStartListeners == nil {
 		d.BuildStartListeners = &Listeners{}
 	}
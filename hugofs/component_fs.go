@@ -111,6 +111,10 @@ func (f *componentFsDir) ReadDir(count int) ([]iofs.DirEntry, error) {
 		}
 		fimim, fimjm := fimi.Meta(), fimj.Meta()
 
+		if fimim.Priority != fimjm.Priority && f.fs.opts.Component == files.ComponentFolderContent {
+			return fimim.Priority > fimjm.Priority
+		}
+
 		if fimim.ModuleOrdinal != fimjm.ModuleOrdinal {
 			switch f.fs.opts.Component {
 			case files.ComponentFolderI18n:
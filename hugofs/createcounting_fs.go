@@ -33,6 +33,11 @@ type DuplicatesReporter interface {
 	ReportDuplicates() string
 }
 
+// CreatedFilenamesReporter reports the filenames of created files.
+type CreatedFilenamesReporter interface {
+	ReportCreatedFilenames() []string
+}
+
 var _ FilesystemUnwrapper = (*createCountingFs)(nil)
 
 func NewCreateCountingFs(fs afero.Fs) afero.Fs {
@@ -65,6 +70,20 @@ func (c *createCountingFs) ReportDuplicates() string {
 	return strings.Join(dupes, ", ")
 }
 
+// ReportCreatedFilenames reports the sorted filenames of all created files.
+func (c *createCountingFs) ReportCreatedFilenames() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	filenames := make([]string, 0, len(c.fileCount))
+	for k := range c.fileCount {
+		filenames = append(filenames, k)
+	}
+	sort.Strings(filenames)
+
+	return filenames
+}
+
 // createCountingFs counts filenames of created files or files opened
 // for writing.
 type createCountingFs struct {
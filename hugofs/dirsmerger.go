@@ -25,10 +25,14 @@ var LanguageDirsMerger overlayfs.DirsMerger = func(lofi, bofi []fs.DirEntry) []f
 	for _, fi1 := range bofi {
 		fim1 := fi1.(FileMetaInfo)
 		var found bool
-		for _, fi2 := range lofi {
+		for i, fi2 := range lofi {
 			fim2 := fi2.(FileMetaInfo)
 			if fi1.Name() == fi2.Name() && fim1.Meta().Lang == fim2.Meta().Lang {
 				found = true
+				if !fi1.IsDir() && !fi2.IsDir() && fim1.Meta().Priority > fim2.Meta().Priority {
+					// The file in the higher priority mount wins.
+					lofi[i] = fi1
+				}
 				break
 			}
 		}
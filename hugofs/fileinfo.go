@@ -58,6 +58,11 @@ type FileMeta struct {
 	IsProject bool
 	Watch     bool
 
+	// The priority of the content mount this file belongs to.
+	// Files in higher priority mounts win over files with the
+	// same name in lower priority mounts.
+	Priority int
+
 	// The lang associated with this file. This may be
 	// either the language set in the filename or
 	// the language defined in the source mount configuration.
@@ -27,6 +27,13 @@ const (
 	FilenamePackageJSON = "package.json"
 
 	FilenameHugoStatsJSON = "hugo_stats.json"
+
+	// The Subresource Integrity map written to the publish directory.
+	FilenameIntegrityJSON = "integrity.json"
+
+	// The redirect maps written to the publish directory.
+	FilenameRedirects     = "_redirects"
+	FilenameRedirectsJSON = "redirects.json"
 )
 
 var (
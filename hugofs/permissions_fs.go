@@ -0,0 +1,145 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugofs
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+)
+
+// PermissionsSetter is implemented by filesystems that set the permissions
+// of the files and directories they create.
+type PermissionsSetter interface {
+	// Permissions returns the file and directory modes set, 0 if not set.
+	Permissions() (fileMode, dirMode os.FileMode)
+}
+
+var (
+	_ FilesystemUnwrapper = (*permissionsFs)(nil)
+	_ PermissionsSetter   = (*permissionsFs)(nil)
+)
+
+// NewPermissionsFs creates a new filesystem that sets fileMode on the files it
+// creates and dirMode on the directories it creates.
+// A zero mode leaves the permissions of that kind as created by fs.
+func NewPermissionsFs(fs afero.Fs, fileMode, dirMode os.FileMode) afero.Fs {
+	return &permissionsFs{
+		Fs:       fs,
+		fileMode: fileMode.Perm(),
+		dirMode:  dirMode.Perm(),
+	}
+}
+
+// permissionsFs sets the permissions on created files and directories.
+// The umask does not apply, as the permissions are set with an explicit chmod.
+type permissionsFs struct {
+	afero.Fs
+
+	fileMode os.FileMode
+	dirMode  os.FileMode
+}
+
+func (fs *permissionsFs) UnwrapFilesystem() afero.Fs {
+	return fs.Fs
+}
+
+func (fs *permissionsFs) Permissions() (fileMode, dirMode os.FileMode) {
+	return fs.fileMode, fs.dirMode
+}
+
+func (fs *permissionsFs) Create(name string) (afero.File, error) {
+	f, err := fs.Fs.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	return f, fs.chmodFile(f, name)
+}
+
+func (fs *permissionsFs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	f, err := fs.Fs.OpenFile(name, flag, perm)
+	if err != nil || flag&os.O_CREATE == 0 {
+		return f, err
+	}
+	return f, fs.chmodFile(f, name)
+}
+
+func (fs *permissionsFs) Mkdir(name string, perm os.FileMode) error {
+	if err := fs.Fs.Mkdir(name, perm); err != nil {
+		return err
+	}
+	if fs.dirMode == 0 {
+		return nil
+	}
+	return fs.Fs.Chmod(name, fs.dirMode)
+}
+
+func (fs *permissionsFs) MkdirAll(path string, perm os.FileMode) error {
+	if fs.dirMode == 0 {
+		return fs.Fs.MkdirAll(path, perm)
+	}
+
+	// Collect the directories that need to be created, so we
+	// leave the permissions of any existing directory alone.
+	var created []string
+	for dir := filepath.Clean(path); ; {
+		if _, err := fs.Fs.Stat(dir); err == nil {
+			break
+		}
+		created = append(created, dir)
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+
+	if err := fs.Fs.MkdirAll(path, perm); err != nil {
+		return err
+	}
+
+	for _, dir := range created {
+		if err := fs.Fs.Chmod(dir, fs.dirMode); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Chmod replaces the permissions in mode with the configured ones, if set.
+// This covers file copying that preserves the permissions of the source,
+// e.g. when syncing the static files.
+func (fs *permissionsFs) Chmod(name string, mode os.FileMode) error {
+	if mode.IsDir() {
+		if fs.dirMode != 0 {
+			mode = mode&^os.ModePerm | fs.dirMode
+		}
+	} else if fs.fileMode != 0 {
+		mode = mode&^os.ModePerm | fs.fileMode
+	}
+	return fs.Fs.Chmod(name, mode)
+}
+
+func (fs *permissionsFs) chmodFile(f afero.File, name string) error {
+	if fs.fileMode == 0 {
+		return nil
+	}
+	if err := fs.Fs.Chmod(name, fs.fileMode); err != nil {
+		f.Close()
+		return err
+	}
+	return nil
+}
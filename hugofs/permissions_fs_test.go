@@ -0,0 +1,78 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugofs
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/spf13/afero"
+)
+
+func TestPermissionsFs(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("permissions not supported on Windows")
+	}
+	c := qt.New(t)
+
+	base := afero.NewBasePathFs(afero.NewOsFs(), t.TempDir())
+	c.Assert(base.MkdirAll("existing", 0o700), qt.IsNil)
+
+	fs := NewPermissionsFs(base, 0o640, 0o750)
+
+	assertMode := func(name string, expect os.FileMode) {
+		c.Helper()
+		fi, err := base.Stat(name)
+		c.Assert(err, qt.IsNil)
+		c.Assert(fi.Mode().Perm(), qt.Equals, expect, qt.Commentf(name))
+	}
+
+	c.Assert(fs.MkdirAll(filepath.FromSlash("existing/a/b"), 0o777), qt.IsNil)
+	assertMode("existing", 0o700)
+	assertMode(filepath.FromSlash("existing/a"), 0o750)
+	assertMode(filepath.FromSlash("existing/a/b"), 0o750)
+
+	c.Assert(fs.Mkdir("c", 0o777), qt.IsNil)
+	assertMode("c", 0o750)
+
+	f, err := fs.Create(filepath.FromSlash("existing/a/f1.txt"))
+	c.Assert(err, qt.IsNil)
+	c.Assert(f.Close(), qt.IsNil)
+	assertMode(filepath.FromSlash("existing/a/f1.txt"), 0o640)
+
+	f, err = fs.OpenFile("f2.txt", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o666)
+	c.Assert(err, qt.IsNil)
+	c.Assert(f.Close(), qt.IsNil)
+	assertMode("f2.txt", 0o640)
+
+	// Copying the source permissions.
+	c.Assert(fs.Chmod("f2.txt", 0o600), qt.IsNil)
+	assertMode("f2.txt", 0o640)
+	c.Assert(fs.Chmod("c", os.ModeDir|0o700), qt.IsNil)
+	assertMode("c", 0o750)
+
+	// Only set the file permissions.
+	fs = NewPermissionsFs(base, 0o600, 0)
+	c.Assert(fs.MkdirAll("d", 0o700), qt.IsNil)
+	assertMode("d", 0o700)
+	c.Assert(fs.Chmod("d", os.ModeDir|0o701), qt.IsNil)
+	assertMode("d", 0o701)
+	f, err = fs.Create(filepath.FromSlash("d/f3.txt"))
+	c.Assert(err, qt.IsNil)
+	c.Assert(f.Close(), qt.IsNil)
+	assertMode(filepath.FromSlash("d/f3.txt"), 0o600)
+}
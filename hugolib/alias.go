@@ -100,6 +100,7 @@ func (s *Site) publishDestAlias(allowRoot bool, path, permalink string, outputFo
 
 	if s.conf.RelativeURLs || s.conf.CanonifyURLs {
 		pd.AbsURLPath = s.absURLPath(targetPath)
+		pd.AbsURLBasePath = s.absURLBasePath()
 	}
 
 	return s.publisher.Publish(pd)
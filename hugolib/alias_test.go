@@ -17,6 +17,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
 	qt "github.com/frankban/quicktest"
@@ -159,3 +160,118 @@ func TestTargetPathHTMLRedirectAlias(t *testing.T) {
 		}
 	}
 }
+
+func TestAliasRedirectMap(t *testing.T) {
+	t.Parallel()
+
+	filesTemplate := `
+-- hugo.toml --
+baseURL = "https://example.org/docs/"
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+[build]
+redirectMap = "FORMAT"
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+-- content/blog/p1.md --
+---
+title: p1
+aliases: ["/old/p1/", "rel", "/old/p1.html", "/with space/"]
+---
+-- content/blog/p1.nn.md --
+---
+title: p1 nn
+aliases: ["/nn/old/p1/"]
+---
+-- content/blog/p2.md --
+---
+title: p2
+---
+-- layouts/_default/single.html --
+{{ .Title }}
+-- layouts/_default/list.html --
+{{ .Title }}
+`
+
+	b := Test(t, strings.ReplaceAll(filesTemplate, "FORMAT", "plain"))
+
+	b.AssertFileContent("public/_redirects", `
+/docs/blog/rel/ https://example.org/docs/blog/p1/ 301
+/docs/nn/old/p1/ https://example.org/docs/nn/blog/p1/ 301
+/docs/old/p1.html https://example.org/docs/blog/p1/ 301
+/docs/old/p1/ https://example.org/docs/blog/p1/ 301
+/docs/with%20space/ https://example.org/docs/blog/p1/ 301
+`)
+	b.AssertFileExists("public/redirects.json", false)
+
+	b = Test(t, strings.ReplaceAll(filesTemplate, "FORMAT", "JSON"))
+
+	b.AssertFileContent("public/redirects.json", `{
+  "/docs/blog/rel/": "https://example.org/docs/blog/p1/",
+  "/docs/nn/old/p1/": "https://example.org/docs/nn/blog/p1/",
+  "/docs/old/p1.html": "https://example.org/docs/blog/p1/",
+  "/docs/old/p1/": "https://example.org/docs/blog/p1/",
+  "/docs/with%20space/": "https://example.org/docs/blog/p1/"
+}`)
+	b.AssertFileExists("public/_redirects", false)
+
+	b = Test(t, strings.ReplaceAll(filesTemplate, "FORMAT", ""))
+	b.AssertFileExists("public/_redirects", false)
+	b.AssertFileExists("public/redirects.json", false)
+
+	_, err := TestE(t, strings.ReplaceAll(filesTemplate, "FORMAT", "yaml"))
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `failed to parse build.redirectMap: unknown format "yaml"`)
+
+	// Do not overwrite a file with the same name in static.
+	_, err = TestE(t, strings.ReplaceAll(filesTemplate, "FORMAT", "plain")+`
+-- static/_redirects --
+/a/ /b/ 301
+`)
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `build.redirectMap: "_redirects" exists in the static directory`)
+
+	b = Test(t, strings.ReplaceAll(filesTemplate, "FORMAT", "json")+`
+-- static/_redirects --
+/a/ /b/ 301
+`)
+	b.AssertFileContent("public/redirects.json", `"/docs/old/p1/": "https://example.org/docs/blog/p1/",`)
+}
+
+func TestAliasRedirectMapMultihost(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+[build]
+redirectMap = "plain"
+[languages.en]
+baseURL = "https://en.example.org/"
+weight = 1
+[languages.nn]
+baseURL = "https://nn.example.org/"
+weight = 2
+-- content/p1.md --
+---
+title: p1
+aliases: ["/old/"]
+---
+-- content/p1.nn.md --
+---
+title: p1 nn
+aliases: ["/gamal/"]
+---
+-- layouts/_default/single.html --
+{{ .Title }}
+-- layouts/_default/list.html --
+{{ .Title }}
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/en/_redirects", "/old/ https://en.example.org/p1/ 301")
+	b.AssertFileContent("public/nn/_redirects", "/gamal/ https://nn.example.org/p1/ 301")
+	b.AssertFileContent("public/nn/gamal/index.html", "https://nn.example.org/p1/")
+}
@@ -1491,7 +1491,7 @@ func TestKindsUnknown(t *testing.T) {
 
 	files := `
 -- hugo.toml --
-disableKinds = ['foo', 'home']
+disableKinds = ['home']
 [outputs]
 foo = ['HTML', 'AMP', 'RSS']
 -- layouts/_default/list.html --
@@ -1508,8 +1508,13 @@ List.
 		},
 	).Init()
 
-	b.AssertLogContains("WARN  Unknown kind \"foo\" in disableKinds configuration.\n")
 	b.AssertLogContains("WARN  Unknown kind \"foo\" in outputs configuration.\n")
+
+	files = strings.Replace(files, "disableKinds = ['home']", "disableKinds = ['foo', 'home']", 1)
+
+	b, err := TestE(t, files)
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `unknown kind "foo" in disableKinds configuration`)
 }
 
 func TestDeprecateTaxonomyTerm(t *testing.T) {
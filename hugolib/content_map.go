@@ -238,6 +238,9 @@ func (m *pageMap) AddFi(fi hugofs.FileMetaInfo) error {
 			// Disabled page.
 			return nil
 		}
+		if pi.IsBundle() {
+			p.m.f.SetBundle(pageBundle{p: p})
+		}
 
 		m.treePages.InsertWithLock(pi.Base(), p)
 
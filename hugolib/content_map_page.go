@@ -16,6 +16,7 @@ package hugolib
 import (
 	"context"
 	"fmt"
+	"html/template"
 	"io"
 	"path"
 	"sort"
@@ -26,6 +27,7 @@ import (
 	"time"
 
 	"github.com/bep/logg"
+	"github.com/gobwas/glob"
 	"github.com/gohugoio/hugo/cache/dynacache"
 	"github.com/gohugoio/hugo/common/loggers"
 	"github.com/gohugoio/hugo/common/paths"
@@ -99,6 +101,9 @@ type pageMap struct {
 	cacheContentPlain      *dynacache.Partition[string, *resources.StaleValue[contentPlainPlainWords]]
 	contentTableOfContents *dynacache.Partition[string, *resources.StaleValue[contentTableOfContents]]
 
+	// Rendered content with the shortcodes removed, used by PlainText.
+	cacheContentRenderedNoShortcodes *dynacache.Partition[string, *resources.StaleValue[template.HTML]]
+
 	cfg contentMapConfig
 }
 
@@ -355,6 +360,45 @@ func (m *pageMap) getPagesInSection(q pageMapQueryPagesInSection) page.Pages {
 	return pages
 }
 
+// getPagesMatching returns all pages below prefix with a logical path matching g.
+// pattern is used as the cache key.
+func (m *pageMap) getPagesMatching(pattern, prefix string, g glob.Glob) page.Pages {
+	pages, err := m.getOrCreatePagesFromCache("/getpages"+pattern, func(string) (page.Pages, error) {
+		var pas page.Pages
+
+		if prefix == "" {
+			// The home page is stored with an empty key.
+			if p, ok := m.treePages.Get("").(*pageState); ok && g.Match(p.Path()) {
+				pas = append(pas, p)
+			}
+		}
+
+		w := &doctree.NodeShiftTreeWalker[contentNodeI]{
+			Tree:   m.treePages,
+			Prefix: prefix,
+			Handle: func(key string, n contentNodeI, match doctree.DimensionFlag) (bool, error) {
+				if p, ok := n.(*pageState); ok && key != "" && g.Match(p.Path()) {
+					pas = append(pas, p)
+				}
+				return false, nil
+			},
+		}
+
+		if err := w.Walk(context.Background()); err != nil {
+			return nil, err
+		}
+
+		page.SortByDefault(pas)
+
+		return pas, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return pages
+}
+
 func (m *pageMap) getPagesWithTerm(q pageMapQueryPagesBelowPath) page.Pages {
 	key := q.Key()
 
@@ -370,7 +414,7 @@ func (m *pageMap) getPagesWithTerm(q pageMapQueryPagesBelowPath) page.Pages {
 			paths.AddTrailingSlash(q.Path),
 			func(s string, n *weightedContentNode) (bool, error) {
 				p := n.n.(*pageState)
-				if !include(p) {
+				if !p.m.pageConfig.Build.ListInTerms || !include(p) {
 					return false, nil
 				}
 				pas = append(pas, pageWithWeight0{n.weight, p})
@@ -819,6 +863,8 @@ func newPageMap(i int, s *Site, mcache *dynacache.Cache, pageTrees *pageTrees) *
 		cacheContentPlain:      dynacache.GetOrCreatePartition[string, *resources.StaleValue[contentPlainPlainWords]](mcache, fmt.Sprintf("/cont/pla/%d", i), dynacache.OptionsPartition{Weight: 70, ClearWhen: dynacache.ClearOnChange}),
 		contentTableOfContents: dynacache.GetOrCreatePartition[string, *resources.StaleValue[contentTableOfContents]](mcache, fmt.Sprintf("/cont/toc/%d", i), dynacache.OptionsPartition{Weight: 70, ClearWhen: dynacache.ClearOnChange}),
 
+		cacheContentRenderedNoShortcodes: dynacache.GetOrCreatePartition[string, *resources.StaleValue[template.HTML]](mcache, fmt.Sprintf("/cont/rns/%d", i), dynacache.OptionsPartition{Weight: 70, ClearWhen: dynacache.ClearOnChange}),
+
 		cfg: contentMapConfig{
 			lang:                 s.Lang(),
 			taxonomyConfig:       taxonomiesConfig.Values(),
@@ -1865,7 +1911,12 @@ func (m *pageMap) CreateSiteTaxonomies(ctx context.Context) error {
 						doctree.LockTypeRead,
 						paths.AddTrailingSlash(s),
 						func(s string, wn *weightedContentNode) (bool, error) {
-							taxonomy[k] = append(taxonomy[k], page.NewWeightedPage(wn.weight, wn.n.(page.Page), wn.term.Page()))
+							wp := wn.n.(*pageState)
+							if wp.m.pageConfig.Build.ListInTerms {
+								taxonomy[k] = append(taxonomy[k], page.NewWeightedPage(wn.weight, wp, wn.term.Page()))
+							} else {
+								taxonomy[k] = append(taxonomy[k], page.NewUnlistedWeightedPage(wn.weight, wp, wn.term.Page()))
+							}
 							return false, nil
 						},
 					)
@@ -0,0 +1,198 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	kopenapi3 "github.com/getkin/kin-openapi/openapi3"
+	"github.com/gobwas/glob"
+	"github.com/gohugoio/hugo/common/maps"
+	hglob "github.com/gohugoio/hugo/hugofs/glob"
+	"github.com/gohugoio/hugo/parser/metadecoders"
+	"github.com/spf13/afero"
+)
+
+// dataSchemas validates data files against the JSON Schemas configured in dataSchemas.
+type dataSchemas []dataSchema
+
+type dataSchema struct {
+	pattern  string
+	filename string
+	glob     glob.Glob
+	schema   *kopenapi3.Schema
+}
+
+func (h *HugoSites) loadDataSchemas() (dataSchemas, error) {
+	patterns := make([]string, 0, len(h.Configs.Base.DataSchemas))
+	for pattern := range h.Configs.Base.DataSchemas {
+		if pattern == maps.MergeStrategyKey {
+			continue
+		}
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+
+	var schemas dataSchemas
+	for _, pattern := range patterns {
+		filename := h.Configs.Base.DataSchemas[pattern]
+		g, err := hglob.GetGlob(hglob.NormalizePath(pattern))
+		if err != nil {
+			return nil, fmt.Errorf("dataSchemas: invalid glob %q: %w", pattern, err)
+		}
+		b, err := afero.ReadFile(h.Fs.Source, h.PathSpec.AbsPathify(filename))
+		if err != nil {
+			return nil, fmt.Errorf("dataSchemas: failed to read schema for %q: %w", pattern, err)
+		}
+		schema, err := decodeDataSchema(b, metadecoders.FormatFromString(filepath.Ext(filename)))
+		if err != nil {
+			return nil, fmt.Errorf("dataSchemas: failed to decode schema %q: %w", filename, err)
+		}
+		schemas = append(schemas, dataSchema{pattern: pattern, filename: filename, glob: g, schema: schema})
+	}
+
+	return schemas, nil
+}
+
+func decodeDataSchema(b []byte, f metadecoders.Format) (*kopenapi3.Schema, error) {
+	if f == "" {
+		f = metadecoders.JSON
+	}
+	v, err := metadecoders.Default.Unmarshal(b, f)
+	if err != nil {
+		return nil, err
+	}
+	// Round trip through JSON to get the schema and the JSON Schema types right.
+	b, err = json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	schema := &kopenapi3.Schema{}
+	if err := json.Unmarshal(b, schema); err != nil {
+		return nil, err
+	}
+	if err := checkDataSchema("", schema, true); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// dataSchemaAnnotations are the JSON Schema keywords outside of the supported
+// subset that we allow on the root schema, as they don't affect validation.
+var dataSchemaAnnotations = map[string]bool{
+	"$schema":  true,
+	"$id":      true,
+	"$comment": true,
+}
+
+// checkDataSchema returns an error if schema, at the JSON pointer path,
+// or any of its subschemas use keywords outside of the supported subset of
+// JSON Schema, as the validator would silently ignore those.
+func checkDataSchema(path string, schema *kopenapi3.Schema, root bool) error {
+	var unsupported []string
+	for k := range schema.Extensions {
+		if root && dataSchemaAnnotations[k] {
+			continue
+		}
+		unsupported = append(unsupported, k)
+	}
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+		return fmt.Errorf("at %q: unsupported keywords %q", "/"+path, unsupported)
+	}
+
+	checkRef := func(p string, ref *kopenapi3.SchemaRef) error {
+		if ref == nil {
+			return nil
+		}
+		if ref.Ref != "" || ref.Value == nil {
+			return fmt.Errorf("at %q: unsupported keywords [\"$ref\"]", "/"+p)
+		}
+		return checkDataSchema(p, ref.Value, false)
+	}
+	join := func(elems ...string) string {
+		if path == "" {
+			return strings.Join(elems, "/")
+		}
+		return path + "/" + strings.Join(elems, "/")
+	}
+
+	for name, refs := range map[string]kopenapi3.SchemaRefs{"allOf": schema.AllOf, "anyOf": schema.AnyOf, "oneOf": schema.OneOf} {
+		for i, ref := range refs {
+			if err := checkRef(join(name, fmt.Sprint(i)), ref); err != nil {
+				return err
+			}
+		}
+	}
+	for name, ref := range schema.Properties {
+		if err := checkRef(join("properties", name), ref); err != nil {
+			return err
+		}
+	}
+	if err := checkRef(join("not"), schema.Not); err != nil {
+		return err
+	}
+	if err := checkRef(join("items"), schema.Items); err != nil {
+		return err
+	}
+	return checkRef(join("additionalProperties"), schema.AdditionalProperties.Schema)
+}
+
+// validate validates data, read from the data file filename (relative to the
+// data dir), against the schemas with a glob matching filename.
+func (s dataSchemas) validate(filename string, data any) error {
+	if len(s) == 0 {
+		return nil
+	}
+	filename = hglob.NormalizePath(filename)
+
+	var v any
+	for _, ds := range s {
+		if !ds.glob.Match(filename) {
+			continue
+		}
+		if v == nil {
+			// The schema validator expects the types from encoding/json, e.g. float64.
+			b, err := json.Marshal(data)
+			if err != nil {
+				return err
+			}
+			if err := json.Unmarshal(b, &v); err != nil {
+				return err
+			}
+		}
+		if err := ds.schema.VisitJSON(v); err != nil {
+			return fmt.Errorf("data: %q does not match the schema %q: %s", filename, ds.filename, dataSchemaErrorMessage(err))
+		}
+	}
+
+	return nil
+}
+
+func dataSchemaErrorMessage(err error) string {
+	var serr *kopenapi3.SchemaError
+	if !errors.As(err, &serr) {
+		return err.Error()
+	}
+	reason := serr.Reason
+	if reason == "" {
+		reason = fmt.Sprintf("does not match %q", serr.SchemaField)
+	}
+	return fmt.Sprintf("at %q: %s", "/"+strings.Join(serr.JSONPointer(), "/"), reason)
+}
@@ -14,7 +14,10 @@
 package hugolib
 
 import (
+	"strings"
 	"testing"
+
+	qt "github.com/frankban/quicktest"
 )
 
 func TestData(t *testing.T) {
@@ -47,3 +50,170 @@ d: {{  site.Data.d.v1 }}|
 		b.AssertFileContent("public/index.html", "a: a_v1|\nb: b_v1|\ncd: c_d_v1|\nd: d_v1_theme|")
 	})
 }
+
+func TestDataMergeDirs(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "page", "section"]
+theme = "mytheme"
+mergeDataDirs = ["products", "a/b"]
+-- data/products/shoes.yaml --
+shoes:
+  price: 100
+  sizes: [40, 42]
+common:
+  currency: EUR
+-- data/products/hats.toml --
+[hats]
+price = 20
+[common]
+vat = 25
+-- data/products/more/socks.json --
+{ "socks": { "price": 5 } }
+-- data/a/b/c.yaml --
+c: true
+-- data/other/x.yaml --
+x: 1
+-- themes/mytheme/data/products/theme.yaml --
+shoes:
+  price: 999
+  color: black
+-- layouts/index.html --
+Shoes: {{ site.Data.products.shoes.price }}|{{ site.Data.products.shoes.color }}|{{ site.Data.products.shoes.sizes }}|
+Hats: {{ site.Data.products.hats.price }}|
+Socks: {{ site.Data.products.socks.price }}|
+Common: {{ site.Data.products.common.currency }}|{{ site.Data.products.common.vat }}|
+Keys: {{ range $k, $v := site.Data.products }}{{ $k }},{{ end }}|
+C: {{ site.Data.a.b.c }}|
+Other: {{ site.Data.other.x.x }}|
+`
+	b := Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		"Shoes: 100|black|[40 42]|",
+		"Hats: 20|",
+		"Socks: 5|",
+		"Common: EUR|25|",
+		"Keys: common,hats,shoes,socks,|",
+		"C: true|",
+		"Other: 1|",
+	)
+
+	// Key collision in the same module.
+	files = strings.ReplaceAll(files, "[common]\nvat = 25", "[common]\ncurrency = \"USD\"")
+	b, err := TestE(t, files)
+	b.Assert(err, qt.ErrorMatches, `(?s).*data: key "products.common.currency" is defined in both .*`)
+}
+
+func TestDataSchemas(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "page", "section"]
+[dataSchemas]
+"products.yaml" = "schemas/products.json"
+"shops/*.toml" = "schemas/shop.yaml"
+-- schemas/products.json --
+{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "required": ["items"],
+  "properties": {
+    "items": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "required": ["name", "category"],
+        "properties": {
+          "name": { "type": "string" },
+          "price": { "type": "number", "minimum": 0 },
+          "category": { "type": "string", "enum": ["shoes", "hats"] }
+        }
+      }
+    }
+  }
+}
+-- schemas/shop.yaml --
+type: object
+required: [city]
+properties:
+  city:
+    type: string
+-- data/products.yaml --
+items:
+- name: Boots
+  price: 100
+  category: shoes
+- name: Cap
+  price: 20
+  category: hats
+-- data/shops/oslo.toml --
+city = "Oslo"
+-- data/other.yaml --
+foo: 42
+-- layouts/index.html --
+Products: {{ range site.Data.products.items }}{{ .name }}:{{ .category }}|{{ end }}
+Shop: {{ site.Data.shops.oslo.city }}|
+`
+	b := Test(t, files)
+
+	b.AssertFileContent("public/index.html", "Products: Boots:shoes|Cap:hats|", "Shop: Oslo|")
+
+	// A mistyped enum value.
+	_, err := TestE(t, strings.Replace(files, "category: hats", "category: hat", 1))
+	b.Assert(err, qt.ErrorMatches, `(?s).*data: "products.yaml" does not match the schema "schemas/products.json": at "/items/1/category": value is not one of the allowed values \["shoes","hats"\]`)
+
+	// Missing a required property.
+	_, err = TestE(t, strings.Replace(files, `city = "Oslo"`, `town = "Oslo"`, 1))
+	b.Assert(err, qt.ErrorMatches, `(?s).*data: "shops/oslo.toml" does not match the schema "schemas/shop.yaml": at "/city": property "city" is missing.*`)
+
+	// The data is validated even if not used in the templates.
+	files = strings.Replace(files, "category: hats", "category: hat", 1)
+	files = files[:strings.Index(files, "-- layouts/index.html --")] + "-- layouts/index.html --\nHome."
+	_, err = TestE(t, files)
+	b.Assert(err, qt.ErrorMatches, `(?s).*"/items/1/category".*`)
+}
+
+func TestDataSchemasUnsupported(t *testing.T) {
+	t.Parallel()
+
+	filesTemplate := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "page", "section"]
+[dataSchemas]
+"products.yaml" = "schemas/products.json"
+-- schemas/products.json --
+{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "name": SCHEMA
+  }
+}
+-- data/products.yaml --
+name: Boots
+-- layouts/index.html --
+Home.
+`
+
+	for _, test := range []struct {
+		schema string
+		expect string
+	}{
+		{`{ "const": "Boots" }`, `at "/properties/name": unsupported keywords ["const"]`},
+		{`{ "type": "object", "patternProperties": { "^a": { "type": "string" } } }`, `at "/properties/name": unsupported keywords ["patternProperties"]`},
+		{`{ "$ref": "#/$defs/name" }`, `at "/properties/name": unsupported keywords ["$ref"]`},
+		{`{ "$schema": "https://json-schema.org/draft/2020-12/schema" }`, `at "/properties/name": unsupported keywords ["$schema"]`},
+		{`{ "type": ["string", "null"] }`, `cannot unmarshal array`},
+	} {
+		files := strings.Replace(filesTemplate, "SCHEMA", test.schema, 1)
+		_, err := TestE(t, files)
+		qt.Assert(t, err, qt.IsNotNil)
+		qt.Assert(t, err.Error(), qt.Contains, `dataSchemas: failed to decode schema "schemas/products.json"`)
+		qt.Assert(t, err.Error(), qt.Contains, test.expect)
+	}
+}
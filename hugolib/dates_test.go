@@ -17,8 +17,11 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/bep/clocks"
 	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/common/htime"
 )
 
 func TestDateFormatMultilingual(t *testing.T) {
@@ -266,3 +269,115 @@ Home's Date should be equal mydata date: true
 Full time: 6:00:00 am UTC
 `)
 }
+
+// Front matter dates without time zone information are in the site's time zone,
+// so a page scheduled for midnight is published at midnight in that time zone.
+func TestPublishDateAndExpiryDateTimeZones(t *testing.T) {
+	t.Cleanup(func() { htime.Clock = clocks.System() })
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+defaultContentLanguage = "en"
+defaultContentLanguageInSubdir = true
+buildFuture = BUILD_FUTURE
+buildExpired = BUILD_EXPIRED
+[languages]
+[languages.en]
+weight = 1
+timeZone = "America/New_York"
+[languages.ja]
+weight = 2
+timeZone = "Asia/Tokyo"
+-- content/yaml.md --
+---
+title: yaml
+publishDate: 2024-05-01T00:00:00
+expiryDate: 2024-06-01
+---
+-- content/toml.md --
++++
+title = "toml"
+publishDate = 2024-05-01T00:00:00
+expiryDate = 2024-06-01
++++
+-- content/offset.md --
+---
+title: offset
+publishDate: 2024-05-01T00:00:00Z
+expiryDate: 2024-06-01T00:00:00Z
+---
+-- content/yaml.ja.md --
+---
+title: yaml
+publishDate: 2024-05-01T00:00:00
+expiryDate: 2024-06-01
+---
+-- content/toml.ja.md --
++++
+title = "toml"
+publishDate = 2024-05-01T00:00:00
+expiryDate = 2024-06-01
++++
+-- content/offset.ja.md --
+---
+title: offset
+publishDate: 2024-05-01T00:00:00Z
+expiryDate: 2024-06-01T00:00:00Z
+---
+-- layouts/index.html --
+Pages: {{ range site.RegularPages.ByTitle }}{{ .Title }}|{{ end }}:End
+`
+
+	build := func(clock time.Time, buildFuture, buildExpired bool) *IntegrationTestBuilder {
+		htime.Clock = clocks.Start(clock)
+		files := strings.NewReplacer(
+			"BUILD_FUTURE", fmt.Sprint(buildFuture),
+			"BUILD_EXPIRED", fmt.Sprint(buildExpired),
+		).Replace(files)
+		return Test(t, files)
+	}
+
+	newYork, _ := time.LoadLocation("America/New_York")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	publishNewYork := time.Date(2024, 5, 1, 0, 0, 0, 0, newYork)
+	publishTokyo := time.Date(2024, 5, 1, 0, 0, 0, 0, tokyo)
+	publishUTC := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	expiryNewYork := time.Date(2024, 6, 1, 0, 0, 0, 0, newYork)
+
+	// Tokyo is ahead of UTC, which is ahead of New York.
+	b := build(publishTokyo.Add(-time.Minute), false, false)
+	b.AssertFileContent("public/en/index.html", "Pages: :End")
+	b.AssertFileContent("public/ja/index.html", "Pages: :End")
+
+	b = build(publishTokyo.Add(time.Minute), false, false)
+	b.AssertFileContent("public/en/index.html", "Pages: :End")
+	b.AssertFileContent("public/ja/index.html", "Pages: toml|yaml|:End")
+
+	b = build(publishUTC.Add(time.Minute), false, false)
+	b.AssertFileContent("public/en/index.html", "Pages: offset|:End")
+	b.AssertFileContent("public/ja/index.html", "Pages: offset|toml|yaml|:End")
+
+	b = build(publishNewYork.Add(-time.Minute), false, false)
+	b.AssertFileContent("public/en/index.html", "Pages: offset|:End")
+
+	b = build(publishNewYork.Add(time.Minute), false, false)
+	b.AssertFileContent("public/en/index.html", "Pages: offset|toml|yaml|:End")
+
+	// buildFuture includes the pages not yet published.
+	b = build(publishTokyo.Add(-time.Minute), true, false)
+	b.AssertFileContent("public/en/index.html", "Pages: offset|toml|yaml|:End")
+
+	// The pages with a UTC expiry date expire first.
+	b = build(expiryNewYork.Add(-time.Minute), false, false)
+	b.AssertFileContent("public/en/index.html", "Pages: toml|yaml|:End")
+	b.AssertFileContent("public/ja/index.html", "Pages: :End")
+
+	b = build(expiryNewYork.Add(time.Minute), false, false)
+	b.AssertFileContent("public/en/index.html", "Pages: :End")
+
+	// buildExpired includes the expired pages.
+	b = build(expiryNewYork.Add(time.Minute), false, true)
+	b.AssertFileContent("public/en/index.html", "Pages: offset|toml|yaml|:End")
+	b.AssertFileContent("public/ja/index.html", "Pages: offset|toml|yaml|:End")
+}
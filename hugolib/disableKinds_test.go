@@ -176,6 +176,7 @@ title: Headless Local Lists Sub
 		b.Assert(categories.RelPermalink(), qt.Equals, "")
 		b.Assert(getPageInSitePages(b, "/categories"), qt.IsNil)
 		b.Assert(getPageInPagePages(getPage(b, "/"), "/categories"), qt.IsNil)
+		b.Assert(getPage(b, "/categories/mycat").Parent(), qt.Equals, getPage(b, "/"))
 	})
 
 	disableKind = kinds.KindHome
@@ -189,6 +190,8 @@ title: Headless Local Lists Sub
 		b.Assert(getPageInSitePages(b, "/"), qt.IsNil)
 		b.Assert(getPageInPagePages(home, "/"), qt.IsNil)
 		b.Assert(getPage(b, "/sect/page.md"), qt.Not(qt.IsNil))
+		b.Assert(getPage(b, "/sect").Parent(), qt.IsNil)
+		b.Assert(getPage(b, "/categories").Parent(), qt.IsNil)
 	})
 
 	disableKind = kinds.KindSection
@@ -205,7 +208,12 @@ title: Headless Local Lists Sub
 		b.Assert(home.OutputFormats(), qt.HasLen, 2)
 		page := getPage(b, "/sect/page.md")
 		b.Assert(page, qt.Not(qt.IsNil))
-		b.Assert(page.CurrentSection(), qt.Equals, sect)
+		b.Assert(page.CurrentSection(), qt.Equals, home)
+		b.Assert(page.FirstSection(), qt.Equals, home)
+		b.Assert(home.Sections(), qt.HasLen, 0)
+		b.Assert(page.Parent(), qt.Equals, home)
+		b.Assert(page.Ancestors(), qt.HasLen, 1)
+		b.Assert(page.Ancestors()[0], qt.Equals, home)
 		b.Assert(getPageInPagePages(sect, "/sect/page"), qt.Not(qt.IsNil))
 		b.AssertFileContent("public/sitemap.xml", "sitemap")
 		b.AssertFileContent("public/index.xml", "rss")
@@ -463,3 +471,87 @@ title: "Page 2 nn"
 	b.AssertFileExists("public/nn/p1/index.html", false)
 	b.AssertFileExists("public/nn/p2/index.html", false)
 }
+
+func TestDisableKindsNoDanglingParents(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "section", "rss", "sitemap", "robotsTXT", "404"]
+-- content/s1/_index.md --
+---
+title: S1
+---
+-- content/s1/s2/_index.md --
+---
+title: S2
+---
+-- content/s1/s2/p1.md --
+---
+title: P1
+tags: ["t1"]
+---
+-- layouts/_default/single.html --
+Breadcrumbs: {{ range .Ancestors.Reverse }}<a href="{{ .RelPermalink }}">{{ .Title }}</a>{{ end }}|
+Terms: {{ range .GetTerms "tags" }}{{ .Title }}: {{ with .Parent }}{{ .Kind }}{{ end }}{{ end }}|
+-- layouts/_default/list.html --
+List: {{ .Title }}|Parent: {{ with .Parent }}{{ .Kind }}{{ end }}|
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/s1/s2/p1/index.html",
+		`Breadcrumbs: <a href="/"></a>|`,
+		"Terms: T1: home|",
+	)
+	b.AssertFileContent("public/tags/t1/index.html", "List: T1|Parent: home|")
+	b.AssertFileExists("public/s1/index.html", false)
+	b.AssertFileExists("public/tags/index.html", false)
+	b.AssertFileExists("public/tags/t1/index.xml", false)
+	b.AssertFileExists("public/sitemap.xml", false)
+}
+
+func TestDisableKindsNestedSections(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["home", "taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+-- content/s1/_index.md --
+---
+title: S1
+---
+-- content/s1/s2/_index.md --
+---
+title: S2
+---
+-- content/s1/s2/s3/_index.md --
+---
+title: S3
+---
+-- content/s1/s2/s3/p1.md --
+---
+title: P1
+---
+-- layouts/_default/single.html --
+Single: {{ .Title }}|CurrentSection: {{ .CurrentSection.Title }}|FirstSection: {{ .FirstSection.Title }}|Parent: {{ .Parent.Title }}|Ancestors: {{ range .Ancestors }}{{ .Title }}|{{ end }}
+-- layouts/_default/list.html --
+List: {{ .Title }}|Parent: {{ with .Parent }}{{ .Title }}{{ end }}|Sections: {{ range .Sections }}{{ .Title }}|{{ end }}$
+Site.Sections: {{ range site.Sections }}{{ .Title }}|{{ end }}$
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/s1/s2/s3/p1/index.html",
+		"Single: P1|CurrentSection: S3|FirstSection: S1|Parent: S3|Ancestors: S3|S2|S1|",
+	)
+	b.AssertFileContent("public/s1/index.html",
+		"List: S1|Parent: |Sections: S2|$",
+		"Site.Sections: S1|$",
+	)
+	b.AssertFileContent("public/s1/s2/index.html", "List: S2|Parent: S1|Sections: S3|$")
+	b.AssertFileContent("public/s1/s2/s3/index.html", "List: S3|Parent: S2|Sections: $")
+	b.AssertFileExists("public/index.html", false)
+}
@@ -668,7 +668,9 @@ func (b *sourceFilesystemsBuilder) createOverlayFs(
 		return nil
 	}
 
-	for _, md := range mounts {
+	overrides := b.newContentOverrides(mounts)
+
+	for mdi, md := range mounts {
 		var (
 			fromTo        []hugofs.RootMapping
 			fromToContent []hugofs.RootMapping
@@ -696,6 +698,14 @@ func (b *sourceFilesystemsBuilder) createOverlayFs(
 				return err
 			}
 
+			if b.isContentMount(mount) {
+				hidden, overrideFilter := overrides.filter(mdi, i)
+				if hidden {
+					continue
+				}
+				inclusionFilter = inclusionFilter.Append(overrideFilter)
+			}
+
 			base, filename := absPathify(mount.Source)
 
 			rm := hugofs.RootMapping{
@@ -713,6 +723,9 @@ func (b *sourceFilesystemsBuilder) createOverlayFs(
 			}
 
 			isContentMount := b.isContentMount(mount)
+			if isContentMount {
+				rm.Meta.Priority = mount.Priority
+			}
 
 			lang := mount.Lang
 			if lang == "" && isContentMount {
@@ -787,6 +800,104 @@ func (b *sourceFilesystemsBuilder) createOverlayFs(
 	return nil
 }
 
+const filepathSeparator = string(os.PathSeparator)
+
+// contentMountRef identifies a content mount across all modules.
+type contentMountRef struct {
+	modules.Mount
+	lang    string
+	ordinal int // The module ordinal.
+	index   int // The index in the module's mounts.
+}
+
+// outranks reports whether files in m win over files in other.
+func (m contentMountRef) outranks(other contentMountRef) bool {
+	if m.Priority != other.Priority {
+		return m.Priority > other.Priority
+	}
+	if m.ordinal != other.ordinal {
+		return m.ordinal < other.ordinal
+	}
+	return m.index < other.index
+}
+
+// contentOverrides holds the content mounts with the "override" policy.
+type contentOverrides struct {
+	mounts    [][]contentMountRef // Indexed by module and mount index.
+	overrides []contentMountRef
+}
+
+func (b *sourceFilesystemsBuilder) newContentOverrides(mounts []mountsDescriptor) *contentOverrides {
+	c := &contentOverrides{mounts: make([][]contentMountRef, len(mounts))}
+	for i, md := range mounts {
+		c.mounts[i] = make([]contentMountRef, len(md.Mounts()))
+		for j, mount := range md.Mounts() {
+			if !b.isContentMount(mount) {
+				continue
+			}
+			lang := mount.Lang
+			if lang == "" {
+				lang = b.p.Cfg.DefaultContentLanguage()
+			}
+			ref := contentMountRef{Mount: mount, lang: lang, ordinal: md.ordinal, index: j}
+			c.mounts[i][j] = ref
+			if mount.IsOverride() {
+				c.overrides = append(c.overrides, ref)
+			}
+		}
+	}
+	return c
+}
+
+// filter returns whether the given content mount is completely hidden by a
+// higher priority override mount, and if not, a filter excluding the parts
+// of it hidden by any override mounts.
+func (c *contentOverrides) filter(moduleIdx, mountIdx int) (bool, *glob.FilenameFilter) {
+	if len(c.overrides) == 0 {
+		return false, nil
+	}
+	m := c.mounts[moduleIdx][mountIdx]
+
+	var excluded []string
+	for _, o := range c.overrides {
+		if o.lang != m.lang || !o.outranks(m) {
+			continue
+		}
+		if rel, ok := relTarget(m.Target, o.Target); ok {
+			if rel == "" {
+				return true, nil
+			}
+			excluded = append(excluded, filepathSeparator+rel)
+		} else if _, ok := relTarget(o.Target, m.Target); ok {
+			return true, nil
+		}
+	}
+
+	if excluded == nil {
+		return false, nil
+	}
+
+	return false, glob.NewFilenameFilterForInclusionFunc(func(filename string) bool {
+		for _, e := range excluded {
+			if filename == e || strings.HasPrefix(filename, e+filepathSeparator) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// relTarget returns target relative to dir if target is dir or below it.
+func relTarget(dir, target string) (string, bool) {
+	if target == dir {
+		return "", true
+	}
+	if strings.HasPrefix(target, dir+filepathSeparator) {
+		return target[len(dir)+1:], true
+	}
+	return "", false
+}
+
 //lint:ignore U1000 useful for debugging
 func printFs(fs afero.Fs, path string, w io.Writer) {
 	if fs == nil {
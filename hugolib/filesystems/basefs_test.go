@@ -536,3 +536,120 @@ func setConfigAndWriteSomeFilesTo(fs afero.Fs, v config.Provider, key, val strin
 		afero.WriteFile(fs, filename, []byte(fmt.Sprintf("content:%s:%d", key, i+1)), 0o755)
 	}
 }
+
+func TestContentMountPriority(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "rss", "sitemap"]
+theme = "mytheme"
+[[module.mounts]]
+source = "content"
+target = "content"
+[[module.mounts]]
+source = "extra"
+target = "content/docs"
+-- content/docs/p1.md --
+---
+title: "p1 project"
+---
+-- extra/p3.md --
+---
+title: "p3 extra"
+---
+-- themes/mytheme/hugo.toml --
+[[module.mounts]]
+source = "content"
+target = "content"
+priority = 10
+-- themes/mytheme/content/docs/p1.md --
+---
+title: "p1 theme"
+---
+-- themes/mytheme/content/docs/p2.md --
+---
+title: "p2 theme"
+---
+-- layouts/_default/list.html --
+{{ range .RegularPages }}{{ .Title }}|{{ end }}
+-- layouts/_default/single.html --
+{{ .Title }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/docs/index.html", "p1 theme|p2 theme|p3 extra|")
+	b.AssertFileContent("public/docs/p1/index.html", "p1 theme")
+}
+
+func TestContentMountOverride(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "rss", "sitemap"]
+theme = "mytheme"
+[[module.mounts]]
+source = "content"
+target = "content"
+[[module.mounts]]
+source = "mydocs"
+target = "content/docs"
+policy = "override"
+-- content/blog/b1.md --
+---
+title: "b1 project"
+---
+-- mydocs/p1.md --
+---
+title: "p1 project"
+---
+-- themes/mytheme/content/blog/b2.md --
+---
+title: "b2 theme"
+---
+-- themes/mytheme/content/docs/p1.md --
+---
+title: "p1 theme"
+---
+-- themes/mytheme/content/docs/p2.md --
+---
+title: "p2 theme"
+---
+-- themes/mytheme/content/docs/sub/p3.md --
+---
+title: "p3 theme"
+---
+-- layouts/_default/list.html --
+{{ range .RegularPagesRecursive }}{{ .Title }}|{{ end }}
+-- layouts/_default/single.html --
+{{ .Title }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/docs/index.html", "! p2 theme", "! p3 theme", "p1 project|")
+	b.AssertFileContent("public/blog/index.html", "b1 project|b2 theme|")
+	b.AssertFileExists("public/docs/p2/index.html", false)
+}
+
+func TestContentMountInvalidPolicy(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+[[module.mounts]]
+source = "assets"
+target = "assets"
+policy = "override"
+-- assets/a.txt --
+a
+`
+
+	b, err := hugolib.TestE(t, files)
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `mount policy "override" is only supported for content mounts`)
+}
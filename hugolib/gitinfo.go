@@ -14,11 +14,18 @@
 package hugolib
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/bep/gitmap"
+	"github.com/gohugoio/hugo/common/hexec"
 	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/hugofs/files"
 	"github.com/gohugoio/hugo/resources/page"
 	"github.com/gohugoio/hugo/source"
 )
@@ -26,19 +33,148 @@ import (
 type gitInfo struct {
 	contentDir string
 	repo       *gitmap.GitRepo
+
+	// The committer and the commit message body for each commit,
+	// which gitmap does not provide.
+	commits map[string]*gitCommit
+
+	// All commits for each file, newest first.
+	// Only set if enableGitContributors is enabled.
+	history map[string][]*gitCommit
+	// The files with history, sorted.
+	filenames []string
+	// The files with history in each directory.
+	dirs map[string][]string
+}
+
+// gitCommit holds the commit information not provided by gitmap.
+type gitCommit struct {
+	hash           string
+	authorName     string
+	authorEmail    string
+	committerName  string
+	committerEmail string
+	body           string
 }
 
 func (g *gitInfo) forPage(p page.Page) source.GitInfo {
-	name := strings.TrimPrefix(filepath.ToSlash(p.File().Filename()), g.contentDir)
-	name = strings.TrimPrefix(name, "/")
-	gi, found := g.repo.Files[name]
+	gi, found := g.repo.Files[g.relFilename(p)]
 	if !found {
 		return source.GitInfo{}
 	}
-	return source.NewGitInfo(*gi)
+	info := source.NewGitInfo(*gi)
+	if c, found := g.commits[gi.Hash]; found {
+		info.CommitterName = c.committerName
+		info.CommitterEmail = c.committerEmail
+		info.Body = c.body
+	}
+	return info
 }
 
-func newGitInfo(conf config.AllProvider) (*gitInfo, error) {
+// contributorsForPage returns the authors of the commits to the files in p's
+// bundle, ordered by the number of commits.
+func (g *gitInfo) contributorsForPage(p page.Page) []source.GitContributor {
+	if g.history == nil || p.File() == nil {
+		return nil
+	}
+
+	return g.contributors(g.bundleFilenames(g.relFilename(p), p.BundleType()))
+}
+
+// bundleFilenames returns the files with history in the bundle with the
+// given content file and bundle type.
+// For a leaf bundle this includes all files below its directory, for a branch
+// bundle the index file and the non-content files in its directory, as the
+// other content files are pages of their own.
+func (g *gitInfo) bundleFilenames(name, bundleType string) []string {
+	dir := path.Dir(name)
+
+	switch bundleType {
+	case "leaf":
+		prefix := dir + "/"
+		if dir == "." {
+			prefix = ""
+		}
+		var filenames []string
+		for i := sort.SearchStrings(g.filenames, prefix); i < len(g.filenames) && strings.HasPrefix(g.filenames[i], prefix); i++ {
+			filenames = append(filenames, g.filenames[i])
+		}
+		return filenames
+	case "branch":
+		filenames := []string{name}
+		for _, filename := range g.dirs[dir] {
+			if !files.IsContentFile(filename) {
+				filenames = append(filenames, filename)
+			}
+		}
+		return filenames
+	default:
+		return []string{name}
+	}
+}
+
+// indexHistory indexes the files with history by directory.
+func (g *gitInfo) indexHistory() {
+	g.filenames = make([]string, 0, len(g.history))
+	g.dirs = make(map[string][]string)
+	for filename := range g.history {
+		g.filenames = append(g.filenames, filename)
+		dir := path.Dir(filename)
+		g.dirs[dir] = append(g.dirs[dir], filename)
+	}
+	sort.Strings(g.filenames)
+}
+
+func (g *gitInfo) contributors(filenames []string) []source.GitContributor {
+	seen := make(map[string]bool)
+	counts := make(map[string]*source.GitContributor)
+	for _, filename := range filenames {
+		for _, gc := range g.history[filename] {
+			if seen[gc.hash] {
+				continue
+			}
+			seen[gc.hash] = true
+			key := strings.ToLower(gc.authorEmail)
+			if key == "" {
+				key = gc.authorName
+			}
+			c, found := counts[key]
+			if !found {
+				c = &source.GitContributor{Name: gc.authorName, Email: gc.authorEmail}
+				counts[key] = c
+			}
+			c.Commits++
+		}
+	}
+
+	if len(counts) == 0 {
+		return nil
+	}
+
+	contributors := make([]source.GitContributor, 0, len(counts))
+	for _, c := range counts {
+		contributors = append(contributors, *c)
+	}
+	sort.Slice(contributors, func(i, j int) bool {
+		ci, cj := contributors[i], contributors[j]
+		if ci.Commits != cj.Commits {
+			return ci.Commits > cj.Commits
+		}
+		if ci.Name != cj.Name {
+			return ci.Name < cj.Name
+		}
+		return ci.Email < cj.Email
+	})
+
+	return contributors
+}
+
+func (g *gitInfo) relFilename(p page.Page) string {
+	name := strings.TrimPrefix(filepath.ToSlash(p.File().Filename()), g.contentDir)
+	return strings.TrimPrefix(name, "/")
+}
+
+func newGitInfo(conf config.AllProvider, collectContributors bool) (*gitInfo, error) {
 	workingDir := conf.BaseConfig().WorkingDir
 
 	gitRepo, err := gitmap.Map(workingDir, "")
@@ -46,5 +182,89 @@ func newGitInfo(conf config.AllProvider) (*gitInfo, error) {
 		return nil, err
 	}
 
-	return &gitInfo{contentDir: gitRepo.TopLevelAbsPath, repo: gitRepo}, nil
+	args := []string{"-c", "diff.renames=0", "-c", "log.showSignature=0", "-C", workingDir, "log", "--no-merges", "--format=format:" + gitLogFormat}
+	if collectContributors {
+		args = append(args, "--name-only")
+	}
+	out, err := git(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	commits, history, err := parseGitLog(string(out), collectContributors)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &gitInfo{contentDir: gitRepo.TopLevelAbsPath, repo: gitRepo, commits: commits, history: history}
+	if history != nil {
+		g.indexHistory()
+	}
+
+	return g, nil
+}
+
+// gitLogFormat starts each commit with a record separator and separates the
+// fields with a unit separator. The body may span multiple lines, so it is
+// terminated by a unit separator before the list of file names, if any.
+const gitLogFormat = "%x1e%H%x1f%aN%x1f%aE%x1f%cN%x1f%cE%x1f%b%x1f"
+
+// parseGitLog parses the output of git log using gitLogFormat and returns the
+// commits by hash and, if collectHistory is set, all commits for each file.
+func parseGitLog(out string, collectHistory bool) (map[string]*gitCommit, map[string][]*gitCommit, error) {
+	commits := make(map[string]*gitCommit)
+	var history map[string][]*gitCommit
+	if collectHistory {
+		history = make(map[string][]*gitCommit)
+	}
+
+	for _, entry := range strings.Split(out, "\x1e") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		fields := strings.Split(entry, "\x1f")
+		if len(fields) != 7 {
+			return nil, nil, fmt.Errorf("failed to parse git log entry %q", entry)
+		}
+
+		gc := &gitCommit{
+			hash:           fields[0],
+			authorName:     fields[1],
+			authorEmail:    fields[2],
+			committerName:  fields[3],
+			committerEmail: fields[4],
+			body:           strings.TrimSpace(fields[5]),
+		}
+		commits[gc.hash] = gc
+
+		if history == nil {
+			continue
+		}
+
+		// The log is in reverse chronological order, newest first.
+		for _, filename := range strings.Split(fields[6], "\n") {
+			filename = strings.TrimSpace(filename)
+			if filename == "" {
+				continue
+			}
+			history[filename] = append(history[filename], gc)
+		}
+	}
+
+	return commits, history, nil
+}
+
+func git(args ...string) ([]byte, error) {
+	cmd, err := hexec.SafeCommand("git", args...)
+	if err != nil {
+		return nil, err
+	}
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		if len(out) > 0 {
+			return nil, errors.New(string(bytes.TrimSpace(out)))
+		}
+		return nil, err
+	}
+	return out, nil
 }
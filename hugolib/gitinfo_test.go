@@ -0,0 +1,119 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/source"
+)
+
+func TestParseGitLog(t *testing.T) {
+	c := qt.New(t)
+
+	out := strings.Join([]string{
+		"\x1eh2\x1fJane\x1fjane@example.org\x1fJohn\x1fjohn@example.org\x1fFirst line.\n\nSecond line.\n\x1f\ncontent/p1.md",
+		"\x1eh1\x1fJohn\x1fjohn@example.org\x1fJohn\x1fjohn@example.org\x1f\x1f\ncontent/p1.md\ncontent/p2.md\n",
+	}, "")
+
+	commits, history, err := parseGitLog(out, false)
+	c.Assert(err, qt.IsNil)
+	c.Assert(commits, qt.HasLen, 2)
+	c.Assert(history, qt.IsNil)
+
+	h2 := commits["h2"]
+	c.Assert(h2.authorName, qt.Equals, "Jane")
+	c.Assert(h2.authorEmail, qt.Equals, "jane@example.org")
+	c.Assert(h2.committerName, qt.Equals, "John")
+	c.Assert(h2.committerEmail, qt.Equals, "john@example.org")
+	c.Assert(h2.body, qt.Equals, "First line.\n\nSecond line.")
+	c.Assert(commits["h1"].body, qt.Equals, "")
+
+	_, history, err = parseGitLog(out, true)
+	c.Assert(err, qt.IsNil)
+	c.Assert(history["content/p1.md"], qt.HasLen, 2)
+	c.Assert(history["content/p1.md"][0].hash, qt.Equals, "h2")
+	c.Assert(history["content/p2.md"], qt.HasLen, 1)
+	c.Assert(history["content/new.md"], qt.IsNil)
+
+	_, _, err = parseGitLog("\x1eh1\x1fJohn", false)
+	c.Assert(err, qt.ErrorMatches, "failed to parse git log entry.*")
+}
+
+func TestGitContributors(t *testing.T) {
+	c := qt.New(t)
+
+	entry := func(hash, name, email string, files ...string) string {
+		return "\x1e" + hash + "\x1f" + name + "\x1f" + email + "\x1f" + name + "\x1f" + email + "\x1f\x1f\n" + strings.Join(files, "\n")
+	}
+
+	out := strings.Join([]string{
+		entry("h5", "Bob", "bob@example.org", "content/b/index.md"),
+		entry("h4", "Alice", "alice@example.org", "content/b/index.md", "content/b/img/a.png"),
+		entry("h3", "Carol", "carol@example.org", "content/b/data.json"),
+		entry("h2", "Bob", "BOB@example.org", "content/b/img/a.png"),
+		entry("h1", "Alice", "alice@example.org", "content/a.md"),
+	}, "")
+
+	commits, history, err := parseGitLog(out, true)
+	c.Assert(err, qt.IsNil)
+	c.Assert(history["content/b/img/a.png"], qt.HasLen, 2)
+	c.Assert(history["content/b/img/a.png"][0].hash, qt.Equals, "h4")
+
+	g := &gitInfo{commits: commits, history: history}
+
+	// A commit touching several files in the bundle is only counted once.
+	contributors := g.contributors([]string{"content/b/index.md", "content/b/img/a.png", "content/b/data.json"})
+	c.Assert(contributors, qt.DeepEquals, []source.GitContributor{
+		{Name: "Bob", Email: "bob@example.org", Commits: 2},
+		{Name: "Alice", Email: "alice@example.org", Commits: 1},
+		{Name: "Carol", Email: "carol@example.org", Commits: 1},
+	})
+
+	c.Assert(g.contributors([]string{"content/new.md"}), qt.IsNil)
+}
+
+func TestGitBundleFilenames(t *testing.T) {
+	c := qt.New(t)
+
+	g := &gitInfo{history: make(map[string][]*gitCommit)}
+	for _, filename := range []string{
+		"content/_index.md",
+		"content/logo.png",
+		"content/a.md",
+		"content/s1/_index.md",
+		"content/s1/data.json",
+		"content/s1/p1.md",
+		"content/s1/b/index.md",
+		"content/s1/b/img/a.png",
+		"content/s1/b/c.md",
+		"content/s1/bb/index.md",
+	} {
+		g.history[filename] = nil
+	}
+	g.indexHistory()
+
+	sorted := func(filenames []string) []string {
+		sort.Strings(filenames)
+		return filenames
+	}
+
+	c.Assert(sorted(g.bundleFilenames("content/_index.md", "branch")), qt.DeepEquals, []string{"content/_index.md", "content/logo.png"})
+	c.Assert(sorted(g.bundleFilenames("content/s1/_index.md", "branch")), qt.DeepEquals, []string{"content/s1/_index.md", "content/s1/data.json"})
+	c.Assert(g.bundleFilenames("content/s1/b/index.md", "leaf"), qt.DeepEquals, []string{"content/s1/b/c.md", "content/s1/b/img/a.png", "content/s1/b/index.md"})
+	c.Assert(g.bundleFilenames("content/s1/p1.md", ""), qt.DeepEquals, []string{"content/s1/p1.md"})
+}
@@ -17,6 +17,9 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"path"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -32,6 +35,7 @@ import (
 
 	"github.com/gohugoio/hugo/output"
 	"github.com/gohugoio/hugo/parser/metadecoders"
+	"github.com/gohugoio/hugo/publisher"
 
 	"github.com/gohugoio/hugo/common/hugo"
 	"github.com/gohugoio/hugo/common/maps"
@@ -91,6 +95,17 @@ type HugoSites struct {
 	skipRebuildForFilenamesMu sync.Mutex
 	skipRebuildForFilenames   map[string]bool
 
+	staticPipeline *staticPipeline
+
+	// Writes pre-compressed variants of published files, nil if disabled.
+	precompressor *publisher.Precompressor
+
+	// Collects page render durations, nil if disabled.
+	pageRenderProfile *pageRenderProfile
+
+	// Collects the page aliases, nil if disabled.
+	redirectMap *redirectMap
+
 	init *hugoSitesInit
 
 	workersSite     *para.Workers
@@ -221,6 +236,14 @@ func (h *HugoSites) gitInfoForPage(p page.Page) (source.GitInfo, error) {
 	return h.gitInfo.forPage(p), nil
 }
 
+// contributorsForPage must be called after gitInfoForPage.
+func (h *HugoSites) contributorsForPage(p *pageState) []source.GitContributor {
+	if h.gitInfo == nil {
+		return nil
+	}
+	return h.gitInfo.contributorsForPage(p)
+}
+
 func (h *HugoSites) codeownersForPage(p page.Page) ([]string, error) {
 	if _, err := h.init.gitInfo.Do(context.Background()); err != nil {
 		return nil, err
@@ -326,7 +349,7 @@ func (h *HugoSites) GetContentPage(filename string) page.Page {
 
 func (h *HugoSites) loadGitInfo() error {
 	if h.Configs.Base.EnableGitInfo {
-		gi, err := newGitInfo(h.Conf)
+		gi, err := newGitInfo(h.Conf, h.Configs.Base.EnableGitContributors)
 		if err != nil {
 			h.Log.Errorln("Failed to read Git log:", err)
 		} else {
@@ -470,6 +493,11 @@ func (s *Site) preparePagesForRender(isRenderingSite bool, idx int) error {
 
 func (h *HugoSites) loadData() error {
 	h.data = make(map[string]any)
+	merger := newDataMerger(h.Configs.Base.MergeDataDirs)
+	schemas, err := h.loadDataSchemas()
+	if err != nil {
+		return err
+	}
 	w := hugofs.NewWalkway(
 		hugofs.WalkwayConfig{
 			Fs: h.PathSpec.BaseFs.Data.Fs,
@@ -481,7 +509,7 @@ func (h *HugoSites) loadData() error {
 				if pi == nil {
 					panic("no path info")
 				}
-				return h.handleDataFile(source.NewFileInfo(fi))
+				return h.handleDataFile(merger, schemas, source.NewFileInfo(fi))
 			},
 		})
 
@@ -491,7 +519,7 @@ func (h *HugoSites) loadData() error {
 	return nil
 }
 
-func (h *HugoSites) handleDataFile(r *source.File) error {
+func (h *HugoSites) handleDataFile(merger *dataMerger, schemas dataSchemas, r *source.File) error {
 	var current map[string]any
 
 	f, err := r.FileInfo().Meta().Open()
@@ -503,6 +531,11 @@ func (h *HugoSites) handleDataFile(r *source.File) error {
 	// Crawl in data tree to insert data
 	current = h.data
 	dataPath := r.FileInfo().Meta().PathInfo.Dir()[1:]
+
+	if dir, found := merger.mergeDir(dataPath); found {
+		return h.mergeDataFile(merger, schemas, dir, r)
+	}
+
 	keyParts := strings.Split(dataPath, "/")
 
 	for _, key := range keyParts {
@@ -514,7 +547,7 @@ func (h *HugoSites) handleDataFile(r *source.File) error {
 		}
 	}
 
-	data, err := h.readData(r)
+	data, err := h.readData(schemas, r)
 	if err != nil {
 		return h.errWithFileContext(err, r)
 	}
@@ -570,12 +603,141 @@ func (h *HugoSites) handleDataFile(r *source.File) error {
 	return nil
 }
 
+// mergeDataFile deep merges the data in r into the data map for dir,
+// one of the directories in mergeDataDirs.
+func (h *HugoSites) mergeDataFile(merger *dataMerger, schemas dataSchemas, dir string, r *source.File) error {
+	data, err := h.readData(schemas, r)
+	if err != nil {
+		return h.errWithFileContext(err, r)
+	}
+	if data == nil {
+		return nil
+	}
+	m, ok := data.(map[string]any)
+	if !ok {
+		return h.errWithFileContext(fmt.Errorf("data: %T data can not be merged into %q, expected a map", data, dir), r)
+	}
+
+	current := h.data
+	for _, key := range strings.Split(dir, "/") {
+		v, found := current[key]
+		if !found {
+			v = make(map[string]any)
+			current[key] = v
+		}
+		vm, ok := v.(map[string]any)
+		if !ok {
+			return fmt.Errorf("data: %q is not a map", dir)
+		}
+		current = vm
+	}
+
+	owner := dataOwner{ordinal: r.FileInfo().Meta().ModuleOrdinal, filename: r.Path()}
+	if err := merger.merge(current, m, dir, owner, h.Log.Infof); err != nil {
+		return h.errWithFileContext(err, r)
+	}
+	return nil
+}
+
+// dataMerger merges the data files in the configured mergeDataDirs.
+type dataMerger struct {
+	dirs []string
+
+	// Maps key paths to the data file that set the value.
+	owners map[string]dataOwner
+}
+
+type dataOwner struct {
+	ordinal  int // The module ordinal, lower values take precedence.
+	filename string
+}
+
+func newDataMerger(dirs []string) *dataMerger {
+	m := &dataMerger{owners: make(map[string]dataOwner)}
+	for _, dir := range dirs {
+		dir = strings.ToLower(strings.Trim(path.Clean(filepath.ToSlash(dir)), "/"))
+		if dir != "" && dir != "." {
+			m.dirs = append(m.dirs, dir)
+		}
+	}
+	return m
+}
+
+// mergeDir returns the merge dir dataPath is in, if any.
+func (m *dataMerger) mergeDir(dataPath string) (string, bool) {
+	for _, dir := range m.dirs {
+		if dataPath == dir || strings.HasPrefix(dataPath, dir+"/") {
+			return dir, true
+		}
+	}
+	return "", false
+}
+
+// owner returns the owner of the value at keyPath, which is the owner of the
+// closest parent for values set as part of a map.
+func (m *dataMerger) owner(keyPath string) dataOwner {
+	for {
+		if o, found := m.owners[keyPath]; found {
+			return o
+		}
+		i := strings.LastIndex(keyPath, "/")
+		if i == -1 {
+			return dataOwner{}
+		}
+		keyPath = keyPath[:i]
+	}
+}
+
+// merge deep merges src into dst.
+// Values in files from higher precedence modules (e.g. the project) win,
+// the same key set in two files in the same module is an error.
+func (m *dataMerger) merge(dst, src map[string]any, keyPath string, owner dataOwner, infof func(format string, v ...any)) error {
+	keys := make([]string, 0, len(src))
+	for k := range src {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := src[k]
+		kp := keyPath + "/" + k
+		existing, found := dst[k]
+		if !found {
+			dst[k] = v
+			m.owners[kp] = owner
+			continue
+		}
+
+		em, ok1 := existing.(map[string]any)
+		sm, ok2 := v.(map[string]any)
+		if ok1 && ok2 {
+			if err := m.merge(em, sm, kp, owner, infof); err != nil {
+				return err
+			}
+			continue
+		}
+
+		prev := m.owner(kp)
+		switch {
+		case prev.ordinal == owner.ordinal:
+			return fmt.Errorf("data: key %q is defined in both %q and %q", strings.ReplaceAll(kp, "/", "."), prev.filename, owner.filename)
+		case prev.ordinal < owner.ordinal:
+			infof("Data for key %q in %q is overridden by higher precedence data in %q", kp, owner.filename, prev.filename)
+		default:
+			dst[k] = v
+			m.owners[kp] = owner
+		}
+	}
+
+	return nil
+}
+
 func (h *HugoSites) errWithFileContext(err error, f *source.File) error {
 	realFilename := f.FileInfo().Meta().Filename
 	return herrors.NewFileErrorFromFile(err, realFilename, h.Fs.Source, nil)
 }
 
-func (h *HugoSites) readData(f *source.File) (any, error) {
+func (h *HugoSites) readData(schemas dataSchemas, f *source.File) (any, error) {
 	file, err := f.FileInfo().Meta().Open()
 	if err != nil {
 		return nil, fmt.Errorf("readData: failed to open data file: %w", err)
@@ -584,5 +746,9 @@ func (h *HugoSites) readData(f *source.File) (any, error) {
 	content := helpers.ReaderToBytes(file)
 
 	format := metadecoders.FormatFromString(f.Ext())
-	return metadecoders.Default.Unmarshal(content, format)
+	data, err := metadecoders.Default.Unmarshal(content, format)
+	if err != nil {
+		return nil, err
+	}
+	return data, schemas.validate(f.Path(), data)
 }
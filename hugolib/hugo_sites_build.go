@@ -16,12 +16,16 @@ package hugolib
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -31,6 +35,8 @@ import (
 	"github.com/gohugoio/hugo/hugofs/files"
 	"github.com/gohugoio/hugo/hugofs/glob"
 	"github.com/gohugoio/hugo/identity"
+	"github.com/gohugoio/hugo/langs/i18n"
+	"github.com/gohugoio/hugo/media"
 	"github.com/gohugoio/hugo/output"
 	"github.com/gohugoio/hugo/publisher"
 	"github.com/gohugoio/hugo/source"
@@ -98,6 +104,26 @@ func (h *HugoSites) Build(config BuildCfg, events ...fsnotify.Event) error {
 		h.Metrics.Reset()
 	}
 
+	if h.Configs.Base.ProfilePages != "" {
+		h.pageRenderProfile = newPageRenderProfile()
+	}
+
+	// Partial re-renders do not render the aliases, so we keep the ones we have.
+	if h.Configs.Base.Build.RedirectMap != "" && (h.redirectMap == nil || !config.PartialReRender) {
+		h.redirectMap = newRedirectMap()
+	}
+
+	// Make the random numbers drawn reproducible between rebuilds.
+	h.Deps.Random.Reset()
+
+	// A rebuild only re-renders the pages affected by the changes, so we
+	// keep the missing translations collected for the other pages.
+	if h.Configs.Base.MissingTranslations != "" && len(events) == 0 && !config.PartialReRender {
+		if tp, ok := h.Deps.TranslationProvider.(*i18n.TranslationProvider); ok {
+			tp.ResetMissingTranslations()
+		}
+	}
+
 	h.buildCounters = config.testCounters
 	if h.buildCounters == nil {
 		h.buildCounters = &buildCounters{}
@@ -139,6 +165,13 @@ func (h *HugoSites) Build(config BuildCfg, events ...fsnotify.Event) error {
 				return fmt.Errorf("process: %w", err)
 			}
 
+			if len(h.Configs.Base.DataSchemas) > 0 {
+				// Load the data early so we fail fast on schema violations.
+				if _, err := h.init.data.Do(ctx); err != nil {
+					return err
+				}
+			}
+
 			if err := h.assemble(ctx, infol, conf); err != nil {
 				return fmt.Errorf("assemble: %w", err)
 			}
@@ -163,6 +196,26 @@ func (h *HugoSites) Build(config BuildCfg, events ...fsnotify.Event) error {
 		if err := h.postProcess(infol); err != nil {
 			h.SendError(fmt.Errorf("postProcess: %w", err))
 		}
+
+		if err := h.writeRedirectMap(); err != nil {
+			h.SendError(fmt.Errorf("writeRedirectMap: %w", err))
+		}
+
+		if err := h.writeBuildManifest(); err != nil {
+			h.SendError(fmt.Errorf("writeBuildManifest: %w", err))
+		}
+
+		if err := h.writeIntegrityMap(); err != nil {
+			h.SendError(fmt.Errorf("writeIntegrityMap: %w", err))
+		}
+
+		if err := h.writePageRenderProfile(); err != nil {
+			h.SendError(fmt.Errorf("writePageRenderProfile: %w", err))
+		}
+
+		if err := h.writeMissingTranslations(); err != nil {
+			h.SendError(fmt.Errorf("writeMissingTranslations: %w", err))
+		}
 	}
 
 	if h.Metrics != nil {
@@ -569,6 +622,224 @@ func (h *HugoSites) writeBuildStats() error {
 	return nil
 }
 
+// buildManifestFile describes a published file in the build manifest.
+type buildManifestFile struct {
+	Path      string `json:"path"`
+	Size      int64  `json:"size"`
+	Hash      string `json:"hash"`
+	MediaType string `json:"mediaType"`
+}
+
+// buildManifestMediaType returns the media type for suffix. If the suffix is
+// ambiguous, e.g. xml, the type with a matching sub type is preferred.
+func buildManifestMediaType(types media.Types, suffix, defaultType string) string {
+	if mt, _, found := types.GetBySuffix(suffix); found {
+		return mt.Type
+	}
+	var candidates []media.Type
+	for _, mt := range types {
+		for _, s := range mt.Suffixes() {
+			if strings.EqualFold(s, suffix) {
+				candidates = append(candidates, mt)
+				break
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return defaultType
+	}
+	for _, mt := range candidates {
+		if strings.EqualFold(mt.SubType, suffix) {
+			return mt.Type
+		}
+	}
+	return candidates[0].Type
+}
+
+// writeBuildManifest writes the build manifest to the root of the publish
+// directory listing all files published by Hugo and all static files.
+func (h *HugoSites) writeBuildManifest() error {
+	if !h.Configs.Base.Build.BuildManifest {
+		return nil
+	}
+
+	manifestFilename := h.Configs.Base.Build.BuildManifestFilename
+
+	publishFs := h.BaseFs.PublishFs
+	entries := make(map[string]buildManifestFile)
+
+	addEntry := func(target string, size int64, hash string) {
+		target = strings.TrimPrefix(filepath.ToSlash(target), "/")
+		mediaType := "application/octet-stream"
+		if ext := path.Ext(target); ext != "" {
+			mediaType = buildManifestMediaType(h.ResourceSpec.MediaTypes(), ext[1:], mediaType)
+		}
+		entries[target] = buildManifestFile{
+			Path:      target,
+			Size:      size,
+			Hash:      "sha256:" + hash,
+			MediaType: mediaType,
+		}
+	}
+
+	addFile := func(fs afero.Fs, filename, target string) error {
+		target = strings.TrimPrefix(filepath.ToSlash(target), "/")
+		if target == manifestFilename {
+			return nil
+		}
+		f, err := fs.Open(filename)
+		if err != nil {
+			if herrors.IsNotExist(err) {
+				// Removed after it was published.
+				return nil
+			}
+			return err
+		}
+		defer f.Close()
+		hash := sha256.New()
+		size, err := io.Copy(hash, f)
+		if err != nil {
+			return err
+		}
+		addEntry(target, size, hex.EncodeToString(hash.Sum(nil)))
+		return nil
+	}
+
+	// addCompressed adds the pre-compressed variants of a static file.
+	addCompressed := func(target string, content []byte) error {
+		variants, err := h.precompressor.Compress(target, content)
+		if err != nil {
+			return err
+		}
+		for name, b := range variants {
+			d := sha256.Sum256(b)
+			addEntry(name, int64(len(b)), hex.EncodeToString(d[:]))
+		}
+		return nil
+	}
+
+	// The static files may be copied to the publish directory in parallel
+	// with the build, so read them from the source.
+	for _, sfs := range h.BaseFs.SourceFilesystems.Static {
+		err := afero.Walk(sfs.Fs, "", func(filename string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			if h.IsStaticPipelineFile(filename) {
+				// We need the content to compress it.
+				force := h.precompressor != nil
+				e, content, err := h.processStaticPipelineFile(sfs, filename, force)
+				if err != nil {
+					return err
+				}
+				addEntry(e.target, e.targetSize, e.targetHash)
+				if force {
+					return addCompressed(e.target, content)
+				}
+				return nil
+			}
+			target := filepath.Join(sfs.PublishFolder, filename)
+			if err := addFile(sfs.Fs, filename, target); err != nil {
+				return err
+			}
+			if h.precompressor != nil {
+				content, err := afero.ReadFile(sfs.Fs, filename)
+				if err != nil {
+					if herrors.IsNotExist(err) {
+						return nil
+					}
+					return err
+				}
+				return addCompressed(target, content)
+			}
+			return nil
+		})
+		if err != nil && !herrors.IsNotExist(err) {
+			return err
+		}
+	}
+
+	// Rendered pages, processed resources etc. take precedence over static files.
+	var published []string
+	hugofs.WalkFilesystems(h.Fs.PublishDir, func(fs afero.Fs) bool {
+		if r, ok := fs.(hugofs.CreatedFilenamesReporter); ok {
+			published = r.ReportCreatedFilenames()
+			return true
+		}
+		return false
+	})
+	for _, filename := range published {
+		if err := addFile(publishFs, filename, filename); err != nil {
+			return err
+		}
+	}
+
+	manifest := struct {
+		Files []buildManifestFile `json:"files"`
+	}{
+		Files: make([]buildManifestFile, 0, len(entries)),
+	}
+	for _, e := range entries {
+		manifest.Files = append(manifest.Files, e)
+	}
+	sort.Slice(manifest.Files, func(i, j int) bool {
+		return manifest.Files[i].Path < manifest.Files[j].Path
+	})
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(manifest); err != nil {
+		return err
+	}
+
+	filename := filepath.FromSlash(manifestFilename)
+	if existingContent, err := afero.ReadFile(publishFs, filename); err == nil {
+		// Check if the content has changed.
+		if bytes.Equal(existingContent, buf.Bytes()) {
+			return nil
+		}
+	}
+
+	return afero.WriteReader(publishFs, filename, &buf)
+}
+
+// writeIntegrityMap writes an integrity.json to the root of the publish
+// directory mapping the path of every published fingerprinted resource to
+// its Subresource Integrity hash.
+func (h *HugoSites) writeIntegrityMap() error {
+	if !h.Configs.Base.Build.BuildIntegrity {
+		return nil
+	}
+
+	publishFs := h.BaseFs.PublishFs
+
+	entries := h.ResourceSpec.IntegrityCollector.Entries(func(targetPath string) bool {
+		// Skip resources that were fingerprinted but never published.
+		_, err := publishFs.Stat(filepath.FromSlash(targetPath))
+		return err == nil
+	})
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	// The map keys are sorted.
+	if err := enc.Encode(entries); err != nil {
+		return err
+	}
+
+	if existingContent, err := afero.ReadFile(publishFs, files.FilenameIntegrityJSON); err == nil {
+		// Check if the content has changed.
+		if bytes.Equal(existingContent, buf.Bytes()) {
+			return nil
+		}
+	}
+
+	return afero.WriteFile(publishFs, files.FilenameIntegrityJSON, buf.Bytes(), 0o666)
+}
+
 type pathChange struct {
 	// The path to the changed file.
 	p *paths.Path
@@ -626,6 +897,7 @@ func (h *HugoSites) processPartial(ctx context.Context, l logg.LevelLogger, conf
 		cacheBusters      []func(string) bool
 		deletedDirs       []string
 		addedContentPaths []*paths.Path
+		watchDirsChanged  bool
 	)
 
 	for _, ev := range events {
@@ -654,6 +926,11 @@ func (h *HugoSites) processPartial(ctx context.Context, l logg.LevelLogger, conf
 		isChangedDir := statErr == nil && fi.IsDir()
 
 		cpss := h.BaseFs.ResolvePaths(ev.Name, !removed)
+		if len(cpss) == 0 && h.Configs.Base.Server.IsInWatchDirs(ev.Name) {
+			logger.Println("External file changed", ev.Name)
+			watchDirsChanged = true
+			continue
+		}
 		pss := make([]*paths.Path, len(cpss))
 		for i, cps := range cpss {
 			p := cps.Path
@@ -848,6 +1125,12 @@ func (h *HugoSites) processPartial(ctx context.Context, l logg.LevelLogger, conf
 		}
 	}
 
+	if watchDirsChanged {
+		// We don't know which templates read the files in server.watchDirs,
+		// so invalidate everything.
+		changes = append(changes, identity.GenghisKhan)
+	}
+
 	for _, deleted := range changedPaths.deleted {
 		handleChange(deleted, true, false)
 	}
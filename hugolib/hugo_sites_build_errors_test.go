@@ -598,7 +598,7 @@ toc line 4
 
 		b.Assert(err, qt.IsNotNil)
 		b.Assert(err.Error(), qt.Contains, filepath.FromSlash(`render of "home" failed: "/layouts/index.html:7:8": execute of template failed`))
-		b.Assert(err.Error(), qt.Contains, `execute of template failed: template: partials/toc.html:2:8: executing "partials/toc.html"`)
+		b.Assert(err.Error(), qt.Contains, `execute of template failed (called from index.html:7:8): template: partials/toc.html:2:8: executing "partials/toc.html"`)
 	})
 }
 
@@ -8,6 +8,7 @@ import (
 
 	qt "github.com/frankban/quicktest"
 	"github.com/gohugoio/hugo/common/loggers"
+	"github.com/gohugoio/hugo/deps"
 	"github.com/gohugoio/hugo/htesting"
 	"github.com/gohugoio/hugo/resources/kinds"
 
@@ -375,3 +376,133 @@ func TestRebuildOnAssetChange(t *testing.T) {
 	b.Build(BuildCfg{})
 	b.AssertFileContent("public/index.html", `changed data`)
 }
+
+func TestBuildManifest(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "rss", "404", "robotsTXT", "section"]
+[build]
+buildManifest = true
+-- static/images/logo.svg --
+<svg></svg>
+-- static/b.txt --
+static b.
+-- static/manifest.json --
+{"name": "My App"}
+-- assets/css/main.css --
+body { color: red; }
+-- content/p1.md --
+---
+title: p1
+---
+-- layouts/index.html --
+Home|{{ (resources.Get "css/main.css" | minify).RelPermalink }}
+-- layouts/_default/single.html --
+Single|{{ .Title }}
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContentExact("public/hugo_build_manifest.json", `{
+  "files": [
+    {
+      "path": "b.txt",
+      "size": 9,
+      "hash": "sha256:8f5a89a3f5eb30c010b9dff1002ff718799e0718a28639787227357f344b111f",
+      "mediaType": "text/plain"
+    },
+    {
+      "path": "css/main.min.css",
+`,
+		`"path": "images/logo.svg",
+      "size": 11,`,
+		`"mediaType": "image/svg+xml"`,
+		`"path": "manifest.json",`,
+		`"path": "p1/index.html",`,
+		`"mediaType": "text/html"`,
+		`"path": "sitemap.xml",`,
+		`"mediaType": "application/xml"`,
+	)
+
+	manifest := b.FileContent("public/hugo_build_manifest.json")
+	b.Assert(strings.Index(manifest, `"index.html"`) < strings.Index(manifest, `"p1/index.html"`), qt.IsTrue)
+	b.Assert(manifest, qt.Not(qt.Contains), "hugo_build_manifest.json")
+}
+
+func TestBuildManifestFilename(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "404", "robotsTXT", "section", "sitemap"]
+[build]
+buildManifest = true
+buildManifestFilename = "build/files.json"
+-- layouts/index.html --
+Home.
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/build/files.json", `"path": "index.html",`)
+	b.AssertFileContent("public/build/files.json", `! "path": "build/files.json"`)
+	b.AssertFileExists("public/hugo_build_manifest.json", false)
+}
+
+func TestBuildManifestDisabled(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term"]
+-- layouts/index.html --
+Home.
+`
+
+	b := Test(t, files)
+
+	b.AssertFileExists("public/hugo_build_manifest.json", false)
+}
+
+func TestFilePermissionsFs(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term"]
+[build]
+filePermissions = "0640"
+-- layouts/index.html --
+Home.
+`
+
+	b := Test(t, files)
+
+	numPermissionsFs := func(fs afero.Fs) int {
+		var n int
+		hugofs.WalkFilesystems(fs, func(fs afero.Fs) bool {
+			if _, ok := fs.(hugofs.PermissionsSetter); ok {
+				n++
+			}
+			return false
+		})
+		return n
+	}
+
+	b.Assert(numPermissionsFs(b.H.Fs.PublishDir), qt.Equals, 1)
+	b.Assert(numPermissionsFs(b.H.Fs.PublishDirStatic), qt.Equals, 1)
+
+	// The Fs passed to NewHugoSites is left untouched.
+	b.Assert(numPermissionsFs(b.fs.PublishDir), qt.Equals, 0)
+	b.Assert(numPermissionsFs(b.fs.PublishDirStatic), qt.Equals, 0)
+
+	// A new HugoSites, e.g. after a configuration change in the server,
+	// does not wrap the Fs again.
+	h, err := NewHugoSites(deps.DepsCfg{Configs: b.H.Configs, Fs: b.fs})
+	b.Assert(err, qt.IsNil)
+	b.Assert(numPermissionsFs(h.Fs.PublishDir), qt.Equals, 1)
+	b.Assert(numPermissionsFs(b.fs.PublishDir), qt.Equals, 0)
+}
@@ -636,3 +636,62 @@ Menu Item: {{ $i }}|{{ .URL }}|
 Menu Item: 0|/foo/posts|
 `)
 }
+
+func TestMenusPageRef(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+defaultContentLanguage = "en"
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+[[menus.main]]
+name = "About"
+pageRef = "/about"
+weight = 1
+[[menus.main]]
+name = "Missing"
+pageRef = "/missing"
+weight = 2
+[[menus.main]]
+name = "Fallback"
+pageRef = "/fallback"
+url = "/fallback-url/"
+weight = 3
+-- content/about.en.md --
+---
+title: "About EN"
+params:
+  color: red
+---
+-- content/about.nn.md --
+---
+title: "About NN"
+params:
+  color: blue
+---
+-- layouts/index.html --
+{{ range site.Menus.main }}
+{{ .Name }}: {{ with .Page }}{{ .Title }}|{{ .Params.color }}|{{ else }}nil|{{ end }}{{ .URL }}|
+{{ end }}
+-- layouts/_default/single.html --
+{{ .Title }}
+`
+
+	b := Test(t, files, TestOptWarn())
+
+	b.AssertFileContent("public/index.html",
+		"About: About EN|red|/about/|",
+		"Missing: nil||",
+		"Fallback: nil|/fallback-url/|",
+	)
+	b.AssertFileContent("public/nn/index.html",
+		"About: About NN|blue|/nn/about/|",
+	)
+	b.AssertLogContains(`WARN  Menu "main": entry "Missing": pageRef "/missing" not found.`)
+	b.AssertLogNotContains(`"Fallback"`)
+}
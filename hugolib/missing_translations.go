@@ -0,0 +1,56 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+
+	"github.com/gohugoio/hugo/langs/i18n"
+)
+
+// writeMissingTranslations writes the translations missing in the build to
+// the file set in missingTranslations, if any, or to stderr if set to "-".
+func (h *HugoSites) writeMissingTranslations() error {
+	filename := h.Configs.Base.MissingTranslations
+	if filename == "" {
+		return nil
+	}
+
+	tp, ok := h.Deps.TranslationProvider.(*i18n.TranslationProvider)
+	if !ok {
+		return nil
+	}
+
+	missing := tp.MissingTranslations()
+	if missing == nil {
+		missing = []i18n.MissingTranslation{}
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(missing); err != nil {
+		return err
+	}
+
+	if filename == "-" {
+		_, err := os.Stderr.Write(buf.Bytes())
+		return err
+	}
+
+	return h.writeReportFile(filename, buf.Bytes())
+}
@@ -16,10 +16,14 @@ package hugolib
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 
+	"github.com/gohugoio/hugo/common/hugio"
+	"github.com/gohugoio/hugo/helpers"
 	"github.com/gohugoio/hugo/hugofs"
 	"github.com/gohugoio/hugo/hugolib/doctree"
 	"github.com/gohugoio/hugo/identity"
@@ -31,6 +35,7 @@ import (
 
 	"github.com/gohugoio/hugo/markup/converter"
 	"github.com/gohugoio/hugo/markup/tableofcontents"
+	"github.com/gohugoio/hugo/parser/metadecoders"
 
 	"github.com/gohugoio/hugo/tpl"
 
@@ -67,6 +72,7 @@ var (
 // logging and similar.
 type pageContext interface {
 	posOffset(offset int) text.Position
+	posFromInput(input []byte, offset int) text.Position
 	wrapError(err error) error
 	getContentConverter() converter.Converter
 }
@@ -88,6 +94,10 @@ func (pa pageSiteAdapter) GetPage(ref string) (page.Page, error) {
 	return p, err
 }
 
+func (pa pageSiteAdapter) GetPages(pattern string) (page.Pages, error) {
+	return pa.s.getPages(pa.p, pattern)
+}
+
 type pageState struct {
 	// Incremented for each new page created.
 	// Note that this will change between builds for a given Page.
@@ -145,6 +155,9 @@ func (p *pageState) Key() string {
 
 func (p *pageState) resetBuildState() {
 	p.Scratcher = maps.NewScratcher()
+	if p.m.f != nil {
+		p.m.f.ResetContentHash()
+	}
 }
 
 func (p *pageState) reusePageOutputContent() bool {
@@ -217,12 +230,32 @@ func (p *pageState) CodeOwners() []string {
 	return p.codeowners
 }
 
+func (p *pageState) Contributors() []source.GitContributor {
+	return p.contributors
+}
+
 // GetTerms gets the terms defined on this page in the given taxonomy.
 // The pages returned will be ordered according to the front matter.
 func (p *pageState) GetTerms(taxonomy string) page.Pages {
 	return p.s.pageMap.getTermsForPageInTaxonomy(p.Path(), taxonomy)
 }
 
+// NextInTerm returns the next page in the given taxonomy term, nil if none.
+// The pages are sorted by the term's weight, then Hugo's default sort, and,
+// as with NextInSection, the next page is the one sorted before this one.
+func (p *pageState) NextInTerm(taxonomy, term string) page.Page {
+	return p.weightedPagesInTerm(taxonomy, term).Next(p)
+}
+
+// PrevInTerm returns the previous page in the given taxonomy term, nil if none.
+func (p *pageState) PrevInTerm(taxonomy, term string) page.Page {
+	return p.weightedPagesInTerm(taxonomy, term).Prev(p)
+}
+
+func (p *pageState) weightedPagesInTerm(taxonomy, term string) page.WeightedPages {
+	return p.s.Taxonomies()[strings.ToLower(taxonomy)].Get(strings.ToLower(term)).Listed()
+}
+
 func (p *pageState) MarshalJSON() ([]byte, error) {
 	return page.MarshalPageToJSON(p)
 }
@@ -328,10 +361,84 @@ func (p *pageState) RawContent() string {
 	return string(source[start:])
 }
 
+// ContentWithoutShortcode returns RawContent with all top level shortcodes
+// with the given name removed, including their inner content.
+func (p *pageState) ContentWithoutShortcode(name string) string {
+	if p.m.content.pi.itemsStep2 == nil {
+		return ""
+	}
+	source, err := p.m.content.pi.contentSource(p.m.content)
+	if err != nil {
+		panic(err)
+	}
+	return string(p.m.content.pi.contentWithoutShortcode(source, name))
+}
+
+// RawFrontMatter returns the front matter as declared in the source,
+// with the keys in order.
+func (p *pageState) RawFrontMatter() (metadecoders.OrderedMap, error) {
+	m, err := p.m.content.pi.orderedFrontMatter(p.m.content)
+	if err != nil {
+		return nil, p.wrapError(err)
+	}
+	return m, nil
+}
+
 func (p *pageState) Resources() resource.Resources {
 	return p.s.pageMap.getOrCreateResourcesForPage(p)
 }
 
+// pageBundle provides the files bundled with a page to its source.File,
+// see File.ContentHash.
+type pageBundle struct {
+	p *pageState
+}
+
+var _ source.Bundle = pageBundle{}
+
+// BundledContentHashes returns the content hashes of the files bundled with
+// the page, prefixed with their names and sorted.
+func (b pageBundle) BundledContentHashes() ([]string, error) {
+	var hashes []string
+	for _, r := range b.p.Resources() {
+		var (
+			h   string
+			err error
+		)
+		switch rr := r.(type) {
+		case page.Page:
+			if rr.File() == nil {
+				continue
+			}
+			h, err = rr.File().ContentHash()
+		case resource.ReadSeekCloserResource:
+			var f hugio.ReadSeekCloser
+			f, err = rr.ReadSeekCloser()
+			if err == nil {
+				h, err = helpers.MD5FromReader(f)
+				f.Close()
+			}
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash %q: %w", r.Name(), err)
+		}
+		hashes = append(hashes, r.Name()+":"+h)
+	}
+	sort.Strings(hashes)
+	return hashes, nil
+}
+
+// ForEeachBundledIdentity calls cb for the identity of each file bundled with the page.
+func (b pageBundle) ForEeachBundledIdentity(cb func(id identity.Identity) bool) {
+	for _, r := range b.p.Resources() {
+		if cb(identity.FirstIdentity(r)) {
+			return
+		}
+	}
+}
+
 func (p *pageState) HasShortcode(name string) bool {
 	if p.m.content.shortcodeState == nil {
 		return false
@@ -413,6 +520,11 @@ func (p *pageState) Translations() page.Pages {
 	return pages
 }
 
+// Hreflangs returns the hreflang entries for all translations, including the current Page.
+func (p *pageState) Hreflangs() page.Hreflangs {
+	return page.NewHreflangs(p.AllTranslations(), p.s.Conf.DefaultContentLanguage())
+}
+
 func (ps *pageState) initCommonProviders(pp pagePaths) error {
 	if ps.IsPage() {
 		ps.posNextPrev = &nextPrev{init: ps.s.init.prevNext}
@@ -512,6 +624,34 @@ func (p *pageState) renderResources() error {
 	return initErr
 }
 
+// OutputFormats returns the output formats of this page, with the output
+// format currently being rendered, if any, marked as current.
+func (p *pageState) OutputFormats() page.OutputFormats {
+	formats := p.OutputFormatsProvider.OutputFormats()
+	if p.s.rc == nil {
+		return formats
+	}
+	for i, f := range formats {
+		if f.Format.Name == p.s.rc.Format.Name {
+			formats = append(page.OutputFormats(nil), formats...)
+			formats[i] = f.WithCurrent()
+			break
+		}
+	}
+	return formats
+}
+
+// OutputFormat returns the output format currently being rendered.
+func (p *pageState) OutputFormat() page.OutputFormat {
+	f := p.outputFormat()
+	for _, of := range p.OutputFormatsProvider.OutputFormats() {
+		if of.Format.Name == f.Name {
+			return of.WithCurrent()
+		}
+	}
+	return page.NewOutputFormat("", "", false, f).WithCurrent()
+}
+
 func (p *pageState) AlternativeOutputFormats() page.OutputFormats {
 	f := p.outputFormat()
 	var o page.OutputFormats
@@ -526,13 +666,25 @@ func (p *pageState) AlternativeOutputFormats() page.OutputFormats {
 }
 
 type renderStringOpts struct {
-	Display string
-	Markup  string
+	Display         string
+	Markup          string
+	Shortcodes      bool
+	HeadingIDPrefix string
 }
 
 var defaultRenderStringOpts = renderStringOpts{
-	Display: "inline",
-	Markup:  "", // Will inherit the page's value when not set.
+	Display:         "inline",
+	Markup:          "", // Will inherit the page's value when not set.
+	Shortcodes:      true,
+	HeadingIDPrefix: "", // Will inherit the page's value when not set.
+}
+
+type plainTextOpts struct {
+	Shortcodes bool
+}
+
+var defaultPlainTextOpts = plainTextOpts{
+	Shortcodes: true,
 }
 
 func (p *pageMeta) wrapError(err error, sourceFs afero.Fs) error {
@@ -92,8 +92,9 @@ type pageCommon struct {
 	layoutDescriptorInit sync.Once
 
 	// Set if feature enabled and this is in a Git repo.
-	gitInfo    source.GitInfo
-	codeowners []string
+	gitInfo      source.GitInfo
+	codeowners   []string
+	contributors []source.GitContributor
 
 	// Positional navigation
 	posNextPrev        *nextPrev
@@ -20,14 +20,18 @@ import (
 	"fmt"
 	"html/template"
 	"io"
+	"math"
 	"strconv"
 	"strings"
+	"sync"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/bep/logg"
 	"github.com/gohugoio/hugo/common/hcontext"
 	"github.com/gohugoio/hugo/common/herrors"
 	"github.com/gohugoio/hugo/common/hugio"
+	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/helpers"
 	"github.com/gohugoio/hugo/identity"
 	"github.com/gohugoio/hugo/markup/converter"
@@ -150,6 +154,12 @@ type contentParseInfo struct {
 
 	frontMatter map[string]any
 
+	// The front matter item, used to create the ordered front matter on demand.
+	frontMatterItem        pageparser.Item
+	frontMatterOrderedInit sync.Once
+	frontMatterOrdered     metadecoders.OrderedMap
+	frontMatterOrderedErr  error
+
 	// Whether the parsed content contains a summary separator.
 	hasSummaryDivider bool
 
@@ -225,6 +235,48 @@ func (pi *contentParseInfo) contentToRender(ctx context.Context, source []byte,
 	return c, hasVariants, nil
 }
 
+// contentWithoutShortcode returns the source of the main content with all top
+// level shortcodes with the given name removed.
+func (pi *contentParseInfo) contentWithoutShortcode(source []byte, name string) []byte {
+	start := pi.posMainContent
+	if start == -1 {
+		start = 0
+	}
+
+	var (
+		b    = make([]byte, 0, len(source)-start)
+		skip = -1
+	)
+
+	for _, it := range pi.itemsStep2 {
+		var pos int
+		switch v := it.(type) {
+		case pageparser.Item:
+			pos = v.Pos()
+		case pageContentReplacement:
+			pos = v.source.Pos()
+		case *shortcode:
+			pos = v.pos
+		}
+
+		if skip != -1 {
+			// The removed shortcode ends where the next item starts.
+			start, skip = pos, -1
+		}
+
+		if sc, ok := it.(*shortcode); ok && strings.EqualFold(sc.name, name) {
+			b = append(b, source[start:pos]...)
+			skip = pos
+		}
+	}
+
+	if skip == -1 {
+		b = append(b, source[start:]...)
+	}
+
+	return b
+}
+
 func (c *cachedContent) IsZero() bool {
 	return len(c.pi.itemsStep2) == 0
 }
@@ -242,6 +294,7 @@ func (c *contentParseInfo) parseFrontMatter(it pageparser.Item, iter *pageparser
 		return nil
 	}
 
+	c.frontMatterItem = it
 	f := pageparser.FormatFromFrontMatterType(it.Type)
 	var err error
 	c.frontMatter, err = metadecoders.Default.UnmarshalToMap(it.Val(source), f)
@@ -268,6 +321,25 @@ func (c *contentParseInfo) parseFrontMatter(it pageparser.Item, iter *pageparser
 	return nil
 }
 
+// orderedFrontMatter returns the front matter with the keys in the order
+// they are declared in the source.
+func (c *contentParseInfo) orderedFrontMatter(s resource.StaleInfo) (metadecoders.OrderedMap, error) {
+	c.frontMatterOrderedInit.Do(func() {
+		if !c.frontMatterItem.IsFrontMatter() {
+			c.frontMatterOrdered = metadecoders.OrderedMap{}
+			return
+		}
+		source, err := c.contentSource(s)
+		if err != nil {
+			c.frontMatterOrderedErr = err
+			return
+		}
+		f := pageparser.FormatFromFrontMatterType(c.frontMatterItem.Type)
+		c.frontMatterOrdered, c.frontMatterOrderedErr = metadecoders.Default.UnmarshalToOrderedMap(c.frontMatterItem.Val(source), f)
+	})
+	return c.frontMatterOrdered, c.frontMatterOrderedErr
+}
+
 func (rn *contentParseInfo) failMap(source []byte, err error, i pageparser.Item) error {
 	if fe, ok := err.(herrors.FileError); ok {
 		return fe
@@ -479,9 +551,10 @@ type contentPlainPlainWords struct {
 	summary          template.HTML
 	summaryTruncated bool
 
-	wordCount      int
-	fuzzyWordCount int
-	readingTime    int
+	wordCount          int
+	fuzzyWordCount     int
+	readingTime        int
+	readingTimeSeconds int
 }
 
 func (c *cachedContent) contentRendered(ctx context.Context, cp *pageContentOutput) (contentSummary, error) {
@@ -496,7 +569,6 @@ func (c *cachedContent) contentRendered(ctx context.Context, cp *pageContentOutp
 
 		cp.po.p.s.h.contentRenderCounter.Add(1)
 		cp.contentRendered = true
-		po := cp.po
 
 		ct, err := c.contentToC(ctx, cp)
 		if err != nil {
@@ -514,84 +586,143 @@ func (c *cachedContent) contentRendered(ctx context.Context, cp *pageContentOutp
 			return rs, nil
 		}
 
-		var b []byte
+		result, hasShortcodeVariants, err := c.renderContent(ctx, cp, ct, true)
+		if err != nil {
+			return nil, err
+		}
+		if hasShortcodeVariants {
+			cp.po.p.pageOutputTemplateVariationsState.Add(1)
+		}
+		rs.Value = result
+
+		return rs, nil
+	})
+	if err != nil {
+		return contentSummary{}, cp.po.p.wrapError(err)
+	}
 
-		if ct.astDoc != nil {
-			// The content is parsed, but not rendered.
-			r, ok, err := po.contentRenderer.RenderContent(ctx, ct.contentToRender, ct.astDoc)
-			if err != nil {
-				return nil, err
-			}
-			if !ok {
-				return nil, errors.New("invalid state: astDoc is set but RenderContent returned false")
-			}
+	return v.Value, nil
+}
 
-			b = r.Bytes()
+// contentRenderedWithoutShortcodes returns the rendered content with the
+// output of any shortcodes using the {{< >}} notation removed.
+func (c *cachedContent) contentRenderedWithoutShortcodes(ctx context.Context, cp *pageContentOutput) (template.HTML, error) {
+	ctx = tpl.Context.DependencyScope.Set(ctx, pageDependencyScopeGlobal)
+	key := c.pi.sourceKey + "/" + cp.po.f.Name
+	versionv := cp.contentRenderedVersion
 
-		} else {
-			// Copy the content to be rendered.
-			b = make([]byte, len(ct.contentToRender))
-			copy(b, ct.contentToRender)
+	v, err := c.pm.cacheContentRenderedNoShortcodes.GetOrCreate(key, func(string) (*resources.StaleValue[template.HTML], error) {
+		ct, err := c.contentToC(ctx, cp)
+		if err != nil {
+			return nil, err
 		}
 
-		// There are one or more replacement tokens to be replaced.
-		var hasShortcodeVariants bool
-		tokenHandler := func(ctx context.Context, token string) ([]byte, error) {
-			if token == tocShortcodePlaceholder {
-				return []byte(ct.tableOfContentsHTML), nil
-			}
-			renderer, found := ct.contentPlaceholders[token]
-			if found {
-				repl, more, err := renderer.renderShortcode(ctx)
-				if err != nil {
-					return nil, err
-				}
-				hasShortcodeVariants = hasShortcodeVariants || more
-				return repl, nil
-			}
-			// This should never happen.
-			panic(fmt.Errorf("unknown shortcode token %q (number of tokens: %d)", token, len(ct.contentPlaceholders)))
+		rs := &resources.StaleValue[template.HTML]{
+			IsStaleFunc: func() bool {
+				return c.IsStale() || cp.contentRenderedVersion != versionv
+			},
+		}
+
+		if len(c.pi.itemsStep2) == 0 {
+			// Nothing to do.
+			return rs, nil
 		}
 
-		b, err = expandShortcodeTokens(ctx, b, tokenHandler)
+		result, _, err := c.renderContent(ctx, cp, ct, false)
 		if err != nil {
 			return nil, err
 		}
-		if hasShortcodeVariants {
-			cp.po.p.pageOutputTemplateVariationsState.Add(1)
+		rs.Value = result.content
+
+		return rs, nil
+	})
+	if err != nil {
+		return "", cp.po.p.wrapError(err)
+	}
+
+	return v.Value, nil
+}
+
+// renderContent renders the content and splits out any user defined summary.
+// If renderShortcodes is false, the output of shortcodes using the {{< >}}
+// notation is removed.
+func (c *cachedContent) renderContent(ctx context.Context, cp *pageContentOutput, ct contentTableOfContents, renderShortcodes bool) (contentSummary, bool, error) {
+	var (
+		result contentSummary
+		b      []byte
+		po     = cp.po
+	)
+
+	if ct.astDoc != nil {
+		// The content is parsed, but not rendered.
+		r, ok, err := po.contentRenderer.RenderContent(ctx, ct.contentToRender, ct.astDoc)
+		if err != nil {
+			return result, false, err
+		}
+		if !ok {
+			return result, false, errors.New("invalid state: astDoc is set but RenderContent returned false")
 		}
 
-		var result contentSummary // hasVariants bool
+		b = r.Bytes()
 
-		if c.pi.hasSummaryDivider {
-			isHTML := cp.po.p.m.pageConfig.Markup == "html"
-			if isHTML {
-				// Use the summary sections as provided by the user.
-				i := bytes.Index(b, internalSummaryDividerPre)
-				result.summary = helpers.BytesToHTML(b[:i])
-				b = b[i+len(internalSummaryDividerPre):]
+	} else {
+		// Copy the content to be rendered.
+		b = make([]byte, len(ct.contentToRender))
+		copy(b, ct.contentToRender)
+	}
 
-			} else {
-				summary, content, err := splitUserDefinedSummaryAndContent(cp.po.p.m.pageConfig.Markup, b)
-				if err != nil {
-					cp.po.p.s.Log.Errorf("Failed to set user defined summary for page %q: %s", cp.po.p.pathOrTitle(), err)
-				} else {
-					b = content
-					result.summary = helpers.BytesToHTML(summary)
-				}
+	// There are one or more replacement tokens to be replaced.
+	var hasShortcodeVariants bool
+	tokenHandler := func(ctx context.Context, token string) ([]byte, error) {
+		if token == tocShortcodePlaceholder {
+			if !renderShortcodes {
+				return nil, nil
 			}
-			result.summaryTruncated = c.pi.summaryTruncated
+			return []byte(ct.tableOfContentsHTML), nil
 		}
-		result.content = helpers.BytesToHTML(b)
-		rs.Value = result
+		renderer, found := ct.contentPlaceholders[token]
+		if found {
+			if !renderShortcodes {
+				return nil, nil
+			}
+			repl, more, err := renderer.renderShortcode(ctx)
+			if err != nil {
+				return nil, err
+			}
+			hasShortcodeVariants = hasShortcodeVariants || more
+			return repl, nil
+		}
+		// This should never happen.
+		panic(fmt.Errorf("unknown shortcode token %q (number of tokens: %d)", token, len(ct.contentPlaceholders)))
+	}
 
-		return rs, nil
-	})
+	b, err := expandShortcodeTokens(ctx, b, tokenHandler)
 	if err != nil {
-		return contentSummary{}, cp.po.p.wrapError(err)
+		return result, false, err
 	}
 
-	return v.Value, nil
+	if c.pi.hasSummaryDivider {
+		isHTML := cp.po.p.m.pageConfig.Markup == "html"
+		if isHTML {
+			// Use the summary sections as provided by the user.
+			i := bytes.Index(b, internalSummaryDividerPre)
+			result.summary = helpers.BytesToHTML(b[:i])
+			b = b[i+len(internalSummaryDividerPre):]
+
+		} else {
+			summary, content, err := splitUserDefinedSummaryAndContent(cp.po.p.m.pageConfig.Markup, b)
+			if err != nil {
+				cp.po.p.s.Log.Errorf("Failed to set user defined summary for page %q: %s", cp.po.p.pathOrTitle(), err)
+			} else {
+				b = content
+				result.summary = helpers.BytesToHTML(summary)
+			}
+		}
+		result.summaryTruncated = c.pi.summaryTruncated
+	}
+	result.content = helpers.BytesToHTML(b)
+
+	return result, hasShortcodeVariants, nil
 }
 
 func (c *cachedContent) mustContentToC(ctx context.Context, cp *pageContentOutput) contentTableOfContents {
@@ -621,7 +752,7 @@ func (c *cachedContent) contentToC(ctx context.Context, cp *pageContentOutput) (
 		f := cp.po.f
 		po := cp.po
 		p := po.p
-		ct.contentPlaceholders, err = c.shortcodeState.prepareShortcodesForPage(ctx, p, f, false)
+		ct.contentPlaceholders, err = c.shortcodeState.prepareShortcodesForPage(ctx, p, f)
 		if err != nil {
 			return nil, err
 		}
@@ -764,11 +895,8 @@ func (c *cachedContent) contentPlain(ctx context.Context, cp *pageContentOutput)
 			result.fuzzyWordCount = (result.wordCount + 100) / 100 * 100
 		}
 
-		if isCJKLanguage {
-			result.readingTime = (result.wordCount + 500) / 501
-		} else {
-			result.readingTime = (result.wordCount + 212) / 213
-		}
+		result.readingTimeSeconds = readingTimeSeconds(result.plainWords, cp.po.p.s.conf.ReadingTime)
+		result.readingTime = (result.readingTimeSeconds + 59) / 60
 
 		if rendered.summary != "" {
 			result.summary = rendered.summary
@@ -804,3 +932,28 @@ func (c *cachedContent) contentPlain(ctx context.Context, cp *pageContentOutput)
 	}
 	return v.Value, nil
 }
+
+// readingTimeSeconds returns the time in seconds to read words.
+// Chinese, Japanese and Korean characters are read at the configured
+// characters per minute rate, all other words at the words per minute rate.
+func readingTimeSeconds(words []string, cfg config.ReadingTimeConfig) int {
+	var wordCount, charCount int
+	for _, word := range words {
+		var cjk, other int
+		for _, r := range word {
+			if unicode.In(r, unicode.Han, unicode.Hangul, unicode.Hiragana, unicode.Katakana) {
+				cjk++
+			} else {
+				other++
+			}
+		}
+		charCount += cjk
+		if other > 0 {
+			wordCount++
+		}
+	}
+
+	seconds := float64(wordCount)*60/float64(cfg.WordsPerMinute) + float64(charCount)*60/float64(cfg.CharactersPerMinute)
+
+	return int(math.Ceil(seconds))
+}
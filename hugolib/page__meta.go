@@ -37,6 +37,7 @@ import (
 	"github.com/gohugoio/hugo/common/paths"
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/helpers"
+	hglob "github.com/gohugoio/hugo/hugofs/glob"
 
 	"github.com/gohugoio/hugo/output"
 	"github.com/gohugoio/hugo/resources/kinds"
@@ -80,6 +81,7 @@ func (m *pageMeta) setMetaPostPrepareRebuild() {
 type pageMetaParams struct {
 	setMetaPostCount          int
 	setMetaPostCascadeChanged bool
+	unsafeFromCascade         bool // Whether unsafe is set in a cascade from a section or the site config.
 
 	pageConfig *pagemeta.PageConfig
 
@@ -332,6 +334,10 @@ func (ps *pageState) setMetaPost(cascade map[page.PageMatcher]maps.Params) error
 
 	}
 
+	// A cascade in a regular page only applies to itself,
+	// so treat it as the page's own front matter.
+	unsafeInOwnCascade := !ps.IsNode() && cascadeHasKey(ps, ps.m.pageConfig.Cascade, "unsafe")
+
 	// Apply cascades first so they can be overriden later.
 	if cascade != nil {
 		if ps.m.pageConfig.Cascade != nil {
@@ -370,6 +376,7 @@ func (ps *pageState) setMetaPost(cascade map[page.PageMatcher]maps.Params) error
 	}
 
 	// Cascade is also applied to itself.
+	ps.m.unsafeFromCascade = false
 	for m, v := range cascade {
 		if !m.Matches(ps) {
 			continue
@@ -377,6 +384,9 @@ func (ps *pageState) setMetaPost(cascade map[page.PageMatcher]maps.Params) error
 		for kk, vv := range v {
 			if _, found := ps.m.pageConfig.Params[kk]; !found {
 				ps.m.pageConfig.Params[kk] = vv
+				if strings.EqualFold(kk, "unsafe") {
+					ps.m.unsafeFromCascade = !unsafeInOwnCascade
+				}
 			}
 		}
 	}
@@ -562,6 +572,29 @@ func (p *pageState) setMetaPostParams() error {
 		case "translationkey":
 			pcfg.TranslationKey = cast.ToString(v)
 			params[loki] = pcfg.TranslationKey
+		case "headingidprefix":
+			pcfg.HeadingIDPrefix = cast.ToString(v)
+			params[loki] = pcfg.HeadingIDPrefix
+		case "uglyurls":
+			pcfg.UglyURLs = new(bool)
+			*pcfg.UglyURLs = cast.ToBool(v)
+			params[loki] = *pcfg.UglyURLs
+		case "hardwraps":
+			pcfg.HardWraps = new(bool)
+			*pcfg.HardWraps = cast.ToBool(v)
+			params[loki] = *pcfg.HardWraps
+		case "unsafe":
+			unsafe := cast.ToBool(v)
+			if unsafe && !p.isUnsafeOverrideAllowed() {
+				p.s.Log.Warnidf(constants.WarnFrontMatterUnsafe, "Ignoring unsafe = true in front matter of %q; see markup.goldmark.renderer.unsafeOverride.", p.pathOrTitle())
+				break
+			}
+			pcfg.Unsafe = &unsafe
+			params[loki] = unsafe
+		case "sanitize":
+			pcfg.Sanitize = new(bool)
+			*pcfg.Sanitize = cast.ToBool(v)
+			params[loki] = *pcfg.Sanitize
 		case "resources":
 			var resources []map[string]any
 			handled := true
@@ -630,6 +663,8 @@ func (p *pageState) setMetaPostParams() error {
 		params[strings.ToLower(k)] = v
 	}
 
+	pm.s.frontmatterHandler.HandleTranslationKey(descriptor)
+
 	if !sitemapSet {
 		pcfg.Sitemap = p.s.conf.Sitemap
 	}
@@ -749,6 +784,40 @@ func (p *pageMeta) applyDefaultValues() error {
 	return nil
 }
 
+// isUnsafeOverrideAllowed reports whether p may enable unsafe in front matter.
+func (p *pageState) isUnsafeOverrideAllowed() bool {
+	cfg := p.s.conf.Markup.Goldmark.Renderer.UnsafeOverride
+	if !cfg.Enable {
+		return false
+	}
+	if p.m.unsafeFromCascade {
+		return true
+	}
+	for _, pattern := range cfg.Paths {
+		// The patterns are validated when the config is loaded.
+		g, err := hglob.GetGlob(pattern)
+		if err == nil && g.Match(p.Path()) {
+			return true
+		}
+	}
+	return false
+}
+
+// cascadeHasKey reports whether any of the cascade entries matching p sets key.
+func cascadeHasKey(p *pageState, cascade map[page.PageMatcher]maps.Params, key string) bool {
+	for m, v := range cascade {
+		if !m.Matches(p) {
+			continue
+		}
+		for k := range v {
+			if strings.EqualFold(k, key) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (p *pageMeta) newContentConverter(ps *pageState, markup string) (converter.Converter, error) {
 	if ps == nil {
 		panic("no Page provided")
@@ -775,6 +844,9 @@ func (p *pageMeta) newContentConverter(ps *pageState, markup string) (converter.
 			DocumentID:   id,
 			DocumentName: path,
 			Filename:     filename,
+			HardWraps:    p.pageConfig.HardWraps,
+			Unsafe:       p.pageConfig.Unsafe,
+			Sanitize:     p.pageConfig.Sanitize,
 		},
 	)
 	if err != nil {
@@ -210,6 +210,7 @@ func (h *HugoSites) newPage(m *pageMeta) (*pageState, *paths.Path, error) {
 				return nil, fmt.Errorf("failed to load Git data: %w", err)
 			}
 			ps.gitInfo = gi
+			ps.contributors = m.s.h.contributorsForPage(ps)
 			owners, err := m.s.h.codeownersForPage(ps)
 			if err != nil {
 				return nil, fmt.Errorf("failed to load CODEOWNERS: %w", err)
@@ -114,7 +114,7 @@ func createTargetPathDescriptor(p *pageState) (page.TargetPathDescriptor, error)
 	alwaysInSubDir := p.Kind() == kinds.KindSitemap
 
 	pageInfoPage := p.PathInfo()
-	pageInfoCurrentSection := p.CurrentSection().PathInfo()
+	pageInfoCurrentSection := pageTree{p: p}.currentSection().PathInfo()
 	if p.s.Conf.DisablePathToLower() {
 		pageInfoPage = pageInfoPage.Unmormalized()
 		pageInfoCurrentSection = pageInfoCurrentSection.Unmormalized()
@@ -130,6 +130,10 @@ func createTargetPathDescriptor(p *pageState) (page.TargetPathDescriptor, error)
 		URL:         pm.pageConfig.URL,
 	}
 
+	if pm.pageConfig.UglyURLs != nil {
+		desc.UglyURLs = *pm.pageConfig.UglyURLs
+	}
+
 	if pm.Slug() != "" {
 		desc.BaseName = pm.Slug()
 	} else if pm.isStandalone() && pm.standaloneOutputFormat.BaseName != "" {
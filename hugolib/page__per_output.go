@@ -22,6 +22,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/gohugoio/hugo/common/herrors"
 	"github.com/gohugoio/hugo/common/text"
 	"github.com/gohugoio/hugo/common/types/hstring"
 	"github.com/gohugoio/hugo/identity"
@@ -205,6 +206,40 @@ func (pco *pageContentOutput) Plain(ctx context.Context) string {
 	return pco.mustContentPlain(ctx).plain
 }
 
+func (pco *pageContentOutput) PlainText(ctx context.Context, args ...any) (string, error) {
+	if len(args) > 1 {
+		return "", errors.New("want 0 or 1 arguments")
+	}
+
+	opts := defaultPlainTextOpts
+	if len(args) == 1 {
+		m, ok := args[0].(map[string]any)
+		if !ok {
+			return "", errors.New("first argument must be a map")
+		}
+		if err := mapstructure.WeakDecode(m, &opts); err != nil {
+			return "", fmt.Errorf("failed to decode options: %w", err)
+		}
+	}
+
+	var content template.HTML
+	if opts.Shortcodes {
+		r, err := pco.po.p.m.content.contentRendered(ctx, pco)
+		if err != nil {
+			return "", err
+		}
+		content = r.content
+	} else {
+		var err error
+		content, err = pco.po.p.m.content.contentRenderedWithoutShortcodes(ctx, pco)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return tpl.HTMLToPlainText(string(content)), nil
+}
+
 func (pco *pageContentOutput) PlainWords(ctx context.Context) []string {
 	return pco.mustContentPlain(ctx).plainWords
 }
@@ -213,6 +248,10 @@ func (pco *pageContentOutput) ReadingTime(ctx context.Context) int {
 	return pco.mustContentPlain(ctx).readingTime
 }
 
+func (pco *pageContentOutput) ReadingTimeSeconds(ctx context.Context) int {
+	return pco.mustContentPlain(ctx).readingTimeSeconds
+}
+
 func (pco *pageContentOutput) WordCount(ctx context.Context) int {
 	return pco.mustContentPlain(ctx).wordCount
 }
@@ -236,6 +275,7 @@ func (pco *pageContentOutput) RenderString(ctx context.Context, args ...any) (te
 
 	var contentToRender string
 	opts := defaultRenderStringOpts
+	opts.HeadingIDPrefix = pco.po.p.m.pageConfig.HeadingIDPrefix
 	sidx := 1
 
 	if len(args) == 1 {
@@ -285,7 +325,7 @@ func (pco *pageContentOutput) RenderString(ctx context.Context, args ...any) (te
 		pid: pco.po.p.pid,
 	}
 
-	if pageparser.HasShortcode(contentToRender) {
+	if opts.Shortcodes && pageparser.HasShortcode(contentToRender) {
 		contentToRenderb := []byte(contentToRender)
 		// String contains a shortcode.
 		parseInfo.itemsStep1, err = pageparser.ParseBytesMain(contentToRenderb, pageparser.Config{})
@@ -294,11 +334,19 @@ func (pco *pageContentOutput) RenderString(ctx context.Context, args ...any) (te
 		}
 
 		s := newShortcodeHandler(pco.po.p.pathOrTitle(), pco.po.p.s)
+		s.source = contentToRenderb
 		if err := parseInfo.mapItemsAfterFrontMatter(contentToRenderb, s); err != nil {
+			if fe, ok := err.(herrors.FileError); ok {
+				// Positions are relative to the string, not the page.
+				if fe.Position().Filename == "" {
+					fe = fe.SetFilename(pco.po.p.pathOrTitle())
+				}
+				err = fe.UpdateContent(bytes.NewReader(contentToRenderb), nil)
+			}
 			return "", err
 		}
 
-		placeholders, err := s.prepareShortcodesForPage(ctx, pco.po.p, pco.po.f, true)
+		placeholders, err := s.prepareShortcodesForPage(ctx, pco.po.p, pco.po.f)
 		if err != nil {
 			return "", err
 		}
@@ -310,7 +358,7 @@ func (pco *pageContentOutput) RenderString(ctx context.Context, args ...any) (te
 		if hasVariants {
 			pco.po.p.pageOutputTemplateVariationsState.Add(1)
 		}
-		b, err := pco.renderContentWithConverter(ctx, conv, contentToRender, false)
+		b, err := pco.renderContentWithConverter(ctx, conv, contentToRender, false, opts.HeadingIDPrefix)
 		if err != nil {
 			return "", pco.po.p.wrapError(err)
 		}
@@ -354,7 +402,7 @@ func (pco *pageContentOutput) RenderString(ctx context.Context, args ...any) (te
 		pco.po.p.m.content.shortcodeState.transferNames(s)
 
 	} else {
-		c, err := pco.renderContentWithConverter(ctx, conv, []byte(contentToRender), false)
+		c, err := pco.renderContentWithConverter(ctx, conv, []byte(contentToRender), false, opts.HeadingIDPrefix)
 		if err != nil {
 			return "", pco.po.p.wrapError(err)
 		}
@@ -452,6 +500,14 @@ func (pco *pageContentOutput) initRenderHooks() error {
 				layoutDescriptor.Kind = "render-image"
 			case hooks.HeadingRendererType:
 				layoutDescriptor.Kind = "render-heading"
+			case hooks.WikilinkRendererType:
+				layoutDescriptor.Kind = "render-wikilink"
+			case hooks.FootnoteRendererType:
+				layoutDescriptor.Kind = "render-footnote"
+			case hooks.BlockquoteRendererType:
+				layoutDescriptor.Kind = "render-blockquote"
+			case hooks.TableRendererType:
+				layoutDescriptor.Kind = "render-table"
 			case hooks.CodeBlockRendererType:
 				layoutDescriptor.Kind = "render-codeblock"
 				if id != nil {
@@ -546,7 +602,7 @@ func (cp *pageContentOutput) ParseAndRenderContent(ctx context.Context, content
 	if err != nil {
 		return nil, err
 	}
-	return cp.renderContentWithConverter(ctx, c, content, renderTOC)
+	return cp.renderContentWithConverter(ctx, c, content, renderTOC, cp.po.p.m.pageConfig.HeadingIDPrefix)
 }
 
 func (pco *pageContentOutput) ParseContent(ctx context.Context, content []byte) (converter.ResultParse, bool, error) {
@@ -559,10 +615,11 @@ func (pco *pageContentOutput) ParseContent(ctx context.Context, content []byte)
 		return nil, ok, nil
 	}
 	rctx := converter.RenderContext{
-		Ctx:         ctx,
-		Src:         content,
-		RenderTOC:   true,
-		GetRenderer: pco.renderHooks.getRenderer,
+		Ctx:             ctx,
+		Src:             content,
+		RenderTOC:       true,
+		HeadingIDPrefix: pco.po.p.m.pageConfig.HeadingIDPrefix,
+		GetRenderer:     pco.renderHooks.getRenderer,
 	}
 	r, err := p.Parse(rctx)
 	return r, ok, err
@@ -578,22 +635,24 @@ func (pco *pageContentOutput) RenderContent(ctx context.Context, content []byte,
 		return nil, ok, nil
 	}
 	rctx := converter.RenderContext{
-		Ctx:         ctx,
-		Src:         content,
-		RenderTOC:   true,
-		GetRenderer: pco.renderHooks.getRenderer,
+		Ctx:             ctx,
+		Src:             content,
+		RenderTOC:       true,
+		HeadingIDPrefix: pco.po.p.m.pageConfig.HeadingIDPrefix,
+		GetRenderer:     pco.renderHooks.getRenderer,
 	}
 	r, err := p.Render(rctx, doc)
 	return r, ok, err
 }
 
-func (pco *pageContentOutput) renderContentWithConverter(ctx context.Context, c converter.Converter, content []byte, renderTOC bool) (converter.ResultRender, error) {
+func (pco *pageContentOutput) renderContentWithConverter(ctx context.Context, c converter.Converter, content []byte, renderTOC bool, headingIDPrefix string) (converter.ResultRender, error) {
 	r, err := c.Convert(
 		converter.RenderContext{
-			Ctx:         ctx,
-			Src:         content,
-			RenderTOC:   renderTOC,
-			GetRenderer: pco.renderHooks.getRenderer,
+			Ctx:             ctx,
+			Src:             content,
+			RenderTOC:       renderTOC,
+			HeadingIDPrefix: headingIDPrefix,
+			GetRenderer:     pco.renderHooks.getRenderer,
 		})
 	return r, err
 }
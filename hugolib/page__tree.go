@@ -56,24 +56,50 @@ func (pt pageTree) IsDescendant(other any) bool {
 	return strings.HasPrefix(pt.p.Path(), paths.AddTrailingSlash(n.Path()))
 }
 
+// CurrentSection returns the nearest branch node, skipping sections
+// of a disabled kind the same way as Parent does.
 func (pt pageTree) CurrentSection() page.Page {
 	if kinds.IsBranch(pt.p.Kind()) {
 		return pt.p
 	}
+	return pt.longestBranchPrefix(pt.p.m.pathInfo.Dir(), true)
+}
 
-	dir := pt.p.m.pathInfo.Dir()
+// currentSection is CurrentSection without the disabled kinds check.
+// It's used to build the target paths, which must not change when
+// a kind gets disabled.
+func (pt pageTree) currentSection() page.Page {
+	if kinds.IsBranch(pt.p.Kind()) {
+		return pt.p
+	}
+	return pt.longestBranchPrefix(pt.p.m.pathInfo.Dir(), false)
+}
+
+func (pt pageTree) longestBranchPrefix(dir string, enabledOnly bool) page.Page {
 	if dir == "/" {
 		return pt.p.s.home
 	}
 
-	_, n := pt.p.s.pageMap.treePages.LongestPrefix(dir, true, func(n contentNodeI) bool { return n.isContentNodeBranch() })
+	_, n := pt.p.s.pageMap.treePages.LongestPrefix(dir, true, func(n contentNodeI) bool {
+		return n.isContentNodeBranch() && (!enabledOnly || pt.isKindEnabled(n))
+	})
 	if n != nil {
 		return n.(page.Page)
 	}
 
+	if enabledOnly {
+		// The home page may be disabled, but we need a section.
+		return pt.p.s.home
+	}
+
 	panic(fmt.Sprintf("CurrentSection not found for %q in lang %s", pt.p.Path(), pt.p.Lang()))
 }
 
+func (pt pageTree) isKindEnabled(n any) bool {
+	p, ok := n.(page.Page)
+	return !ok || pt.p.s.conf.IsKindEnabled(p.Kind())
+}
+
 func (pt pageTree) FirstSection() page.Page {
 	s := pt.p.m.pathInfo.Dir()
 	if s == "/" {
@@ -81,7 +107,7 @@ func (pt pageTree) FirstSection() page.Page {
 	}
 
 	for {
-		k, n := pt.p.s.pageMap.treePages.LongestPrefix(s, true, func(n contentNodeI) bool { return n.isContentNodeBranch() })
+		k, n := pt.p.s.pageMap.treePages.LongestPrefix(s, true, func(n contentNodeI) bool { return n.isContentNodeBranch() && pt.isKindEnabled(n) })
 		if n == nil {
 			return nil
 		}
@@ -114,6 +140,31 @@ func (pt pageTree) InSection(other any) bool {
 }
 
 func (pt pageTree) Parent() page.Page {
+	parent := pt.parent()
+	// Pages of a disabled kind are not rendered, so skip them
+	// to avoid linking to e.g. a disabled section or taxonomy.
+	for parent != nil && !pt.isKindEnabled(parent) {
+		parent = parent.Parent()
+	}
+	return parent
+}
+
+// isParentOf reports whether pt.p is the parent of p, skipping any disabled
+// kinds in between. Unlike p.Parent() == pt.p, this also holds when pt.p itself
+// is disabled, e.g. the sections of a disabled home page.
+func (pt pageTree) isParentOf(p *pageState) bool {
+	parent := pageTree{p: p}.parent()
+	for parent != nil && parent != pt.p && !pt.isKindEnabled(parent) {
+		ps, ok := parent.(*pageState)
+		if !ok {
+			return false
+		}
+		parent = pageTree{p: ps}.parent()
+	}
+	return parent == pt.p
+}
+
+func (pt pageTree) parent() page.Page {
 	if pt.p.IsHome() {
 		return nil
 	}
@@ -141,6 +192,22 @@ func (pt pageTree) Ancestors() page.Pages {
 	return ancestors
 }
 
+func (pt pageTree) Descendants() page.Pages {
+	if !kinds.IsBranch(pt.p.Kind()) {
+		return nil
+	}
+	return pt.p.s.pageMap.getPagesInSection(
+		pageMapQueryPagesInSection{
+			pageMapQueryPagesBelowPath: pageMapQueryPagesBelowPath{
+				Path:    pt.p.Path(),
+				KeyPart: "descendants",
+				Include: pagePredicates.ShouldListLocal,
+			},
+			Recursive: true,
+		},
+	)
+}
+
 func (pt pageTree) Sections() page.Pages {
 	var (
 		pages               page.Pages
@@ -159,7 +226,11 @@ func (pt pageTree) Sections() page.Pages {
 			return false, nil
 		}
 		if currentBranchPrefix == "" || !strings.HasPrefix(ss, currentBranchPrefix) {
-			if p, ok := n.(*pageState); ok && p.IsSection() && p.m.shouldList(false) && p.Parent() == pt.p {
+			if !pt.isKindEnabled(n) {
+				// Look for sections below, as Parent skips this one.
+				return false, nil
+			}
+			if p, ok := n.(*pageState); ok && p.IsSection() && p.m.shouldList(false) && pt.isParentOf(p) {
 				pages = append(pages, p)
 			} else {
 				w.SkipPrefix(ss + "/")
@@ -194,5 +265,5 @@ func (p pageTree) SectionsEntries() []string {
 }
 
 func (p pageTree) SectionsPath() string {
-	return p.CurrentSection().Path()
+	return p.currentSection().Path()
 }
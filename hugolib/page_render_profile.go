@@ -0,0 +1,150 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gohugoio/hugo/hugofs"
+	"github.com/spf13/afero"
+)
+
+// pageRenderProfile collects the render durations of pages, see the
+// profilePages config option. It is safe for concurrent use.
+type pageRenderProfile struct {
+	mu    sync.Mutex
+	pages map[pageRenderProfileKey]*pageRenderProfileEntry
+}
+
+type pageRenderProfileKey struct {
+	path string
+	lang string
+}
+
+// pageRenderProfileEntry holds the render durations of a page.
+type pageRenderProfileEntry struct {
+	Path string `json:"path"`
+	Lang string `json:"lang"`
+
+	// The total render duration in milliseconds for all output formats.
+	DurationMs float64 `json:"durationMs"`
+
+	// The render duration in milliseconds per output format.
+	OutputFormats map[string]float64 `json:"outputFormats"`
+
+	duration      time.Duration
+	outputFormats map[string]time.Duration
+}
+
+func newPageRenderProfile() *pageRenderProfile {
+	return &pageRenderProfile{
+		pages: make(map[pageRenderProfileKey]*pageRenderProfileEntry),
+	}
+}
+
+// add records that rendering the page p in the current output format took d.
+func (r *pageRenderProfile) add(p *pageState, d time.Duration) {
+	key := pageRenderProfileKey{path: p.Path(), lang: p.Lang()}
+	f := p.pageOutput.f.Name
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, found := r.pages[key]
+	if !found {
+		e = &pageRenderProfileEntry{
+			Path:          key.path,
+			Lang:          key.lang,
+			outputFormats: make(map[string]time.Duration),
+		}
+		r.pages[key] = e
+	}
+	e.duration += d
+	e.outputFormats[f] += d
+}
+
+// entries returns the collected entries, slowest first.
+func (r *pageRenderProfile) entries() []*pageRenderProfileEntry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	toMs := func(d time.Duration) float64 {
+		return float64(d) / float64(time.Millisecond)
+	}
+
+	entries := make([]*pageRenderProfileEntry, 0, len(r.pages))
+	for _, e := range r.pages {
+		e.DurationMs = toMs(e.duration)
+		e.OutputFormats = make(map[string]float64, len(e.outputFormats))
+		for f, d := range e.outputFormats {
+			e.OutputFormats[f] = toMs(d)
+		}
+		entries = append(entries, e)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		ei, ej := entries[i], entries[j]
+		if ei.duration != ej.duration {
+			return ei.duration > ej.duration
+		}
+		if ei.Path != ej.Path {
+			return ei.Path < ej.Path
+		}
+		return ei.Lang < ej.Lang
+	})
+
+	return entries
+}
+
+// writePageRenderProfile writes the collected page render durations to the
+// file set in profilePages, if any.
+func (h *HugoSites) writePageRenderProfile() error {
+	if h.pageRenderProfile == nil {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(h.pageRenderProfile.entries()); err != nil {
+		return err
+	}
+
+	return h.writeReportFile(h.Configs.Base.ProfilePages, buf.Bytes())
+}
+
+// writeReportFile writes b to filename, resolved relative to the working
+// directory if not absolute.
+func (h *HugoSites) writeReportFile(filename string, b []byte) error {
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(h.Configs.LoadingInfo.BaseConfig.WorkingDir, filename)
+	}
+
+	var fs afero.Fs = hugofs.Os
+	if !hugofs.IsOsFs(h.Fs.Source) {
+		fs = h.Fs.WorkingDirWritable
+	}
+
+	if err := fs.MkdirAll(filepath.Dir(filename), 0o777); err != nil {
+		return err
+	}
+
+	return afero.WriteFile(fs, filename, b, 0o666)
+}
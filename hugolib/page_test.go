@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/bep/clocks"
+	"github.com/gohugoio/hugo/helpers"
 	"github.com/gohugoio/hugo/identity"
 	"github.com/gohugoio/hugo/markup/asciidocext"
 	"github.com/gohugoio/hugo/markup/rst"
@@ -1350,6 +1351,60 @@ AllTranslations: {{ range .AllTranslations }}{{ .Language.Lang }}|{{ end }}|
 	)
 }
 
+func TestTranslationKeyFromFrontMatterField(t *testing.T) {
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term"]
+defaultContentLanguage = "en"
+defaultContentLanguageInSubdir = true
+[frontmatter]
+translationKey = [":default", "ref"]
+[languages]
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+-- content/sect/about.en.md --
+---
+title: "about en"
+ref: "about"
+---
+-- content/sect/om.nn.md --
+---
+title: "om nn"
+ref: "about"
+---
+-- content/sect/p2.en.md --
+---
+title: "p2 en"
+ref: "p2"
+translationKey: "explicit"
+---
+-- content/sect/p2.nn.md --
+---
+title: "p2 nn"
+translationKey: "explicit"
+---
+-- layouts/_default/single.html --
+Title: {{ .Title }}|TranslationKey: {{ .TranslationKey }}|
+Translations: {{ range .Translations }}{{ .Title }}|{{ end }}|
+
+`
+	b := Test(t, files)
+	b.AssertFileContent("public/en/sect/about/index.html",
+		"TranslationKey: about|",
+		"Translations: om nn||",
+	)
+	b.AssertFileContent("public/nn/sect/om/index.html",
+		"TranslationKey: about|",
+		"Translations: about en||",
+	)
+	b.AssertFileContent("public/en/sect/p2/index.html",
+		"TranslationKey: explicit|",
+		"Translations: p2 nn||",
+	)
+}
+
 // Issue #11540.
 func TestTranslationKeyResourceSharing(t *testing.T) {
 	files := `
@@ -2019,3 +2074,274 @@ func TestRenderWithoutArgument(t *testing.T) {
 
 	b.Assert(err, qt.IsNotNil)
 }
+
+func TestPagePlainText(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section"]
+-- content/p1.md --
+---
+title: "p1"
+---
+First *paragraph*,
+still the first.
+
+{{< sc >}}
+
+Line 1\
+Line 2
+
+{{% sc %}}
+
+- Item 1
+- Item 2
+-- layouts/shortcodes/sc.html --
+<div>Shortcode</div>
+-- layouts/_default/single.html --
+Plain: {{ .Plain }}|
+PlainText: {{ .PlainText }}|
+PlainTextNoShortcodes: {{ .PlainText (dict "shortcodes" false) }}|
+Content: {{ .Content }}
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html",
+		"PlainText: First paragraph, still the first.\n\nShortcode\n\nLine 1\nLine 2\n\nShortcode\n\nItem 1\n\nItem 2|",
+		"PlainTextNoShortcodes: First paragraph, still the first.\n\nLine 1\nLine 2\n\nShortcode\n\nItem 1\n\nItem 2|",
+		"<p>First <em>paragraph</em>",
+		"<div>Shortcode</div>",
+	)
+}
+
+func TestBuildRenderLink(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+-- content/s/_index.md --
+---
+title: S
+---
+-- content/s/p1.md --
+---
+title: P1
+_build:
+  render: link
+---
+P1 summary. <!--more--> P1 more.
+-- content/s/p2/index.md --
+---
+title: P2
+_build:
+  render: link
+  publishResources: false
+---
+P2 content.
+-- content/s/p2/data.txt --
+Data.
+-- layouts/_default/single.html --
+Single: {{ .Title }}|{{ .Content }}
+-- layouts/_default/list.html --
+{{ range .Pages }}{{ .Title }}|{{ .RelPermalink }}|Summary: {{ .Summary }}|Content: {{ .Content }}|{{ end }}
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/s/index.html",
+		"P1|/s/p1/|Summary: <p>P1 summary.</p>|Content: <p>P1 summary.</p>\n<p>P1 more.</p>",
+		"P2|/s/p2/|Summary: P2 content.|Content: <p>P2 content.</p>",
+	)
+	b.AssertFileExists("public/s/p1/index.html", false)
+	b.AssertFileExists("public/s/p2/index.html", false)
+	b.AssertFileExists("public/s/p2/data.txt", false)
+}
+
+func TestRawFrontMatter(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap"]
+-- content/toml.md --
++++
+title = "TOML"
+weight = 32
+draft = false
+[params]
+zeta = "z"
+alpha = "a"
++++
+-- content/yaml.md --
+---
+Title: YAML
+weight: 33
+tags: [b, a]
+date: 2024-02-01
+---
+-- content/json.md --
+{
+  "title": "JSON",
+  "weight": 34,
+  "author": {"name": "Jo", "age": 42}
+}
+-- content/nofm.md --
+No front matter.
+-- layouts/_default/single.html --
+{{ range .RawFrontMatter }}{{ .Key }}: {{ .Value }} ({{ printf "%T" .Value }})|{{ end }}
+{{ transform.Remarshal "yaml" .RawFrontMatter }}
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/toml/index.html",
+		"title: TOML (string)|weight: 32 (int64)|draft: false (bool)|params: [{zeta z} {alpha a}] (metadecoders.OrderedMap)|",
+		"title: TOML\nweight: 32\ndraft: false\nparams:\n  zeta: z\n  alpha: a\n",
+	)
+	b.AssertFileContent("public/yaml/index.html",
+		"Title: YAML (string)|weight: 33 (int)|tags: [b a] ([]interface {})|date: 2024-02-01 (string)|",
+	)
+	b.AssertFileContent("public/json/index.html",
+		"title: JSON (string)|weight: 34 (float64)|author: [{name Jo} {age 42}] (metadecoders.OrderedMap)|",
+	)
+	b.AssertFileContent("public/nofm/index.html", "! |")
+}
+
+func TestReadingTimeConfig(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "home"]
+defaultContentLanguage = "en"
+defaultContentLanguageInSubdir = true
+[readingTime]
+wordsPerMinute = 100
+[languages]
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+[languages.nn.readingTime]
+wordsPerMinute = 200
+charactersPerMinute = 300
+-- content/p1.en.md --
+---
+title: p1
+---
+WORDS
+-- content/p1.nn.md --
+---
+title: p1
+---
+WORDS
+-- content/p2.md --
+---
+title: p2
+---
+WORDS 日本語の文章です
+-- layouts/_default/single.html --
+ReadingTime: {{ .ReadingTime }}|Seconds: {{ .ReadingTimeSeconds }}|
+`
+
+	files = strings.ReplaceAll(files, "WORDS", strings.Repeat("word ", 150))
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/en/p1/index.html", "ReadingTime: 2|Seconds: 90|")
+	b.AssertFileContent("public/nn/p1/index.html", "ReadingTime: 1|Seconds: 45|")
+	// 150 words at 100 WPM and 8 characters at the default 500 CPM.
+	b.AssertFileContent("public/en/p2/index.html", "ReadingTime: 2|Seconds: 91|")
+}
+
+func TestPageFileContentHash(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap"]
+-- content/p1.md --
+---
+title: p1
+---
+Content.
+-- content/bundle/index.md --
+---
+title: bundle
+---
+-- content/bundle/data.txt --
+data
+-- content/bundle/other.md --
+---
+title: other
+---
+-- layouts/_default/single.html --
+{{ with .File }}ContentHash: {{ .ContentHash }}|{{ end }}
+-- layouts/_default/list.html --
+List|{{ with .File }}ContentHash: {{ .ContentHash }}|{{ end }}
+`
+
+	// Source files are stored without trailing newlines.
+	b := Test(t, files, TestOptRunning())
+
+	b.AssertFileContent("public/p1/index.html", "ContentHash: "+helpers.MD5String("---\ntitle: p1\n---\nContent.")+"|")
+	// The home page has no source file.
+	b.AssertFileContent("public/index.html", "List|", "! ContentHash")
+
+	bundleHash := helpers.MD5String(helpers.MD5String("---\ntitle: bundle\n---") + "\n" + strings.Join([]string{
+		"data.txt:" + helpers.MD5String("data"),
+		"other.md:" + helpers.MD5String("---\ntitle: other\n---"),
+	}, "\n"))
+	b.AssertFileContent("public/bundle/index.html", "ContentHash: "+bundleHash+"|")
+
+	b.EditFileReplaceAll("content/p1.md", "Content.", "Edited.").Build()
+	b.AssertFileContent("public/p1/index.html", "ContentHash: "+helpers.MD5String("---\ntitle: p1\n---\nEdited.")+"|")
+
+	b.EditFileReplaceAll("content/bundle/data.txt", "data", "edited").Build()
+	b.AssertFileContent("public/bundle/index.html", "! "+bundleHash)
+}
+
+func TestPageBundleTypeAndIsBundleHeader(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap"]
+-- content/p1.md --
+---
+title: p1
+---
+-- content/leaf/index.md --
+---
+title: leaf
+---
+-- content/leaf/other.md --
+---
+title: other
+---
+-- content/branch/_index.md --
+---
+title: branch
+---
+-- layouts/_default/single.html --
+{{ partial "info.html" . }}
+{{ range .Resources.ByType "page" }}Resource: {{ partial "info.html" . }}{{ end }}
+-- layouts/_default/list.html --
+{{ partial "info.html" . }}
+-- layouts/partials/info.html --
+{{ .Title }}|BundleType: {{ .BundleType }}|{{ with .File }}IsBundleHeader: {{ .IsBundleHeader }}|{{ end }}
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html", "p1|BundleType: |IsBundleHeader: false|")
+	b.AssertFileContent("public/leaf/index.html",
+		"leaf|BundleType: leaf|IsBundleHeader: true|",
+		"Resource: other|BundleType: |IsBundleHeader: false|",
+	)
+	b.AssertFileContent("public/branch/index.html", "branch|BundleType: branch|IsBundleHeader: true|")
+	b.AssertFileContent("public/index.html", "|BundleType: branch|")
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/gohugoio/hugo/hugofs"
 	"github.com/gohugoio/hugo/hugofs/files"
+	"github.com/gohugoio/hugo/hugofs/glob"
 
 	"github.com/gohugoio/hugo/common/paths"
 
@@ -66,6 +67,44 @@ func (c *pageFinder) getPage(context page.Page, ref string) (page.Page, error) {
 	return nil, nil
 }
 
+// getPages returns all pages with a logical path matching the given Glob pattern,
+// e.g. "/blog/**". If context is set, a pattern not starting with a slash is
+// resolved relative to it, as in getPage.
+func (c *pageFinder) getPages(context page.Page, pattern string) (page.Pages, error) {
+	pattern = paths.ToSlashTrimTrailing(pattern)
+	if !strings.HasPrefix(pattern, "/") {
+		var baseDir string
+		if context != nil {
+			if pi := context.PathInfo(); pi != nil {
+				if pi.IsBranchBundle() {
+					baseDir = pi.Dir()
+				} else {
+					baseDir = pi.ContainerDir()
+				}
+			}
+		}
+		pattern = path.Join("/", baseDir, pattern)
+	}
+
+	g, err := glob.GetGlob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page pattern %q: %w", pattern, err)
+	}
+
+	// Walk the smallest part of the tree possible.
+	prefix := pattern
+	if i := strings.IndexAny(prefix, "*?[{\\"); i != -1 {
+		prefix = prefix[:i]
+	}
+	prefix = prefix[:strings.LastIndex(prefix, "/")+1]
+	if prefix == "/" || strings.ToLower(prefix) != prefix {
+		// The match is case insensitive, the tree walk is not.
+		prefix = ""
+	}
+
+	return c.pageMap.getPagesMatching(pattern, prefix, g), nil
+}
+
 // Only used in tests.
 func (c *pageFinder) getPageOldVersion(kind string, sections ...string) page.Page {
 	refs := append([]string{kind}, path.Join(sections...))
@@ -636,3 +636,95 @@ RegularPagesRecursive: {{ range .RegularPagesRecursive }}{{ .Kind }}:{{ .RelPerm
 
 	b.AssertFileContent("public/index.html", `RegularPagesRecursive: page:/p1/|page:/post/p2/||End.`)
 }
+
+func TestGetPages(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+defaultContentLanguage = "en"
+defaultContentLanguageInSubdir = true
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+-- content/docs/_index.md --
+---
+title: docs
+---
+-- content/docs/p1.md --
+---
+title: docs-p1
+weight: 2
+---
+-- content/docs/p2.md --
+---
+title: docs-p2
+weight: 1
+---
+-- content/docs/guides/_index.md --
+---
+title: guides
+---
+-- content/docs/guides/g1/index.md --
+---
+title: guides-g1
+---
+-- content/docs/guides/g1/index.nn.md --
+---
+title: guides-g1-nn
+---
+-- content/news/n1.md --
+---
+title: news-n1
+---
+-- layouts/index.html --
+{{ define "print" }}{{ range . }}{{ .Title }}|{{ end }}{{ end }}
+Docs children: {{ template "print" site.GetPages "/docs/*" }}
+Docs all: {{ template "print" site.GetPages "/docs/**" }}
+Relative to home: {{ template "print" site.GetPages "docs/guides/*" }}
+Alternates: {{ template "print" site.GetPages "/{docs/p1,news/*}" }}
+Case: {{ template "print" site.GetPages "/DOCS/P?" }}
+Exact: {{ template "print" site.GetPages "/docs" }}
+Home: {{ template "print" site.GetPages "/" }}
+None: {{ template "print" site.GetPages "/nonexisting/**" }}|{{ len (site.GetPages "/nonexisting/**") }}
+-- layouts/_default/single.html --
+{{ define "print" }}{{ range . }}{{ .Title }}|{{ end }}{{ end }}
+Siblings: {{ template "print" .GetPages "*" }}
+-- layouts/_default/list.html --
+{{ define "print" }}{{ range . }}{{ .Title }}|{{ end }}{{ end }}
+Children: {{ template "print" .GetPages "*" }}
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/en/index.html",
+		"Docs children: docs-p2|docs-p1|guides|",
+		"Docs all: docs-p2|docs-p1|guides|guides-g1|",
+		"Relative to home: guides-g1|",
+		"Alternates: docs-p1|news-n1|",
+		"Case: docs-p2|docs-p1|",
+		"Exact: docs|",
+		"Home: |",
+		"None: |0",
+	)
+	b.AssertFileContent("public/nn/index.html", "Docs all: guides-g1-nn|")
+	b.AssertFileContent("public/en/docs/p1/index.html", "Siblings: docs-p2|docs-p1|guides|")
+	b.AssertFileContent("public/en/docs/index.html", "Children: docs-p2|docs-p1|guides|")
+	b.AssertFileContent("public/en/docs/guides/g1/index.html", "Siblings: guides-g1|")
+}
+
+func TestGetPagesInvalidPattern(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+-- layouts/index.html --
+{{ site.GetPages "/docs/[" }}
+`
+
+	b, err := TestE(t, files)
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `invalid page pattern "/docs/["`)
+}
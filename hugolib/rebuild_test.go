@@ -949,6 +949,34 @@ Content: {{ .Content }}|
 	b.AssertRenderCountContent(1)
 }
 
+func TestRebuildEditFileInWatchDirs(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableLiveReload = true
+disableKinds = ["taxonomy", "term", "rss", "sitemap"]
+[server]
+watchDirs = ["external"]
+-- external/data/mydata.json --
+{"foo": "bar"}
+-- content/p1.md --
+---
+title: "P1"
+---
+-- layouts/index.html --
+Home: {{ (os.ReadFile "external/data/mydata.json" | transform.Unmarshal).foo }}|
+-- layouts/_default/single.html --
+Single: {{ .Title }}|
+`
+	b := TestRunning(t, files)
+
+	b.AssertFileContent("public/index.html", "Home: bar|")
+	b.EditFileReplaceAll("external/data/mydata.json", "bar", "baz").Build()
+	b.AssertFileContent("public/index.html", "Home: baz|")
+	b.AssertRenderCountPage(2)
+}
+
 func TestRebuildEditData(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,133 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/hugofs/files"
+	"github.com/spf13/afero"
+)
+
+// redirectMap collects the page aliases and the canonical URL of the page
+// they redirect to, see the build.redirectMap config option.
+// It is safe for concurrent use.
+type redirectMap struct {
+	mu sync.Mutex
+	// Publish root (the language for multihost sites) => alias path => URL.
+	roots map[string]map[string]string
+}
+
+func newRedirectMap() *redirectMap {
+	return &redirectMap{
+		roots: make(map[string]map[string]string),
+	}
+}
+
+// add records that the alias path from in the publish root redirects to the URL to.
+func (r *redirectMap) add(root, from, to string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m, found := r.roots[root]
+	if !found {
+		m = make(map[string]string)
+		r.roots[root] = m
+	}
+	m[from] = to
+}
+
+// aliasURLPath returns the escaped URL path, including any path in baseURL,
+// of the alias published to the target path created from alias.
+func (s *Site) aliasURLPath(alias string) string {
+	alias = strings.TrimPrefix(path.Clean("/"+alias), "/")
+	if alias != "" && !strings.HasSuffix(alias, ".html") {
+		alias += "/"
+	}
+	u := &url.URL{Path: s.PathSpec.Cfg.BaseURL().BasePathNoTrailingSlash + "/" + alias}
+	return u.EscapedPath()
+}
+
+// writeRedirectMap writes the collected aliases to the root of the publish
+// directory in the format set in build.redirectMap, if any.
+func (h *HugoSites) writeRedirectMap() error {
+	format := h.Configs.Base.Build.RedirectMap
+	if format == "" || h.redirectMap == nil {
+		return nil
+	}
+
+	h.redirectMap.mu.Lock()
+	defer h.redirectMap.mu.Unlock()
+
+	publishFs := h.BaseFs.PublishFs
+
+	for root, m := range h.redirectMap.roots {
+		var (
+			buf      bytes.Buffer
+			filename string
+		)
+
+		switch format {
+		case config.RedirectMapJSON:
+			filename = files.FilenameRedirectsJSON
+			enc := json.NewEncoder(&buf)
+			enc.SetEscapeHTML(false)
+			enc.SetIndent("", "  ")
+			// The map keys are sorted.
+			if err := enc.Encode(m); err != nil {
+				return err
+			}
+		default:
+			filename = files.FilenameRedirects
+			froms := make([]string, 0, len(m))
+			for from := range m {
+				froms = append(froms, from)
+			}
+			sort.Strings(froms)
+			for _, from := range froms {
+				fmt.Fprintf(&buf, "%s %s 301\n", from, m[from])
+			}
+		}
+
+		// The static files are copied to the publish directory separately,
+		// so fail rather than race with a file we would overwrite.
+		if _, err := h.BaseFs.SourceFilesystems.StaticFs(root).Stat(filename); err == nil {
+			return fmt.Errorf("build.redirectMap: %q exists in the static directory; remove it or disable build.redirectMap", filename)
+		}
+
+		filename = filepath.Join(root, filename)
+
+		if existingContent, err := afero.ReadFile(publishFs, filename); err == nil {
+			// Check if the content has changed.
+			if bytes.Equal(existingContent, buf.Bytes()) {
+				continue
+			}
+		}
+
+		if err := afero.WriteFile(publishFs, filename, buf.Bytes(), 0o666); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -14,10 +14,12 @@
 package hugolib
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/bep/logg"
 	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/common/herrors"
 	"github.com/gohugoio/hugo/common/loggers"
 )
 
@@ -212,3 +214,82 @@ title: "P1"
 
 	b.AssertFileContent("public/p1/index.html", `TableOfContents`)
 }
+
+func TestRenderStringShortcodesOption(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section"]
+-- layouts/shortcodes/myshort.html --
+myshort
+-- layouts/index.html --
+{{ $opts := dict "shortcodes" false }}
+Enabled: {{ .RenderString "A {{< myshort >}} B" }}|
+Disabled: {{ .RenderString $opts "A {{< myshort >}} B" }}|
+Has myshort: {{ .HasShortcode "myshort" }}|
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		"Enabled: A myshort\n B|",
+		"Disabled: A {{&lt; myshort &gt;}} B|",
+		"Has myshort: true|",
+	)
+}
+
+func TestRenderStringShortcodeErrorPosition(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section"]
+-- content/p1.md --
+---
+title: "P1"
+---
+Content.
+-- layouts/shortcodes/broken.html --
+{{ div 1 0 }}
+-- layouts/_default/single.html --
+{{ .RenderString "Line 1.\n\nLine 3: {{< broken >}}" }}
+`
+
+	b, err := TestE(t, files)
+	b.Assert(err, qt.IsNotNil)
+
+	b.Assert(err.Error(), qt.Contains, `"/content/p1.md:3:9": failed to render shortcode "broken"`)
+
+	var fe herrors.FileError
+	for _, ferr := range herrors.UnwrapFileErrors(err) {
+		if ferr.Position().Filename == filepath.FromSlash("/content/p1.md") {
+			fe = ferr
+		}
+	}
+	b.Assert(fe, qt.IsNotNil)
+	b.Assert(fe.Position().LineNumber, qt.Equals, 3)
+	b.Assert(fe.Position().ColumnNumber, qt.Equals, 9)
+	b.Assert(fe.ErrorContext().Lines, qt.DeepEquals, []string{"Line 1.", "", "Line 3: {{< broken >}}"})
+}
+
+func TestRenderStringInlineShortcodeScope(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section"]
+enableInlineShortcodes = true
+-- content/p1.md --
+---
+title: "P1"
+---
+{{< foo.inline >}}Page{{< /foo.inline >}}
+-- layouts/_default/single.html --
+{{ .Content }}|{{ .RenderString "{{< foo.inline />}}" }}
+`
+
+	b, err := TestE(t, files)
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `no earlier definition of shortcode "foo.inline" found`)
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/gohugoio/hugo/output"
 
 	bp "github.com/gohugoio/hugo/bufferpool"
+	"github.com/gohugoio/hugo/helpers"
 	"github.com/gohugoio/hugo/tpl"
 )
 
@@ -64,15 +65,19 @@ type ShortcodeWithPage struct {
 	// Indentation before the opening shortcode in the source.
 	indentation string
 
+	// The unrendered source between the opening and closing tags.
+	innerRaw string
+
 	innerDeindentInit sync.Once
 	innerDeindent     template.HTML
 
 	// pos is the position in bytes in the source file. Used for error logging.
 	posInit   sync.Once
 	posOffset int
+	posSource []byte // Set when not the page's source, e.g. in RenderString.
 	pos       text.Position
 
-	scratch *maps.Scratch
+	store *maps.Scratch
 }
 
 // InnerDeindent returns the (potentially de-indented) inner content of the shortcode.
@@ -96,12 +101,30 @@ func (scp *ShortcodeWithPage) InnerDeindent() template.HTML {
 	return scp.innerDeindent
 }
 
+// InnerRaw returns the source between the opening and closing shortcode tags
+// as is, with any nested shortcodes unrendered and the indentation preserved.
+func (scp *ShortcodeWithPage) InnerRaw() string {
+	return scp.innerRaw
+}
+
+// RenderMarkup renders the given markup, typically a shortcode parameter,
+// using the page's markup configuration. The options are the same as in
+// Page.RenderString, e.g. set display to "block" to wrap paragraphs in <p> tags.
+// The content is rendered inline by default.
+func (scp *ShortcodeWithPage) RenderMarkup(ctx context.Context, args ...any) (template.HTML, error) {
+	return scp.Page.RenderString(ctx, args...)
+}
+
 // Position returns this shortcode's detailed position. Note that this information
 // may be expensive to calculate, so only use this in error situations.
 func (scp *ShortcodeWithPage) Position() text.Position {
 	scp.posInit.Do(func() {
 		if p, ok := mustUnwrapPage(scp.Page).(pageContext); ok {
-			scp.pos = p.posOffset(scp.posOffset)
+			if scp.posSource != nil {
+				scp.pos = p.posFromInput(scp.posSource, scp.posOffset)
+			} else {
+				scp.pos = p.posOffset(scp.posOffset)
+			}
 		}
 	})
 	return scp.pos
@@ -126,11 +149,20 @@ func (scp *ShortcodeWithPage) RelRef(args map[string]any) (string, error) {
 
 // Scratch returns a scratch-pad scoped for this shortcode. This can be used
 // as a temporary storage for variables, counters etc.
+// This is an alias for Store.
 func (scp *ShortcodeWithPage) Scratch() *maps.Scratch {
-	if scp.scratch == nil {
-		scp.scratch = maps.NewScratch()
+	return scp.Store()
+}
+
+// Store returns a store scoped for this shortcode, typically used by nested
+// shortcodes to register themselves with the parent via .Parent.Store.
+// The nested shortcodes are rendered in order of appearance before
+// the parent.
+func (scp *ShortcodeWithPage) Store() *maps.Scratch {
+	if scp.store == nil {
+		scp.store = maps.NewScratch()
 	}
-	return scp.scratch
+	return scp.store
 }
 
 // Get is a convenience method to look up shortcode parameters by its key.
@@ -176,6 +208,33 @@ func (scp *ShortcodeWithPage) Get(key any) any {
 	return x.Interface()
 }
 
+// Ordered returns the positional parameters in the order given, e.g. to range
+// over a variable number of parameters. It returns an error if the shortcode
+// was called with named parameters.
+func (scp *ShortcodeWithPage) Ordered() ([]any, error) {
+	switch v := scp.Params.(type) {
+	case []any:
+		return v, nil
+	case map[string]any:
+		return nil, fmt.Errorf("shortcode %q was called with named parameters, use .Named", scp.Name)
+	}
+	// No parameters.
+	return nil, nil
+}
+
+// Named returns the named parameters. It returns an error if the shortcode
+// was called with positional parameters.
+func (scp *ShortcodeWithPage) Named() (map[string]any, error) {
+	switch v := scp.Params.(type) {
+	case map[string]any:
+		return v, nil
+	case []any:
+		return nil, fmt.Errorf("shortcode %q was called with positional parameters, use .Ordered", scp.Name)
+	}
+	// No parameters.
+	return nil, nil
+}
+
 // For internal use only.
 func (scp *ShortcodeWithPage) Unwrapv() any {
 	return scp.Page
@@ -197,6 +256,7 @@ type shortcode struct {
 	ordinal   int
 
 	indentation string // indentation from source.
+	innerRaw    string // the unrendered inner source.
 
 	info   tpl.Info       // One of the output formats (arbitrary)
 	templs []tpl.Template // All output formats
@@ -216,6 +276,28 @@ type shortcode struct {
 
 	pos    int // the position in bytes in the source file
 	length int // the length in bytes in the source file
+
+	// The source when this shortcode is not part of the page's content,
+	// e.g. the string passed to RenderString. Positions are relative to it.
+	source []byte
+}
+
+// position returns the position of the shortcode in p's source or, if set,
+// in the RenderString source.
+func (s *shortcode) position(p *pageState) text.Position {
+	if s.source != nil {
+		return p.posFromInput(s.source, s.pos)
+	}
+	return p.posOffset(s.pos)
+}
+
+// addErrorContext reads the error context of fe from the RenderString
+// source, if set, and not from the page's file.
+func (s *shortcode) addErrorContext(fe herrors.FileError) herrors.FileError {
+	if s.source == nil {
+		return fe
+	}
+	return fe.UpdateContent(bytes.NewReader(s.source), nil)
 }
 
 func (s shortcode) insertPlaceholder() bool {
@@ -278,6 +360,10 @@ type shortcodeHandler struct {
 	// Ordered list of shortcodes for a page.
 	shortcodes []*shortcode
 
+	// The source of the shortcodes when not the page's content, e.g. the
+	// string passed to RenderString.
+	source []byte
+
 	// All the shortcode names in this set.
 	nameSet   map[string]bool
 	nameSetMu sync.RWMutex
@@ -312,16 +398,19 @@ func prepareShortcode(
 	sc *shortcode,
 	parent *ShortcodeWithPage,
 	p *pageState,
-	isRenderString bool,
 ) (shortcodeRenderer, error) {
 	toParseErr := func(err error) error {
+		err = fmt.Errorf("failed to render shortcode %q: %w", sc.name, err)
+		if sc.source != nil {
+			return sc.addErrorContext(herrors.NewFileErrorFromPos(err, sc.position(p)))
+		}
 		source := p.m.content.mustSource()
-		return p.parseError(fmt.Errorf("failed to render shortcode %q: %w", sc.name, err), source, sc.pos)
+		return p.parseError(err, source, sc.pos)
 	}
 
 	// Allow the caller to delay the rendering of the shortcode if needed.
 	var fn shortcodeRenderFunc = func(ctx context.Context) ([]byte, bool, error) {
-		r, err := doRenderShortcode(ctx, level, s, tplVariants, sc, parent, p, isRenderString)
+		r, err := doRenderShortcode(ctx, level, s, tplVariants, sc, parent, p)
 		if err != nil {
 			return nil, false, toParseErr(err)
 		}
@@ -335,6 +424,20 @@ func prepareShortcode(
 	return fn, nil
 }
 
+// lookupShortcodeDelegate looks up the partial the shortcode with the given
+// name delegates to, see the shortcodes.delegate config.
+func (s *Site) lookupShortcodeDelegate(name string) (tpl.Template, bool, error) {
+	partial, found := s.conf.Shortcodes.DelegatePartial(name)
+	if !found {
+		return nil, false, nil
+	}
+	templ, found := s.Tmpl().Lookup(partial)
+	if !found {
+		return nil, false, fmt.Errorf("shortcode %q delegates to partial %q, which was not found", name, partial)
+	}
+	return templ, true, nil
+}
+
 func doRenderShortcode(
 	ctx context.Context,
 	level int,
@@ -343,7 +446,6 @@ func doRenderShortcode(
 	sc *shortcode,
 	parent *ShortcodeWithPage,
 	p *pageState,
-	isRenderString bool,
 ) (shortcodeRenderer, error) {
 	var tmpl tpl.Template
 
@@ -358,19 +460,20 @@ func doRenderShortcode(
 			return zeroShortcode, nil
 		}
 		templName := path.Join("_inline_shortcode", p.Path(), sc.name)
+		if sc.source != nil {
+			// Inline shortcodes defined in a RenderString string are scoped to that string.
+			templName = path.Join("_inline_shortcode", p.Path(), "_renderstring", helpers.MD5String(string(sc.source)), sc.name)
+		}
 		if sc.isClosing {
 			templStr := sc.innerString()
 
 			var err error
 			tmpl, err = s.TextTmpl().Parse(templName, templStr)
 			if err != nil {
-				if isRenderString {
-					return zeroShortcode, p.wrapError(err)
-				}
-				fe := herrors.NewFileErrorFromName(err, p.File().Filename())
+				fe := herrors.NewFileErrorFromName(err, p.pathOrTitle())
 				pos := fe.Position()
-				pos.LineNumber += p.posOffset(sc.pos).LineNumber
-				fe = fe.UpdatePosition(pos)
+				pos.LineNumber += sc.position(p).LineNumber
+				fe = sc.addErrorContext(fe.UpdatePosition(pos))
 				return zeroShortcode, p.wrapError(fe)
 			}
 
@@ -386,6 +489,13 @@ func doRenderShortcode(
 	} else {
 		var found, more bool
 		tmpl, found, more = s.Tmpl().LookupVariant(sc.name, tplVariants)
+		if !found {
+			var err error
+			tmpl, found, err = s.lookupShortcodeDelegate(sc.name)
+			if err != nil {
+				return zeroShortcode, err
+			}
+		}
 		if !found {
 			s.Log.Errorf("Unable to locate template for shortcode %q in page %q", sc.name, p.File().Path())
 			return zeroShortcode, nil
@@ -393,7 +503,7 @@ func doRenderShortcode(
 		hasVariants = hasVariants || more
 	}
 
-	data := &ShortcodeWithPage{Ordinal: sc.ordinal, posOffset: sc.pos, indentation: sc.indentation, Params: sc.params, Page: newPageForShortcode(p), Parent: parent, Name: sc.name}
+	data := &ShortcodeWithPage{Ordinal: sc.ordinal, posOffset: sc.pos, posSource: sc.source, indentation: sc.indentation, innerRaw: sc.innerRaw, Params: sc.params, Page: newPageForShortcode(p), Parent: parent, Name: sc.name}
 	if sc.params != nil {
 		data.IsNamedParams = reflect.TypeOf(sc.params).Kind() == reflect.Map
 	}
@@ -405,7 +515,7 @@ func doRenderShortcode(
 			case string:
 				inner += innerData
 			case *shortcode:
-				s, err := prepareShortcode(ctx, level+1, s, tplVariants, innerData, data, p, isRenderString)
+				s, err := prepareShortcode(ctx, level+1, s, tplVariants, innerData, data, p)
 				if err != nil {
 					return zeroShortcode, err
 				}
@@ -465,10 +575,10 @@ func doRenderShortcode(
 	result, err := renderShortcodeWithPage(ctx, s.Tmpl(), tmpl, data)
 
 	if err != nil && sc.isInline {
-		fe := herrors.NewFileErrorFromName(err, p.File().Filename())
+		fe := herrors.NewFileErrorFromName(err, p.pathOrTitle())
 		pos := fe.Position()
-		pos.LineNumber += p.posOffset(sc.pos).LineNumber
-		fe = fe.UpdatePosition(pos)
+		pos.LineNumber += sc.position(p).LineNumber
+		fe = sc.addErrorContext(fe.UpdatePosition(pos))
 		return zeroShortcode, fe
 	}
 
@@ -512,7 +622,7 @@ func (s *shortcodeHandler) hasName(name string) bool {
 	return ok
 }
 
-func (s *shortcodeHandler) prepareShortcodesForPage(ctx context.Context, p *pageState, f output.Format, isRenderString bool) (map[string]shortcodeRenderer, error) {
+func (s *shortcodeHandler) prepareShortcodesForPage(ctx context.Context, p *pageState, f output.Format) (map[string]shortcodeRenderer, error) {
 	rendered := make(map[string]shortcodeRenderer)
 
 	tplVariants := tpl.TemplateVariants{
@@ -521,7 +631,7 @@ func (s *shortcodeHandler) prepareShortcodesForPage(ctx context.Context, p *page
 	}
 
 	for _, v := range s.shortcodes {
-		s, err := prepareShortcode(ctx, 0, s.s, tplVariants, v, nil, p, isRenderString)
+		s, err := prepareShortcode(ctx, 0, s.s, tplVariants, v, nil, p)
 		if err != nil {
 			return nil, err
 		}
@@ -558,7 +668,7 @@ func (s *shortcodeHandler) extractShortcode(ordinal, level int, source []byte, p
 	if s == nil {
 		panic("handler nil")
 	}
-	sc := &shortcode{ordinal: ordinal}
+	sc := &shortcode{ordinal: ordinal, source: s.source}
 
 	// Back up one to identify any indentation.
 	if pt.Pos() > 0 {
@@ -573,6 +683,8 @@ func (s *shortcodeHandler) extractShortcode(ordinal, level int, source []byte, p
 	nestedOrdinal := 0
 	nextLevel := level + 1
 	closed := false
+	// The start and end of the inner source.
+	innerStart, innerEnd := -1, -1
 	const errorPrefix = "failed to extract shortcode"
 
 Loop:
@@ -586,6 +698,7 @@ Loop:
 				return sc, errors.New("shortcode has no name")
 			}
 			if next.IsShortcodeClose() {
+				innerEnd = currItem.Pos()
 				continue
 			}
 
@@ -611,6 +724,9 @@ Loop:
 			cnt++
 
 		case currItem.IsRightShortcodeDelim():
+			if innerStart == -1 {
+				innerStart = currItem.Pos() + len(currItem.Val(source))
+			}
 			// we trust the template on this:
 			// if there's no inner, we're done
 			if !sc.isInline {
@@ -637,6 +753,9 @@ Loop:
 			} else {
 				sc.isClosing = true
 				pt.Consume(2)
+				if innerStart != -1 && innerEnd >= innerStart {
+					sc.innerRaw = string(source[innerStart:innerEnd])
+				}
 			}
 
 			return sc, nil
@@ -649,7 +768,14 @@ Loop:
 			// Used to check if the template expects inner content.
 			templs := s.s.Tmpl().LookupVariants(sc.name)
 			if templs == nil {
-				return nil, fmt.Errorf("%s: template for shortcode %q not found", errorPrefix, sc.name)
+				templ, found, err := s.s.lookupShortcodeDelegate(sc.name)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", errorPrefix, err)
+				}
+				if !found {
+					return nil, fmt.Errorf("%s: template for shortcode %q not found", errorPrefix, sc.name)
+				}
+				templs = []tpl.Template{templ}
 			}
 
 			sc.info = templs[0].(tpl.Info)
@@ -95,6 +95,14 @@ func (p *pageForShortcode) String() string {
 	return p.p.String()
 }
 
+// OutputFormat returns the output format currently being rendered.
+// The shortcode output may now differ between the output formats,
+// so we cannot reuse the rendered content for the other formats.
+func (p *pageForShortcode) OutputFormat() page.OutputFormat {
+	p.p.pageOutputTemplateVariationsState.Add(1)
+	return p.p.OutputFormat()
+}
+
 func (p *pageForShortcode) TableOfContents(context.Context) template.HTML {
 	return p.toc
 }
@@ -118,6 +126,13 @@ func newPageForRenderHook(p *pageState) page.Page {
 	}
 }
 
+// OutputFormat returns the output format currently being rendered.
+// See pageForShortcode.OutputFormat.
+func (p *pageForRenderHooks) OutputFormat() page.OutputFormat {
+	p.p.pageOutputTemplateVariationsState.Add(1)
+	return p.p.OutputFormat()
+}
+
 func (p *pageForRenderHooks) Unwrapv() any {
 	return p.p
 }
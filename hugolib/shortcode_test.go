@@ -1255,3 +1255,270 @@ Hello.
 
 	b.AssertFileContent("public/p1/index.html", "<span style=\"color:#a6e22e\">Hello.</span>")
 }
+
+func TestShortcodeInnerRaw(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["home", "taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+-- content/p1.md --
+---
+title: "p1"
+---
+
+- Item
+
+  {{< raw >}}
+  Some *markdown* with {{< nested >}}.
+
+      code
+  {{< /raw >}}
+
+{{< raw >}}{{< /raw >}}
+-- layouts/shortcodes/raw.html --
+<pre data-len="{{ len .InnerRaw }}">{{ .InnerRaw }}</pre>
+-- layouts/shortcodes/nested.html --
+NESTED
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html",
+		"<pre data-len=\"54\">\n  Some *markdown* with {{&lt; nested &gt;}}.\n\n      code\n  </pre>",
+		"<pre data-len=\"0\"></pre>",
+	)
+}
+
+func TestShortcodeRenderMarkup(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["home", "taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+-- content/p1.md --
+---
+title: "p1"
+---
+
+{{< figure caption="A [link](/foo/) and *em*" >}}
+-- layouts/shortcodes/figure.html --
+<figcaption>{{ .RenderMarkup (.Get "caption") }}</figcaption>
+<div class="block">{{ .RenderMarkup (dict "display" "block") (.Get "caption") }}</div>
+<div class="org">{{ .RenderMarkup (dict "markup" "org") "/italic/" }}</div>
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html",
+		`<figcaption>A <a href="/foo/">link</a> and <em>em</em></figcaption>`,
+		`<div class="block"><p>A <a href="/foo/">link</a> and <em>em</em></p>`,
+		`<div class="org"><em>italic</em></div>`,
+	)
+}
+
+func TestShortcodeOrderedAndNamed(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["home", "taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+-- content/p1.md --
+---
+title: "p1"
+---
+
+{{< tabs "One" 2 "Three" >}}
+{{< tabs >}}
+{{< named a="A" b=2 >}}
+-- layouts/shortcodes/tabs.html --
+{{ with .Ordered }}Ordered: {{ range $i, $v := . }}{{ $i }}:{{ $v }}|{{ end }}{{ else }}Ordered: none{{ end }}$
+-- layouts/shortcodes/named.html --
+Named: {{ range $k, $v := .Named }}{{ $k }}:{{ $v }}|{{ end }}$
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html",
+		"Ordered: 0:One|1:2|2:Three|$",
+		"Ordered: none$",
+		"Named: a:A|b:2|$",
+	)
+
+	files = strings.ReplaceAll(files, "{{< tabs >}}", "{{< tabs a=\"b\" >}}")
+	b, err := TestE(t, files)
+	b.Assert(err, qt.ErrorMatches, `(?s).*shortcode "tabs" was called with named parameters, use .Named.*`)
+}
+
+func TestShortcodeDelegate(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["home", "taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+[shortcodes.delegate]
+button = "partials/button.html"
+callout = "callout.html"
+foo = "foo.html"
+-- content/p1.md --
+---
+title: "p1"
+---
+
+{{< button href="/a/" >}}Click **me**{{< /button >}}
+{{< callout "warning" >}}
+{{< foo >}}
+-- layouts/partials/button.html --
+<a href="{{ .Get "href" }}">{{ .Inner }}</a>|Page: {{ .Page.Title }}|Name: {{ .Name }}|
+-- layouts/partials/callout.html --
+Callout: {{ .Get 0 }}|IsNamedParams: {{ .IsNamedParams }}|
+-- layouts/partials/foo.html --
+Partial foo.
+-- layouts/shortcodes/foo.html --
+Shortcode foo.
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html",
+		"<a href=\"/a/\">Click **me**</a>|Page: p1|Name: button|",
+		"Callout: warning|IsNamedParams: false|",
+		// A shortcode template takes precedence.
+		"Shortcode foo.",
+		"! Partial foo.",
+	)
+
+	files = strings.ReplaceAll(files, `callout = "callout.html"`, `callout = "nosuchpartial.html"`)
+	b, err := TestE(t, files)
+	b.Assert(err, qt.ErrorMatches, `(?s).*shortcode "callout" delegates to partial "partials/nosuchpartial.html", which was not found.*`)
+}
+
+func TestShortcodeContentWithoutShortcode(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "home", "section"]
+-- content/p1.md --
+---
+title: p1
+---
+## Intro
+
+{{< excerpt >}}
+
+Some {{< b >}}bold{{< /b >}} text.
+
+{{< note >}}A {{< b >}}nested{{< /b >}} note.{{< /note >}}
+The end.
+-- layouts/shortcodes/b.html --
+<b>{{ .Inner }}</b>
+-- layouts/shortcodes/note.html --
+<div class="note">{{ .Inner }}</div>
+-- layouts/shortcodes/excerpt.html --
+Raw: {{ eq .Page.RawContent (.Page.ContentWithoutShortcode "nonexisting") }}|
+Without: {{ .Page.ContentWithoutShortcode "excerpt" }}|
+-- layouts/_default/single.html --
+{{ .Content }}
+Without note: {{ .ContentWithoutShortcode "note" }}|
+Without b: {{ .ContentWithoutShortcode "b" }}|
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html",
+		"Raw: true|",
+		"Without: ## Intro\n\n\n\nSome {{&lt; b &gt;}}bold{{&lt; /b &gt;}} text.",
+		"Without note: ## Intro\n\n{{&lt; excerpt &gt;}}\n\nSome {{&lt; b &gt;}}bold{{&lt; /b &gt;}} text.\n\n\nThe end.|",
+		"Without b: ## Intro\n\n{{&lt; excerpt &gt;}}\n\nSome  text.\n\n{{&lt; note &gt;}}A {{&lt; b &gt;}}nested{{&lt; /b &gt;}} note.{{&lt; /note &gt;}}\nThe end.|",
+	)
+}
+
+func TestShortcodeParentStore(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "home", "section"]
+[outputs]
+page = ["html", "json"]
+-- content/p1.md --
+---
+title: p1
+---
+{{< tabs >}}
+{{% tab "First" %}}First **content**.{{% /tab %}}
+{{% tab "Second" %}}Second content with {{% b %}}bold{{% /b %}}.{{% /tab %}}
+{{% tab "Third" %}}Third content.{{% /tab %}}
+{{< /tabs >}}
+
+{{< tabs >}}
+{{% tab "Other" %}}Other content.{{% /tab %}}
+{{< /tabs >}}
+-- layouts/shortcodes/b.html --
+**{{ .Inner }}**
+-- layouts/shortcodes/tab.html --
+{{- .Parent.Store.Add "tabs" (slice (dict "name" (.Get 0) "content" .Inner)) -}}
+-- layouts/shortcodes/tabs.html --
+{{- /* The nested tab shortcodes are rendered before this template is executed. */ -}}
+{{- $_ := .Inner -}}
+{{- $tabs := .Store.Get "tabs" -}}
+<div class="tabs" data-same="{{ eq .Store .Scratch }}">
+{{- range $i, $tab := $tabs }}<button>{{ $i }}:{{ $tab.name }}</button>{{ end }}
+{{- range $tabs }}<section>{{ .content }}</section>{{ end -}}
+</div>
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/_default/single.json --
+{{ .Content }}
+`
+
+	b := Test(t, files)
+
+	for _, filename := range []string{"public/p1/index.html", "public/p1/index.json"} {
+		b.AssertFileContent(filename,
+			`<div class="tabs" data-same="true"><button>0:First</button><button>1:Second</button><button>2:Third</button><section>First <strong>content</strong>.</section><section>Second content with <strong>bold</strong>.</section><section>Third content.</section></div>`,
+			`<div class="tabs" data-same="true"><button>0:Other</button><section>Other content.</section></div>`,
+		)
+	}
+}
+
+func TestShortcodePageOutputFormat(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+[outputs]
+page = ["html", "amp"]
+-- content/p1.md --
+---
+title: "p1"
+---
+{{< img src="a.jpg" >}}
+-- layouts/shortcodes/img.html --
+{{- if eq .Page.OutputFormat.Name "amp" -}}
+<amp-img src="{{ .Get "src" }}"></amp-img>
+{{- else -}}
+<img src="{{ .Get "src" }}">
+{{- end -}}
+|IsCurrent: {{ .Page.OutputFormat.IsCurrent }}|
+-- layouts/_default/single.html --
+Single: {{ .OutputFormat.Name }}|{{ .Content }}
+-- layouts/_default/single.amp.html --
+Single AMP: {{ .OutputFormat.Name }}|{{ .Content }}
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html", "Single: html|", `<img src="a.jpg">`, "|IsCurrent: true|", "! amp-img")
+	b.AssertFileContent("public/amp/p1/index.html", "Single AMP: amp|", `<amp-img src="a.jpg"></amp-img>`, "|IsCurrent: true|", "! <img")
+}
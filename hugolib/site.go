@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/bep/logg"
+	"github.com/gohugoio/hugo/common/constants"
 	"github.com/gohugoio/hugo/common/htime"
 	"github.com/gohugoio/hugo/common/hugio"
 	"github.com/gohugoio/hugo/common/types"
@@ -575,9 +576,18 @@ func (s *Site) assembleMenus() error {
 	// add menu entries from config to flat hash
 	for name, menu := range s.conf.Menus.Config {
 		for _, me := range menu {
-			if types.IsNil(me.Page) && me.PageRef != "" {
-				// Try to resolve the page.
-				me.Page, _ = s.getPage(nil, me.PageRef)
+			if me.PageRef != "" {
+				// Resolve the page on every build, as it may have been
+				// added or removed since the last.
+				p, err := s.getPage(nil, me.PageRef)
+				if err != nil {
+					s.Log.Warnidf(constants.WarnMenuPageRefNotFound, "Menu %q: entry %q: failed to resolve pageRef %q: %s", name, me.KeyName(), me.PageRef, err)
+				}
+				me.Page = p
+				// A configured URL is a valid fallback, e.g. in multilingual sites.
+				if err == nil && p == nil && me.MenuConfig.URL == "" {
+					s.Log.Warnidf(constants.WarnMenuPageRefNotFound, "Menu %q: entry %q: pageRef %q not found.", name, me.KeyName(), me.PageRef)
+				}
 			}
 
 			// If page is still nill, we must make sure that we have a URL that considers baseURL etc.
@@ -725,19 +735,37 @@ func (s *Site) GetPage(ref ...string) (page.Page, error) {
 	return p, err
 }
 
+// GetPages returns all pages with a logical path matching the given Glob
+// pattern, e.g. "/blog/**", sorted by the default sort order.
+func (s *Site) GetPages(pattern string) (page.Pages, error) {
+	return s.s.getPages(nil, pattern)
+}
+
 func (s *Site) absURLPath(targetPath string) string {
-	var path string
 	if s.conf.RelativeURLs {
-		path = helpers.GetDottedRelativePath(targetPath)
-	} else {
-		url := s.PathSpec.Cfg.BaseURL().String()
-		if !strings.HasSuffix(url, "/") {
-			url += "/"
-		}
-		path = url
+		return helpers.GetDottedRelativePath(targetPath)
+	}
+	return s.absURLBase()
+}
+
+// absURLBase returns the baseURL with a trailing slash.
+func (s *Site) absURLBase() string {
+	url := s.PathSpec.Cfg.BaseURL().String()
+	if !strings.HasSuffix(url, "/") {
+		url += "/"
 	}
+	return url
+}
 
-	return path
+// absURLBasePath returns the path element in baseURL, e.g. "/blog", to
+// replace in site-relative URLs along with the leading slash, so it is
+// not repeated. With canonifyURLs, the relative URLs created by Hugo do
+// not include it, so there's nothing to replace.
+func (s *Site) absURLBasePath() string {
+	if s.conf.CanonifyURLs {
+		return ""
+	}
+	return s.PathSpec.Cfg.BaseURL().BasePathNoTrailingSlash
 }
 
 const (
@@ -751,12 +779,8 @@ func (s *Site) renderAndWritePage(statCounter *uint64, name string, targetPath s
 	defer bp.PutBuffer(renderBuffer)
 
 	of := p.outputFormat()
-	p.incrRenderState()
 
-	ctx := tpl.Context.Page.Set(context.Background(), p)
-	ctx = tpl.Context.DependencyManagerScopedProvider.Set(ctx, p)
-
-	if err := s.renderForTemplate(ctx, p.Kind(), of.Name, d, renderBuffer, templ); err != nil {
+	if err := s.renderPageForTemplate(p, d, renderBuffer, templ); err != nil {
 		return err
 	}
 
@@ -776,10 +800,12 @@ func (s *Site) renderAndWritePage(statCounter *uint64, name string, targetPath s
 
 	if isRSS {
 		// Always canonify URLs in RSS
-		pd.AbsURLPath = s.absURLPath(targetPath)
+		pd.AbsURLPath = s.absURLBase()
+		pd.AbsURLBasePath = s.absURLBasePath()
 	} else if isHTML {
 		if s.conf.RelativeURLs || s.conf.CanonifyURLs {
 			pd.AbsURLPath = s.absURLPath(targetPath)
+			pd.AbsURLBasePath = s.absURLBasePath()
 		}
 
 		if s.watching() && s.conf.Internal.Running && !s.conf.DisableLiveReload {
@@ -796,6 +822,17 @@ func (s *Site) renderAndWritePage(statCounter *uint64, name string, targetPath s
 	return s.publisher.Publish(pd)
 }
 
+// renderPageForTemplate executes templ with d as data in the context of p
+// in its current output format and writes the result to w.
+func (s *Site) renderPageForTemplate(p *pageState, d any, w io.Writer, templ tpl.Template) error {
+	p.incrRenderState()
+
+	ctx := tpl.Context.Page.Set(context.Background(), p)
+	ctx = tpl.Context.DependencyManagerScopedProvider.Set(ctx, p)
+
+	return s.renderForTemplate(ctx, p.Kind(), p.outputFormat().Name, d, w, templ)
+}
+
 var infoOnMissingLayout = map[string]bool{
 	// The 404 layout is very much optional in Hugo, but we do look for it.
 	"404": true,
@@ -813,6 +850,22 @@ func (hr hookRendererTemplate) RenderLink(cctx context.Context, w io.Writer, ctx
 	return hr.templateHandler.ExecuteWithContext(cctx, hr.templ, w, ctx)
 }
 
+func (hr hookRendererTemplate) RenderWikilink(cctx context.Context, w io.Writer, ctx hooks.WikilinkContext) error {
+	return hr.templateHandler.ExecuteWithContext(cctx, hr.templ, w, ctx)
+}
+
+func (hr hookRendererTemplate) RenderFootnote(cctx context.Context, w io.Writer, ctx hooks.FootnoteContext) error {
+	return hr.templateHandler.ExecuteWithContext(cctx, hr.templ, w, ctx)
+}
+
+func (hr hookRendererTemplate) RenderBlockquote(cctx context.Context, w io.Writer, ctx hooks.BlockquoteContext) error {
+	return hr.templateHandler.ExecuteWithContext(cctx, hr.templ, w, ctx)
+}
+
+func (hr hookRendererTemplate) RenderTable(cctx context.Context, w io.Writer, ctx hooks.TableContext) error {
+	return hr.templateHandler.ExecuteWithContext(cctx, hr.templ, w, ctx)
+}
+
 func (hr hookRendererTemplate) RenderHeading(cctx context.Context, w io.Writer, ctx hooks.HeadingContext) error {
 	return hr.templateHandler.ExecuteWithContext(cctx, hr.templ, w, ctx)
 }
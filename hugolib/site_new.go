@@ -31,6 +31,7 @@ import (
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/config/allconfig"
 	"github.com/gohugoio/hugo/deps"
+	"github.com/gohugoio/hugo/hugofs"
 	"github.com/gohugoio/hugo/hugolib/doctree"
 	"github.com/gohugoio/hugo/identity"
 	"github.com/gohugoio/hugo/langs"
@@ -47,6 +48,7 @@ import (
 	"github.com/gohugoio/hugo/resources/resource"
 	"github.com/gohugoio/hugo/tpl"
 	"github.com/gohugoio/hugo/tpl/tplimpl"
+	"github.com/spf13/afero"
 )
 
 var _ page.Site = (*Site)(nil)
@@ -129,6 +131,56 @@ func NewHugoSites(cfg deps.DepsCfg) (*HugoSites, error) {
 		logger = loggers.New(logOpts)
 	}
 
+	if cfg.Fs != nil {
+		// The filesystems below are wrapped per HugoSites, so take a copy
+		// to avoid modifying the Fs shared with the caller, e.g. the server,
+		// which creates a new HugoSites when the configuration changes.
+		fs := *cfg.Fs
+		cfg.Fs = &fs
+	}
+
+	if build := cfg.Configs.Base.Build; (build.FileMode() != 0 || build.DirMode() != 0) && cfg.Fs != nil {
+		// Set the configured permissions on published files and directories.
+		setPermissions := func(fs afero.Fs) afero.Fs {
+			return hugofs.NewPermissionsFs(fs, build.FileMode(), build.DirMode())
+		}
+		publishDir := cfg.Fs.PublishDir
+		cfg.Fs.PublishDir = setPermissions(publishDir)
+		if cfg.Fs.PublishDirStatic == publishDir {
+			cfg.Fs.PublishDirStatic = cfg.Fs.PublishDir
+		} else {
+			cfg.Fs.PublishDirStatic = setPermissions(cfg.Fs.PublishDirStatic)
+		}
+	}
+
+	if cfg.Configs.Base.Build.BuildManifest && cfg.Fs != nil {
+		// Record the published files for the build manifest.
+		var recording bool
+		hugofs.WalkFilesystems(cfg.Fs.PublishDir, func(fs afero.Fs) bool {
+			_, recording = fs.(hugofs.CreatedFilenamesReporter)
+			return recording
+		})
+		if !recording {
+			cfg.Fs.PublishDir = hugofs.NewCreateCountingFs(cfg.Fs.PublishDir)
+		}
+	}
+
+	precompressor, err := publisher.NewPrecompressor(cfg.Configs.Base.Minify.Precompress, cfg.Configs.Base.MediaTypes.Config)
+	if err != nil {
+		return nil, err
+	}
+	if precompressor != nil && cfg.Fs != nil {
+		// Wrap outside any recording filesystem above so the compressed
+		// files get recorded, too.
+		publishDir := cfg.Fs.PublishDir
+		cfg.Fs.PublishDir = precompressor.WrapFs(publishDir)
+		if cfg.Fs.PublishDirStatic == publishDir {
+			cfg.Fs.PublishDirStatic = cfg.Fs.PublishDir
+		} else {
+			cfg.Fs.PublishDirStatic = precompressor.WrapFs(cfg.Fs.PublishDirStatic)
+		}
+	}
+
 	memCache := dynacache.New(dynacache.Options{Running: conf.Running(), Log: logger})
 
 	firstSiteDeps := &deps.Deps{
@@ -258,6 +310,9 @@ func NewHugoSites(cfg deps.DepsCfg) (*HugoSites, error) {
 	if err == nil && h == nil {
 		panic("hugo: newHugoSitesNew returned nil error and nil HugoSites")
 	}
+	if h != nil {
+		h.precompressor = precompressor
+	}
 
 	return h, err
 }
@@ -286,6 +341,7 @@ func newHugoSites(cfg deps.DepsCfg, d *deps.Deps, pageTrees *pageTrees, sites []
 		translationKeyPages:     maps.NewSliceCache[page.Page](),
 		currentSite:             sites[0],
 		skipRebuildForFilenames: make(map[string]bool),
+		staticPipeline:          &staticPipeline{cache: make(map[string]staticPipelineEntry)},
 		init: &hugoSitesInit{
 			data:    lazy.New(),
 			layouts: lazy.New(),
@@ -646,3 +646,95 @@ WordCount: {{ .WordCount }}
 	b.AssertFileContent("public/outputs-empty/index.html", "HTML:", "Word1. Word2.")
 	b.AssertFileContent("public/outputs-string/index.html", "O1:", "Word1. Word2.")
 }
+
+func TestOutputFormatNoMinify(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section"]
+minifyOutput = true
+[outputs]
+home = ["html", "amp", "json", "debug"]
+[outputFormats.amp]
+noMinify = true
+[outputFormats.debug]
+mediaType = "application/json"
+baseName = "debug"
+noMinify = true
+-- layouts/index.html --
+<div>
+  <p>Home</p>
+</div>
+-- layouts/index.amp.html --
+<div>
+  <p>AMP</p>
+</div>
+-- layouts/index.json --
+{ "home":   true }
+-- layouts/index.debug.json --
+{ "debug":   true }
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/index.html", "<div><p>Home</p></div>")
+	b.AssertFileContent("public/amp/index.html", "<div>\n  <p>AMP</p>\n</div>")
+	b.AssertFileContent("public/index.json", `{"home":true}`)
+	b.AssertFileContent("public/debug.json", `{ "debug":   true }`)
+}
+
+func TestOutputFormatsIsCurrentAndMediaTypes(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "sitemap", "robotsTXT", "404", "section"]
+[outputs]
+home = ["html", "rss", "json", "debug"]
+page = ["html"]
+[outputFormats.debug]
+mediaType = "application/json"
+baseName = "debug"
+-- content/p1.md --
+---
+title: p1
+---
+-- layouts/_default/single.html --
+Single: {{ range .OutputFormats }}{{ .Name }}:{{ .IsCurrent }}|{{ end }}
+Home: {{ range site.Home.OutputFormats }}{{ .Name }}:{{ .IsCurrent }}|{{ end }}
+-- layouts/index.html --
+{{ partial "formats.html" . }}
+-- layouts/index.rss.xml --
+{{ partial "formats.html" . }}
+-- layouts/index.json --
+{{ partial "formats.html" . }}
+-- layouts/index.debug.json --
+{{ partial "formats.html" . }}
+-- layouts/partials/formats.html --
+Current: {{ range .OutputFormats }}{{ if .IsCurrent }}{{ .Name }}{{ end }}{{ end }}|
+RSS: {{ with .OutputFormats.Get "rss" }}{{ .Name }}|{{ .Rel }}|{{ .MediaType.Type }}|{{ .IsCurrent }}|{{ .RelPermalink }}{{ end }}|
+Alternative: {{ range .AlternativeOutputFormats }}{{ .Name }}:{{ .IsCurrent }}|{{ end }}
+MediaTypes: {{ range .OutputFormats.MediaTypes }}{{ .Type }}|{{ end }}
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		"Current: html|",
+		"RSS: rss|alternate|application/rss&#43;xml|false|/index.xml|",
+		"Alternative: rss:false|json:false|debug:false|",
+		"MediaTypes: text/html|application/rss&#43;xml|application/json|",
+	)
+	b.AssertFileContent("public/index.xml",
+		"Current: rss|",
+		"RSS: rss|alternate|application/rss&#43;xml|true|/index.xml|",
+		"Alternative: html:false|json:false|debug:false|",
+	)
+	b.AssertFileContent("public/index.json", "Current: json|")
+	b.AssertFileContent("public/debug.json", "Current: debug|")
+	b.AssertFileContent("public/p1/index.html",
+		"Single: html:true|",
+		"Home: html:true|rss:false|json:false|debug:false|",
+	)
+}
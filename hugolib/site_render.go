@@ -15,10 +15,12 @@ package hugolib
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gohugoio/hugo/common/herrors"
 	"github.com/gohugoio/hugo/hugolib/doctree"
@@ -65,7 +67,7 @@ func (s siteRenderContext) shouldRenderStandalonePage(kind string) bool {
 
 // renderPages renders pages concurrently.
 func (s *Site) renderPages(ctx *siteRenderContext) error {
-	numWorkers := config.GetNumWorkerMultiplier()
+	numWorkers := s.renderConcurrency()
 
 	results := make(chan error)
 	pages := make(chan *pageState, numWorkers) // buffered for performance
@@ -116,6 +118,14 @@ func (s *Site) renderPages(ctx *siteRenderContext) error {
 	return nil
 }
 
+// renderConcurrency returns the number of pages to render concurrently.
+func (s *Site) renderConcurrency() int {
+	if n := s.conf.Build.RenderConcurrency; n > 0 {
+		return n
+	}
+	return config.GetNumWorkerMultiplier()
+}
+
 func pageRenderer(
 	ctx *siteRenderContext,
 	s *Site,
@@ -175,6 +185,11 @@ func pageRenderer(
 			d = s.h.Sites
 		}
 
+		var start time.Time
+		if s.h.pageRenderProfile != nil {
+			start = time.Now()
+		}
+
 		if err := s.renderAndWritePage(&s.PathSpec.ProcessingStats.Pages, "page "+p.Title(), targetPath, p, d, templ); err != nil {
 			results <- err
 		}
@@ -184,7 +199,100 @@ func pageRenderer(
 				results <- err
 			}
 		}
+
+		if s.h.pageRenderProfile != nil {
+			s.h.pageRenderProfile.add(p, time.Since(start))
+		}
+	}
+}
+
+// RenderPage renders the page with the given path in its main output format,
+// e.g. HTML, and returns the result. The path is resolved as in GetPage.
+//
+// This is useful for previews, where rendering every page in the site is not
+// needed. The sites must be built first, typically with SkipRender set in
+// BuildCfg. Any site wide state, e.g. taxonomies and page collections, is
+// from that build. The result is not post processed, e.g. minified, and
+// nothing is written to disk.
+func (s *Site) RenderPage(ref string) (string, error) {
+	h := s.h
+
+	unlock, err := h.BaseFs.LockBuild()
+	if err != nil {
+		return "", fmt.Errorf("failed to acquire a build lock: %w", err)
+	}
+	defer unlock()
+
+	if len(h.renderFormats) == 0 {
+		return "", errors.New("the site must be built before rendering a page")
+	}
+
+	if _, err := h.init.layouts.Do(context.Background()); err != nil {
+		return "", err
+	}
+
+	pp, err := s.getPage(nil, ref)
+	if err != nil {
+		return "", err
+	}
+	p, ok := pp.(*pageState)
+	if !ok {
+		return "", fmt.Errorf("page %q not found", ref)
+	}
+
+	if p.m.noRender() {
+		return "", fmt.Errorf("page %q is not configured to be rendered", ref)
+	}
+
+	outputFormats := p.m.outputFormats()
+	if len(outputFormats) == 0 {
+		return "", fmt.Errorf("page %q has no output formats", ref)
+	}
+	f := outputFormats[0]
+
+	// Find the index of f in the output formats of all sites, see render.
+	idx := -1
+	i := 0
+	for _, s2 := range h.Sites {
+		for _, f2 := range s2.renderFormats {
+			if s2 == s && f2.Name == f.Name {
+				idx = i
+			}
+			i++
+		}
 	}
+	if idx == -1 {
+		return "", fmt.Errorf("output format %q for page %q is not rendered", f.Name, ref)
+	}
+
+	h.currentSite = s
+	for _, s2 := range h.Sites {
+		// A site can "borrow" content from other sites, so prepare them all.
+		s2.rc = &siteRenderingContext{Format: f}
+		if err := s2.preparePagesForRender(s == s2, idx); err != nil {
+			return "", err
+		}
+	}
+
+	templ, found, err := p.resolveTemplate()
+	if err != nil {
+		return "", p.errorf(err, "failed to resolve template")
+	}
+	if !found {
+		return "", fmt.Errorf("no layout found for page %q in output format %q", ref, f.Name)
+	}
+
+	var d any = p
+	if p.Kind() == kinds.KindSitemapIndex {
+		d = h.Sites
+	}
+
+	var b strings.Builder
+	if err := s.renderPageForTemplate(p, d, &b, templ); err != nil {
+		return "", herrors.ImproveIfNilPointer(err)
+	}
+
+	return b.String(), nil
 }
 
 func (s *Site) logMissingLayout(name, layout, kind, outputFormat string) {
@@ -314,6 +422,15 @@ func (s *Site) renderAliases() error {
 
 					lang := p.Language().Lang
 
+					if s.h.redirectMap != nil {
+						if s.h.Configs.IsMultihost {
+							// Each language has its own host and publish root.
+							s.h.redirectMap.add(lang, s.aliasURLPath(strings.TrimPrefix(a, "/"+lang)), plink)
+						} else {
+							s.h.redirectMap.add("", s.aliasURLPath(a), plink)
+						}
+					}
+
 					if s.h.Configs.IsMultihost && !strings.HasPrefix(a, "/"+lang) {
 						// These need to be in its language root.
 						a = path.Join(lang, a)
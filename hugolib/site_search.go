@@ -0,0 +1,180 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/resources/page"
+	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/gohugoio/hugo/resources/resource_factories/create"
+	"github.com/gohugoio/hugo/tpl"
+	"github.com/mitchellh/mapstructure"
+)
+
+const searchIndexDefaultTargetPath = "search/index.json"
+
+var searchIndexDefaultFields = []string{"title", "content", "tags", "relPermalink"}
+
+// searchIndexOptions configures the search index created by Site.SearchIndex.
+type searchIndexOptions struct {
+	// The page fields to include in each search document.
+	// Any field not in the list of built-in fields is looked up in the page params.
+	Fields []string
+
+	// Words to remove from the plain text content, matched case-insensitively.
+	StopWords []string
+
+	// The target path of the resource. Default is "search/index.json".
+	TargetPath string
+
+	// The pages to index. Default is the site's regular pages.
+	pages page.Pages
+}
+
+func decodeSearchIndexOptions(s *Site, options any) (searchIndexOptions, error) {
+	opts := searchIndexOptions{
+		Fields:     searchIndexDefaultFields,
+		TargetPath: searchIndexDefaultTargetPath,
+	}
+
+	if options != nil {
+		m, err := maps.ToStringMapE(options)
+		if err != nil {
+			return opts, err
+		}
+		m = maps.CleanConfigStringMap(m)
+		if v, found := m["pages"]; found {
+			pages, ok := v.(page.Pages)
+			if !ok {
+				return opts, fmt.Errorf("pages must be a page collection, got %T", v)
+			}
+			opts.pages = pages
+			delete(m, "pages")
+		}
+		dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+			WeaklyTypedInput: true,
+			ErrorUnused:      true,
+			Result:           &opts,
+		})
+		if err != nil {
+			return opts, err
+		}
+		if err := dec.Decode(m); err != nil {
+			return opts, err
+		}
+	}
+
+	if opts.pages == nil {
+		opts.pages = s.RegularPages()
+	}
+
+	return opts, nil
+}
+
+// SearchIndex returns a JSON resource with a search document for each page,
+// e.g. for use with a client side search library.
+// The optional options map supports the keys fields, stopWords, targetPath and pages.
+func (s *Site) SearchIndex(ctx context.Context, options ...any) (resource.Resource, error) {
+	if len(options) > 1 {
+		return nil, errors.New("SearchIndex takes at most one argument")
+	}
+	var opt any
+	if len(options) == 1 {
+		opt = options[0]
+	}
+	opts, err := decodeSearchIndexOptions(s, opt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode search index options: %w", err)
+	}
+
+	stopWords := make(map[string]bool)
+	for _, w := range opts.StopWords {
+		stopWords[strings.ToLower(w)] = true
+	}
+
+	docs := make([]map[string]any, 0, len(opts.pages))
+	for _, p := range opts.pages {
+		doc := make(map[string]any)
+		for _, field := range opts.Fields {
+			v, err := searchIndexField(ctx, p, field, stopWords)
+			if err != nil {
+				return nil, err
+			}
+			if v != nil {
+				doc[field] = v
+			}
+		}
+		docs = append(docs, doc)
+	}
+
+	b, err := json.Marshal(docs)
+	if err != nil {
+		return nil, err
+	}
+
+	return create.New(s.ResourceSpec).FromString(opts.TargetPath, string(b))
+}
+
+func searchIndexField(ctx context.Context, p page.Page, field string, stopWords map[string]bool) (any, error) {
+	switch strings.ToLower(field) {
+	case "title":
+		return p.Title(), nil
+	case "content":
+		return removeStopWords(p.Plain(ctx), stopWords), nil
+	case "summary":
+		return removeStopWords(tpl.StripHTML(string(p.Summary(ctx))), stopWords), nil
+	case "description":
+		return p.Description(), nil
+	case "permalink":
+		return p.Permalink(), nil
+	case "relpermalink":
+		return p.RelPermalink(), nil
+	case "section":
+		return p.Section(), nil
+	case "kind":
+		return p.Kind(), nil
+	case "type":
+		return p.Type(), nil
+	case "date":
+		return p.Date(), nil
+	case "lastmod":
+		return p.Lastmod(), nil
+	default:
+		return p.Param(field)
+	}
+}
+
+// removeStopWords removes the words in stopWords from s and normalizes
+// the whitespace.
+func removeStopWords(s string, stopWords map[string]bool) string {
+	words := strings.Fields(s)
+	if len(stopWords) == 0 {
+		return strings.Join(words, " ")
+	}
+	n := 0
+	for _, w := range words {
+		if stopWords[strings.ToLower(strings.Trim(w, ".,;:!?\"'()"))] {
+			continue
+		}
+		words[n] = w
+		n++
+	}
+	return strings.Join(words[:n], " ")
+}
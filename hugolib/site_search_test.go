@@ -0,0 +1,81 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestSiteSearchIndex(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "rss", "sitemap"]
+-- content/p1.md --
+---
+title: "p1"
+tags: ["a", "b"]
+---
+The **quick** brown fox.
+-- content/p2.md --
+---
+title: "p2"
+weight: 1
+---
+A lazy dog and the cat.
+-- content/s1/p3.md --
+---
+title: "p3"
+---
+Section page.
+-- layouts/index.html --
+{{ with site.SearchIndex }}Default: {{ .RelPermalink }}|{{ .Content }}|{{ end }}
+{{ $opts := dict "fields" (slice "title" "content" "section") "stopWords" (slice "the" "a" "and") "targetPath" "idx.json" "pages" (where site.RegularPages "Section" "") }}
+{{ with site.SearchIndex $opts | fingerprint }}Custom: {{ .RelPermalink }}|{{ .Content }}|{{ end }}
+-- layouts/_default/single.html --
+{{ .Title }}
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		`Default: /search/index.json|[{&#34;content&#34;:&#34;A lazy dog and the cat.&#34;,&#34;relPermalink&#34;:&#34;/p2/&#34;,&#34;title&#34;:&#34;p2&#34;},{&#34;content&#34;:&#34;The quick brown fox.&#34;,&#34;relPermalink&#34;:&#34;/p1/&#34;,&#34;tags&#34;:[&#34;a&#34;,&#34;b&#34;],&#34;title&#34;:&#34;p1&#34;},{&#34;content&#34;:&#34;Section page.&#34;,&#34;relPermalink&#34;:&#34;/s1/p3/&#34;,&#34;title&#34;:&#34;p3&#34;}]|`,
+		`Custom: /idx.`,
+		`[{&#34;content&#34;:&#34;lazy dog cat.&#34;,&#34;section&#34;:&#34;&#34;,&#34;title&#34;:&#34;p2&#34;},{&#34;content&#34;:&#34;quick brown fox.&#34;,&#34;section&#34;:&#34;&#34;,&#34;title&#34;:&#34;p1&#34;}]|`,
+	)
+
+	b.AssertFileContent("public/search/index.json", `"title":"p1"`)
+	b.AssertFileExists("public/idx.json", false)
+}
+
+func TestSiteSearchIndexInvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "rss", "sitemap"]
+-- layouts/index.html --
+{{ with site.SearchIndex (dict "foo" "bar") }}{{ .RelPermalink }}{{ end }}
+`
+
+	b, err := TestE(t, files)
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(strings.Contains(err.Error(), "failed to decode search index options"), qt.IsTrue)
+}
@@ -365,6 +365,70 @@ Next: {{ with .NextInSection }}{{ .RelPermalink }}{{ end }}|
 		"Prev: |", "Next: /blog/cool/cool1/|")
 }
 
+func TestNextInSectionVsNext(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "rss", "sitemap"]
+-- content/root1.md --
+---
+title: root1
+---
+-- content/s1/p1.md --
+---
+title: p1
+date: 2020-01-01
+---
+-- content/s1/p2.md --
+---
+title: p2
+date: 2021-01-01
+---
+-- content/s1/p3/index.md --
+---
+title: p3
+date: 2022-01-01
+---
+-- content/s1/s2/_index.md --
+---
+title: s2
+---
+-- content/s1/s2/q1.md --
+---
+title: q1
+weight: 2
+---
+-- content/s1/s2/q2.md --
+---
+title: q2
+weight: 1
+---
+-- content/s1/hidden.md --
+---
+title: hidden
+build:
+  list: never
+---
+-- layouts/_default/list.html --
+List.
+-- layouts/_default/single.html --
+{{ .Title }}|NextInSection: {{ with .NextInSection }}{{ .Title }}{{ end }}|PrevInSection: {{ with .PrevInSection }}{{ .Title }}{{ end }}|Section: {{ range .CurrentSection.RegularPages }}{{ .Title }},{{ end }}|Next: {{ with .Next }}{{ .Title }}{{ end }}|Prev: {{ with .Prev }}{{ .Title }}{{ end }}|
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/root1/index.html", "root1|NextInSection: |PrevInSection: |Section: root1,|Next: p1|Prev: |")
+	b.AssertFileContent("public/s1/p1/index.html", "p1|NextInSection: p2|PrevInSection: |Section: p3,p2,p1,|Next: p2|Prev: root1|")
+	b.AssertFileContent("public/s1/p2/index.html", "p2|NextInSection: p3|PrevInSection: p1|")
+	b.AssertFileContent("public/s1/p3/index.html", "p3|NextInSection: |PrevInSection: p2|Section: p3,p2,p1,|Next: q1|Prev: p2|")
+	b.AssertFileContent("public/s1/s2/q1/index.html", "q1|NextInSection: q2|PrevInSection: |Section: q2,q1,|Next: q2|Prev: p3|")
+	b.AssertFileContent("public/s1/s2/q2/index.html", "q2|NextInSection: |PrevInSection: q1|")
+	// Pages not listed have no position in the section.
+	b.AssertFileContent("public/s1/hidden/index.html", "hidden|NextInSection: |PrevInSection: |")
+}
+
 func TestSectionEntries(t *testing.T) {
 	t.Parallel()
 
@@ -398,3 +462,72 @@ Kind: {{ .Kind }}|RelPermalink: {{ .RelPermalink }}|SectionsPath: {{ .SectionsPa
 	b.AssertFileContent("public/a/b/c/mybundle/index.html", "Kind: page|RelPermalink: /a/b/c/mybundle/|SectionsPath: /a/b/c|SectionsEntries: [a b c]|Len: 3")
 	b.AssertFileContent("public/index.html", "Kind: home|RelPermalink: /|SectionsPath: /|SectionsEntries: []|Len: 0")
 }
+
+func TestAncestorsAndDescendants(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+-- content/a/_index.md --
+---
+title: "A"
+weight: 1
+---
+-- content/a/p1.md --
+---
+title: "P1"
+weight: 2
+---
+-- content/a/b/_index.md --
+---
+title: "B"
+weight: 3
+---
+-- content/a/b/p2.md --
+---
+title: "P2"
+weight: 4
+---
+-- content/a/b/c/_index.md --
+---
+title: "C"
+weight: 5
+---
+-- content/a/b/c/mybundle/index.md --
+---
+title: "My Bundle"
+weight: 6
+---
+-- content/a/b/c/draft.md --
+---
+title: "Draft"
+draft: true
+---
+-- content/d/p3.md --
+---
+title: "P3"
+---
+-- layouts/_default/list.html --
+{{ partial "tree.html" . }}
+-- layouts/_default/single.html --
+{{ partial "tree.html" . }}
+-- layouts/partials/tree.html --
+Ancestors: {{ range .Ancestors.Reverse }}{{ .Title }}|{{ end }}$
+Descendants: {{ range .Descendants }}{{ .Title }}|{{ end }}$
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/a/b/c/mybundle/index.html",
+		"Ancestors: |A|B|C|$",
+		"Descendants: $",
+	)
+	b.AssertFileContent("public/a/index.html",
+		"Ancestors: |$",
+		"Descendants: P1|B|P2|C|My Bundle|$",
+	)
+	b.AssertFileContent("public/a/b/index.html", "Descendants: P2|C|My Bundle|$")
+	b.AssertFileContent("public/index.html", "Descendants: A|P1|B|P2|C|My Bundle|Ds|P3|$")
+}
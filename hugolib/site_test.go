@@ -17,8 +17,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
 
@@ -1298,3 +1300,169 @@ ABC.
 		b.Assert(els.IDs, qt.HasLen, 1)
 	}
 }
+
+func TestRenderConcurrency(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+paginate = 2
+[build]
+renderConcurrency = 1
+-- layouts/_default/list.html --
+{{ range .Paginator.Pages }}{{ .Title }}: {{ .Content }}|{{ end }}
+-- layouts/_default/single.html --
+{{ .Title }}|{{ range site.RegularPages }}{{ .Summary }}{{ end }}
+`
+
+	for i := 1; i <= 10; i++ {
+		files += fmt.Sprintf("-- content/p%d.md --\n---\ntitle: p%d\n---\nContent %d.\n", i, i, i)
+	}
+
+	b := Test(t, files)
+
+	b.Assert(b.H.Sites[0].renderConcurrency(), qt.Equals, 1)
+	b.AssertFileContent("public/index.html", "p1: <p>Content 1.</p>\n|p10: <p>Content 10.</p>\n|")
+	b.AssertFileContent("public/page/5/index.html", "p8: <p>Content 8.</p>")
+	b.AssertFileContent("public/p5/index.html", "p5|Content 1.Content 10.Content 2.")
+}
+
+func TestProfilePages(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "sitemap", "robotsTXT", "404"]
+profilePages = "profile/pages.json"
+paginate = 1
+[outputs]
+page = ["html", "json"]
+-- layouts/_default/list.html --
+{{ range .Paginator.Pages }}{{ .Title }}|{{ end }}
+-- layouts/_default/list.xml --
+RSS
+-- layouts/_default/single.html --
+{{ .Title }}
+-- layouts/_default/single.json --
+{"title": {{ .Title | jsonify }}}
+-- content/p1.md --
+---
+title: p1
+---
+-- content/p2.md --
+---
+title: p2
+---
+`
+
+	b := Test(t, files)
+
+	var entries []struct {
+		Path          string             `json:"path"`
+		Lang          string             `json:"lang"`
+		DurationMs    float64            `json:"durationMs"`
+		OutputFormats map[string]float64 `json:"outputFormats"`
+	}
+
+	b.Assert(json.Unmarshal([]byte(b.FileContent("profile/pages.json")), &entries), qt.IsNil)
+	b.Assert(entries, qt.HasLen, 3)
+
+	formats := make(map[string][]string)
+	for i, e := range entries {
+		b.Assert(e.Lang, qt.Equals, "en")
+		if i > 0 {
+			b.Assert(e.DurationMs <= entries[i-1].DurationMs, qt.IsTrue)
+		}
+		var sum float64
+		for f, d := range e.OutputFormats {
+			formats[e.Path] = append(formats[e.Path], f)
+			sum += d
+		}
+		b.Assert(math.Abs(sum-e.DurationMs) < 0.001, qt.IsTrue)
+		sort.Strings(formats[e.Path])
+	}
+
+	b.Assert(formats, qt.DeepEquals, map[string][]string{
+		"/":   {"html", "rss"},
+		"/p1": {"html", "json"},
+		"/p2": {"html", "json"},
+	})
+}
+
+func TestSiteRenderPage(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "sitemap", "robotsTXT", "404"]
+defaultContentLanguage = "en"
+[outputs]
+home = ["json", "html"]
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+-- content/s/p1.md --
+---
+title: "P1 en"
+---
+Content *en*.
+-- content/s/p1.nn.md --
+---
+title: "P1 nn"
+---
+Content *nn*.
+-- content/s/p2.md --
+---
+title: "P2 en"
+---
+-- content/headless.md --
+---
+title: "Headless"
+_build:
+  render: never
+---
+-- layouts/_default/single.html --
+Single: {{ .Title }}|{{ .Content }}|{{ .RelPermalink }}|Pages: {{ len .CurrentSection.Pages }}|{{ range .Translations }}{{ .Title }}{{ end }}|
+-- layouts/_default/list.html --
+List: {{ .Title }}|{{ range .Pages }}{{ .Title }}|{{ end }}
+-- layouts/index.json --
+{"title": {{ site.Title | jsonify }}, "pages": {{ len site.RegularPages }}}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			Running:     true,
+			BuildCfg:    BuildCfg{SkipRender: true},
+		},
+	).Build()
+
+	b.AssertFileExists("public/s/p1/index.html", false)
+
+	en, nn := b.H.Sites[0], b.H.Sites[1]
+
+	render := func(s *Site, ref string) string {
+		b.Helper()
+		content, err := s.RenderPage(ref)
+		b.Assert(err, qt.IsNil)
+		return content
+	}
+
+	b.Assert(render(en, "/s/p1"), qt.Equals, "Single: P1 en|<p>Content <em>en</em>.</p>\n|/s/p1/|Pages: 2|P1 nn|")
+	b.Assert(render(nn, "/s/p1"), qt.Equals, "Single: P1 nn|<p>Content <em>nn</em>.</p>\n|/nn/s/p1/|Pages: 1|P1 en|")
+	b.Assert(render(en, "s"), qt.Equals, "List: S|P1 en|P2 en|")
+	b.Assert(render(en, "/"), qt.Equals, `{"title": "", "pages": 3}`)
+
+	_, err := en.RenderPage("/s/nope")
+	b.Assert(err, qt.ErrorMatches, `page "/s/nope" not found`)
+	_, err = en.RenderPage("/headless")
+	b.Assert(err, qt.ErrorMatches, `page "/headless" is not configured to be rendered`)
+
+	b.EditFileReplaceAll("content/s/p1.md", "Content *en*.", "Edited *en*.").Build()
+	b.AssertFileExists("public/s/p1/index.html", false)
+	b.Assert(render(en, "/s/p1"), qt.Contains, "<p>Edited <em>en</em>.</p>")
+}
@@ -15,7 +15,9 @@ package hugolib
 
 import (
 	"fmt"
+	"path"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	qt "github.com/frankban/quicktest"
@@ -133,3 +135,169 @@ SectionsEntries: {{ .SectionsEntries }}
 	b.AssertFileContent("public/withfile/index.html", "SectionsEntries: [withfile]")
 	b.AssertFileContent("public/withoutfile/index.html", "SectionsEntries: [withoutfile]")
 }
+
+func TestURLsBaseURLPathAndLanguagePrefix(t *testing.T) {
+	t.Parallel()
+
+	filesTemplate := `
+-- hugo.toml --
+baseURL = %q
+defaultContentLanguage = "en"
+defaultContentLanguageInSubdir = %t
+%s
+disableKinds = ["taxonomy", "term", "sitemap", "robotsTXT", "404", "section"]
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+-- content/s/p1.md --
+---
+title: p1
+---
+-- content/s/p1.nn.md --
+---
+title: p1
+---
+-- layouts/_default/single.html --
+RelPermalink: {{ .RelPermalink }}|
+Permalink: {{ .Permalink }}|
+Home: <a href="{{ site.Home.RelPermalink }}">|
+Self: <a href="{{ .RelPermalink }}">|
+Translation: {{ range .Translations }}<a href="{{ .RelPermalink }}">{{ end }}|
+-- layouts/_default/list.html --
+{{ range site.RegularPages }}<a href="{{ .RelPermalink }}">{{ end }}
+-- layouts/_default/rss.xml --
+{{ range site.RegularPages }}<description>{{ printf "<a href=%%q>" .RelPermalink | transform.XMLEscape | safeHTML }}</description>{{ end }}
+`
+
+	for _, baseURL := range []string{"https://example.com/", "https://example.com/blog/", "https://example.com/blog", "https://example.com/blog/sub/"} {
+		for _, defaultInSubdir := range []bool{false, true} {
+			for _, urlsConfig := range []string{"", "canonifyURLs = true", "relativeURLs = true"} {
+				baseURL, defaultInSubdir, urlsConfig := baseURL, defaultInSubdir, urlsConfig
+				t.Run(fmt.Sprintf("%s/%t/%s", baseURL, defaultInSubdir, urlsConfig), func(t *testing.T) {
+					t.Parallel()
+
+					b := Test(t, fmt.Sprintf(filesTemplate, baseURL, defaultInSubdir, urlsConfig))
+
+					host := "https://example.com"
+					basePath := strings.TrimSuffix(strings.TrimPrefix(baseURL, host), "/")
+					enPrefix := ""
+					if defaultInSubdir {
+						enPrefix = "/en"
+					}
+
+					for _, lang := range []struct {
+						prefix      string
+						translation string
+					}{
+						{enPrefix, "/nn"},
+						{"/nn", enPrefix},
+					} {
+						// href returns the expected link to the URL path u in a
+						// published file with the given number of directories.
+						href := func(u string, depth int) string {
+							switch urlsConfig {
+							case "canonifyURLs = true":
+								return host + basePath + u
+							case "relativeURLs = true":
+								if depth == 0 {
+									return "./" + strings.TrimPrefix(u, "/")
+								}
+								return strings.Repeat("../", depth) + strings.TrimPrefix(u, "/")
+							default:
+								return basePath + u
+							}
+						}
+
+						relPermalink := basePath + lang.prefix + "/s/p1/"
+						if urlsConfig == "canonifyURLs = true" {
+							relPermalink = lang.prefix + "/s/p1/"
+						}
+						dir := strings.TrimPrefix(lang.prefix, "/")
+						depth := strings.Count(lang.prefix, "/") + 2
+
+						b.AssertFileContent(path.Join("public", dir, "s/p1/index.html"),
+							"RelPermalink: "+relPermalink+"|",
+							"Permalink: "+host+basePath+lang.prefix+"/s/p1/|",
+							`Home: <a href="`+href(lang.prefix+"/", depth)+`">|`,
+							`Self: <a href="`+href(lang.prefix+"/s/p1/", depth)+`">|`,
+							`Translation: <a href="`+href(lang.translation+"/s/p1/", depth)+`">|`,
+						)
+						b.AssertFileContent(path.Join("public", dir, "index.html"),
+							`<a href="`+href(lang.prefix+"/s/p1/", depth-2)+`">`,
+						)
+						b.AssertFileContent(path.Join("public", dir, "index.xml"),
+							`<description>&lt;a href=&#34;`+host+basePath+lang.prefix+`/s/p1/&#34;&gt;</description>`,
+						)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestUglyURLsPerSectionAndCascade(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org"
+disableKinds = ["taxonomy", "term", "rss", "sitemap"]
+[uglyURLs]
+downloads = true
+[permalinks.page]
+files = "/f/:slug/"
+-- content/blog/post.md --
+---
+title: "Post"
+---
+-- content/downloads/_index.md --
+---
+title: "Downloads"
+---
+-- content/downloads/file.md --
+---
+title: "File"
+---
+-- content/downloads/pretty.md --
+---
+title: "Pretty"
+uglyURLs: false
+---
+-- content/legacy/_index.md --
+---
+title: "Legacy"
+cascade:
+  uglyURLs: true
+---
+-- content/legacy/docs/_index.md --
+---
+title: "Legacy Docs"
+---
+-- content/legacy/docs/page.md --
+---
+title: "Legacy Page"
+---
+-- content/files/report.md --
+---
+title: "Report"
+slug: "annual-report"
+uglyURLs: true
+---
+-- layouts/_default/single.html --
+{{ .Title }}|{{ .RelPermalink }}|
+-- layouts/_default/list.html --
+{{ .Title }}|{{ .RelPermalink }}|
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/blog/post/index.html", "Post|/blog/post/|")
+	b.AssertFileContent("public/downloads.html", "Downloads|/downloads.html|")
+	b.AssertFileContent("public/downloads/file.html", "File|/downloads/file.html|")
+	b.AssertFileContent("public/downloads/pretty/index.html", "Pretty|/downloads/pretty/|")
+	b.AssertFileContent("public/legacy.html", "Legacy|/legacy.html|")
+	b.AssertFileContent("public/legacy/docs.html", "Legacy Docs|/legacy/docs.html|")
+	b.AssertFileContent("public/legacy/docs/page.html", "Legacy Page|/legacy/docs/page.html|")
+	b.AssertFileContent("public/f/annual-report.html", "Report|/f/annual-report.html|")
+}
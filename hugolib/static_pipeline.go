@@ -0,0 +1,198 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gohugoio/hugo/common/herrors"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/helpers"
+	"github.com/gohugoio/hugo/hugolib/filesystems"
+	"github.com/gohugoio/hugo/minifiers"
+	"github.com/spf13/afero"
+)
+
+// staticPipeline transforms the static files matched by the staticPipeline
+// config.
+type staticPipeline struct {
+	initOnce sync.Once
+	min      minifiers.Client
+	initErr  error
+
+	mu sync.Mutex
+	// Keyed by the filename relative to the publish dir.
+	cache map[string]staticPipelineEntry
+}
+
+// staticPipelineEntry is the result of transforming a static file.
+type staticPipelineEntry struct {
+	// The source file's modification time and size.
+	modTime time.Time
+	size    int64
+
+	// The filename of the transformed file relative to the publish dir.
+	target string
+
+	// The size and sha256 hash of the transformed file.
+	targetSize int64
+	targetHash string
+}
+
+// IsStaticPipelineFile reports whether the static file with the given
+// filename, relative to the static dir, is handled by the static pipeline
+// rather than copied as is.
+func (h *HugoSites) IsStaticPipelineFile(filename string) bool {
+	return h.Configs.Base.StaticPipeline.Match(filename) != nil
+}
+
+// PublishStaticPipeline transforms the static files in sfs matched by the
+// staticPipeline config and writes them to dst. If no filenames are given,
+// all matching files in sfs are processed. Files that have not changed since
+// they were last processed are skipped.
+// It returns the number of matching files.
+func (h *HugoSites) PublishStaticPipeline(sfs *filesystems.SourceFilesystem, dst afero.Fs, filenames ...string) (uint64, error) {
+	if len(h.Configs.Base.StaticPipeline) == 0 {
+		return 0, nil
+	}
+
+	if len(filenames) == 0 {
+		err := afero.Walk(sfs.Fs, "", func(filename string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			if h.IsStaticPipelineFile(filename) {
+				filenames = append(filenames, filename)
+			}
+			return nil
+		})
+		if err != nil && !herrors.IsNotExist(err) {
+			return 0, err
+		}
+	}
+
+	var count uint64
+	for _, filename := range filenames {
+		e, content, err := h.processStaticPipelineFile(sfs, filename, false)
+		if err != nil {
+			return count, err
+		}
+		if content == nil {
+			if _, err := dst.Stat(e.target); err == nil {
+				count++
+				continue
+			}
+			// The target was removed from the publish dir.
+			if e, content, err = h.processStaticPipelineFile(sfs, filename, true); err != nil {
+				return count, err
+			}
+		}
+		if err := helpers.WriteToDisk(e.target, bytes.NewReader(content), dst); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// processStaticPipelineFile transforms the given static file. If the file is
+// unchanged since it was last processed and force is not set, the cached
+// entry is returned with a nil content.
+func (h *HugoSites) processStaticPipelineFile(sfs *filesystems.SourceFilesystem, filename string, force bool) (staticPipelineEntry, []byte, error) {
+	p := h.staticPipeline
+
+	fi, err := sfs.Fs.Stat(filename)
+	if err != nil {
+		return staticPipelineEntry{}, nil, err
+	}
+
+	key := path.Join(filepath.ToSlash(sfs.PublishFolder), filepath.ToSlash(filename))
+
+	p.mu.Lock()
+	prev, found := p.cache[key]
+	p.mu.Unlock()
+	if found && !force && prev.modTime.Equal(fi.ModTime()) && prev.size == fi.Size() {
+		return prev, nil, nil
+	}
+
+	content, err := afero.ReadFile(sfs.Fs, filename)
+	if err != nil {
+		return staticPipelineEntry{}, nil, err
+	}
+
+	target := filepath.Join(sfs.PublishFolder, filename)
+
+	for _, t := range h.Configs.Base.StaticPipeline.Match(filename) {
+		switch t {
+		case config.StaticTransformMinify:
+			if content, err = h.minifyStaticFile(target, content); err != nil {
+				return staticPipelineEntry{}, nil, err
+			}
+		case config.StaticTransformFingerprint:
+			d := sha256.Sum256(content)
+			ext := filepath.Ext(target)
+			target = strings.TrimSuffix(target, ext) + "." + hex.EncodeToString(d[:]) + ext
+		}
+	}
+
+	d := sha256.Sum256(content)
+	e := staticPipelineEntry{
+		modTime:    fi.ModTime(),
+		size:       fi.Size(),
+		target:     target,
+		targetSize: int64(len(content)),
+		targetHash: hex.EncodeToString(d[:]),
+	}
+
+	p.mu.Lock()
+	p.cache[key] = e
+	p.mu.Unlock()
+
+	return e, content, nil
+}
+
+func (h *HugoSites) minifyStaticFile(filename string, content []byte) ([]byte, error) {
+	p := h.staticPipeline
+	p.initOnce.Do(func() {
+		p.min, p.initErr = minifiers.New(h.ResourceSpec.MediaTypes(), h.ResourceSpec.OutputFormats(), h.Configs.GetFirstLanguageConfig())
+	})
+	if p.initErr != nil {
+		return nil, p.initErr
+	}
+
+	ext := filepath.Ext(filename)
+	if ext == "" {
+		return nil, fmt.Errorf("staticPipeline: failed to minify %q: unknown media type", filepath.ToSlash(filename))
+	}
+	mt, _, found := h.ResourceSpec.MediaTypes().GetBySuffix(ext[1:])
+	if !found {
+		return nil, fmt.Errorf("staticPipeline: failed to minify %q: unknown media type", filepath.ToSlash(filename))
+	}
+
+	var b bytes.Buffer
+	if err := p.min.Minify(mt, &b, bytes.NewReader(content)); err != nil {
+		return nil, fmt.Errorf("staticPipeline: failed to minify %q: %w", filepath.ToSlash(filename), err)
+	}
+	return b.Bytes(), nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/spf13/afero"
+)
+
+func TestStaticPipeline(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "rss", "404", "robotsTXT", "section", "sitemap"]
+[build]
+buildManifest = true
+[[staticPipeline]]
+glob = "**.svg"
+transforms = ["minify", "fingerprint"]
+[[staticPipeline]]
+glob = "css/*.css"
+transforms = ["minify"]
+-- static/images/logo.svg --
+<svg  width="10" >  <g> </g>  </svg>
+-- static/css/main.css --
+body {   color: red;   }
+-- static/b.txt --
+static b.
+-- layouts/index.html --
+Home.
+`
+
+	b := Test(t, files)
+
+	const logo = "images/logo.c9cba7e35dc25a73d0f591287398bb766481e117a4a92712cacf77b65f775d77.svg"
+
+	b.AssertFileContent("public/hugo_build_manifest.json",
+		`"path": "b.txt",`,
+		`"path": "css/main.css",
+      "size": 15,`,
+		`"path": "`+logo+`",`,
+	)
+
+	sfs := b.H.BaseFs.SourceFilesystems.Static[""]
+	dst := b.H.Fs.PublishDir
+
+	count, err := b.H.PublishStaticPipeline(sfs, dst)
+	b.Assert(err, qt.IsNil)
+	b.Assert(count, qt.Equals, uint64(2))
+	b.AssertFileContentExact("public/css/main.css", "body{color:red}")
+	b.AssertFileContentExact("public/"+logo, `<svg width="10"><g/></svg>`)
+	b.AssertFileExists("public/b.txt", false)
+
+	// Unchanged files are not processed again.
+	b.Assert(afero.WriteFile(dst, "css/main.css", []byte("edited"), 0o666), qt.IsNil)
+	_, err = b.H.PublishStaticPipeline(sfs, dst, "css/main.css")
+	b.Assert(err, qt.IsNil)
+	b.AssertFileContentExact("public/css/main.css", "edited")
+
+	// Unless the target has been removed.
+	b.Assert(dst.Remove("css/main.css"), qt.IsNil)
+	_, err = b.H.PublishStaticPipeline(sfs, dst, "css/main.css")
+	b.Assert(err, qt.IsNil)
+	b.AssertFileContentExact("public/css/main.css", "body{color:red}")
+}
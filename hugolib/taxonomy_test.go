@@ -753,3 +753,113 @@ Single.
 `
 	Test(t, files)
 }
+
+func TestTaxonomiesBuildListInTerms(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["rss", "sitemap", "robotsTXT", "404", "section"]
+[taxonomies]
+tag = 'tags'
+-- content/p1.md --
+---
+title: P1
+tags: ['a', 'b']
+---
+-- content/p2.md --
+---
+title: P2
+tags: ['a']
+_build:
+  listInTerms: false
+---
+-- content/p3.md --
+---
+title: P3
+tags: ['a']
+---
+-- layouts/index.html --
+{{ $a := site.Taxonomies.tags.a }}
+Count: {{ site.Taxonomies.tags.Count "a" }}|CountListed: {{ site.Taxonomies.tags.CountListed "a" }}|
+Listed: {{ range $a.Listed }}{{ .Page.RelPermalink }}|{{ end }}
+RegularPages: {{ len site.RegularPages }}|
+-- layouts/_default/single.html --
+Terms: {{ range .GetTerms "tags" }}{{ .Title }}|{{ end }}
+Related: {{ range site.RegularPages.Related . }}{{ .RelPermalink }}|{{ end }}
+-- layouts/_default/term.html --
+Pages: {{ range .Pages }}{{ .RelPermalink }}|{{ end }}
+RegularPages: {{ range .RegularPages }}{{ .RelPermalink }}|{{ end }}
+-- layouts/_default/taxonomy.html --
+{{ range .Data.Terms.ByCount }}{{ .Name }}: {{ .Count }}/{{ .CountListed }}|{{ end }}
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		"Count: 3|CountListed: 2|",
+		"Listed: /p1/|/p3/|",
+		"RegularPages: 3|",
+	)
+	b.AssertFileContent("public/tags/a/index.html",
+		"Pages: /p1/|/p3/|",
+		"RegularPages: /p1/|/p3/|",
+	)
+	b.AssertFileContent("public/tags/index.html", "a: 3/2|b: 1/1|")
+	b.AssertFileContent("public/p2/index.html",
+		"Terms: A|",
+		"Related: /p1/|/p3/|",
+	)
+}
+
+func TestTaxonomiesNextPrevInTerm(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["home", "section", "rss", "sitemap", "robotsTXT", "404"]
+[taxonomies]
+series = "series"
+tag = "tags"
+-- content/part2.md --
+---
+title: "Part 2"
+series: ["Golang Basics"]
+series_weight: 2
+date: 2024-01-01
+---
+-- content/part1.md --
+---
+title: "Part 1"
+series: ["Golang Basics"]
+series_weight: 1
+date: 2024-03-01
+---
+-- content/part3.md --
+---
+title: "Part 3"
+series: ["Golang Basics"]
+series_weight: 3
+date: 2024-02-01
+---
+-- content/other.md --
+---
+title: "Other"
+series: ["Rust Basics"]
+---
+-- layouts/_default/single.html --
+{{ with .NextInTerm "series" "golang basics" }}Next: {{ .Title }}{{ else }}Next: nil{{ end }}|
+{{ with .PrevInTerm "Series" "Golang Basics" }}Prev: {{ .Title }}{{ else }}Prev: nil{{ end }}|
+{{ with .NextInTerm "tags" "none" }}Next tags: {{ .Title }}{{ else }}Next tags: nil{{ end }}|
+{{ with .NextInTerm "nosuchtaxonomy" "none" }}Next nosuchtaxonomy: {{ .Title }}{{ else }}Next nosuchtaxonomy: nil{{ end }}|
+-- layouts/_default/list.html --
+List.
+`
+
+	b := Test(t, files)
+
+	b.AssertFileContent("public/part1/index.html", "Next: nil|", "Prev: Part 2|", "Next tags: nil|", "Next nosuchtaxonomy: nil|")
+	b.AssertFileContent("public/part2/index.html", "Next: Part 1|", "Prev: Part 3|")
+	b.AssertFileContent("public/part3/index.html", "Next: Part 2|", "Prev: nil|")
+	b.AssertFileContent("public/other/index.html", "Next: nil|", "Prev: nil|")
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/gohugoio/hugo/common/loggers"
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/resources/page"
+	"github.com/gohugoio/hugo/tpl"
 
 	"github.com/gohugoio/go-i18n/v2/i18n"
 )
@@ -34,13 +35,24 @@ type translateFunc func(ctx context.Context, translationID string, templateData
 // Translator handles i18n translations.
 type Translator struct {
 	translateFuncs map[string]translateFunc
+	missing        *missingTranslations
 	cfg            config.AllProvider
 	logger         loggers.Logger
 }
 
 // NewTranslator creates a new Translator for the given language bundle and configuration.
 func NewTranslator(b *i18n.Bundle, cfg config.AllProvider, logger loggers.Logger) Translator {
-	t := Translator{cfg: cfg, logger: logger, translateFuncs: make(map[string]translateFunc)}
+	var missing *missingTranslations
+	if cfg.CollectMissingTranslations() {
+		missing = newMissingTranslations()
+	}
+	return newTranslator(b, cfg, logger, missing)
+}
+
+// newTranslator creates a new Translator recording missing translations in
+// missing, if set.
+func newTranslator(b *i18n.Bundle, cfg config.AllProvider, logger loggers.Logger, missing *missingTranslations) Translator {
+	t := Translator{cfg: cfg, logger: logger, translateFuncs: make(map[string]translateFunc), missing: missing}
 	t.initFuncs(b)
 	return t
 }
@@ -119,6 +131,10 @@ func (t Translator) initFuncs(bndl *i18n.Bundle) {
 				t.logger.Warnf("Failed to get translated string for language %q and ID %q: %s", currentLangStr, translationID, err)
 			}
 
+			if t.missing != nil {
+				t.missing.add(currentLangStr, translationID, pagePathFromContext(ctx))
+			}
+
 			if t.cfg.PrintI18nWarnings() {
 				t.logger.Warnf("i18n|MISSING_TRANSLATION|%s|%s", currentLangStr, translationID)
 			}
@@ -132,6 +148,32 @@ func (t Translator) initFuncs(bndl *i18n.Bundle) {
 	}
 }
 
+// MissingTranslations returns the translations not found since the last
+// call to ResetMissingTranslations, sorted by language and ID.
+func (t Translator) MissingTranslations() []MissingTranslation {
+	if t.missing == nil {
+		return nil
+	}
+	return t.missing.entries()
+}
+
+// ResetMissingTranslations clears the collected missing translations.
+func (t Translator) ResetMissingTranslations() {
+	if t.missing != nil {
+		t.missing.reset()
+	}
+}
+
+func pagePathFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if p, ok := tpl.Context.Page.Get(ctx).(page.Page); ok {
+		return p.Path()
+	}
+	return ""
+}
+
 // intCount wraps the Count method.
 type intCount int
 
@@ -14,9 +14,12 @@
 package i18n_test
 
 import (
+	"encoding/json"
 	"testing"
 
+	qt "github.com/frankban/quicktest"
 	"github.com/gohugoio/hugo/hugolib"
+	"github.com/gohugoio/hugo/langs/i18n"
 )
 
 func TestI18nFromTheme(t *testing.T) {
@@ -126,3 +129,88 @@ title: home_es
 	b.AssertFileContent("public/es/index.html", `home_es_gato`)
 	b.AssertFileContent("public/fr/index.html", `home_fr_gato`)
 }
+
+func TestMissingTranslations(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "404"]
+defaultContentLanguage = "en"
+missingTranslations = "reports/missing.json"
+[languages.en]
+weight = 1
+[languages.de]
+weight = 2
+-- i18n/en.toml --
+hello = "Hello"
+bye = "Bye"
+-- i18n/de.toml --
+hello = "Hallo"
+-- content/p1.md --
+---
+title: p1
+---
+-- content/p2.md --
+---
+title: p2
+---
+-- content/p1.de.md --
+---
+title: p1
+---
+-- content/p2.de.md --
+---
+title: p2
+---
+-- layouts/_default/single.html --
+{{ i18n "hello" }}|{{ i18n "bye" }}|{{ i18n "missing" }}|{{ i18n "missing" }}|
+-- layouts/index.html --
+{{ i18n "missing" }}|
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/de/p1/index.html", "Hallo|Bye|||")
+
+	var missing []i18n.MissingTranslation
+	b.Assert(json.Unmarshal([]byte(b.FileContent("reports/missing.json")), &missing), qt.IsNil)
+	b.Assert(missing, qt.DeepEquals, []i18n.MissingTranslation{
+		{Lang: "de", ID: "bye", Pages: 2},
+		{Lang: "de", ID: "missing", Pages: 3},
+		{Lang: "en", ID: "missing", Pages: 3},
+	})
+
+	// A rebuild only re-renders the changed pages, but keeps the entries
+	// collected for the other pages.
+	b = hugolib.TestRunning(t, files)
+	b.EditFileReplaceAll("content/p1.de.md", "title: p1", "title: p1 edited").Build()
+	b.AssertFileContent("public/de/p1/index.html", "Hallo|Bye|||")
+
+	missing = nil
+	b.Assert(json.Unmarshal([]byte(b.FileContent("reports/missing.json")), &missing), qt.IsNil)
+	b.Assert(missing, qt.DeepEquals, []i18n.MissingTranslation{
+		{Lang: "de", ID: "bye", Pages: 2},
+		{Lang: "de", ID: "missing", Pages: 3},
+		{Lang: "en", ID: "missing", Pages: 3},
+	})
+}
+
+func TestMissingTranslationsDisabled(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "404"]
+-- i18n/en.toml --
+hello = "Hello"
+-- layouts/index.html --
+{{ i18n "hello" }}|{{ i18n "missing" }}|
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/index.html", "Hello||")
+	tp := b.H.Deps.TranslationProvider.(*i18n.TranslationProvider)
+	b.Assert(tp.MissingTranslations(), qt.IsNil)
+}
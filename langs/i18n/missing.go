@@ -0,0 +1,92 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package i18n
+
+import (
+	"sort"
+	"sync"
+)
+
+// MissingTranslation describes a translation ID that could not be found
+// for a language.
+type MissingTranslation struct {
+	Lang string `json:"lang"`
+	ID   string `json:"id"`
+
+	// The number of pages that needed the translation.
+	Pages int `json:"pages"`
+}
+
+type missingTranslationKey struct {
+	lang string
+	id   string
+}
+
+// missingTranslations collects the translation lookups that fell through
+// the fallback chain. It is safe for concurrent use.
+type missingTranslations struct {
+	mu sync.Mutex
+	m  map[missingTranslationKey]map[string]bool
+}
+
+func newMissingTranslations() *missingTranslations {
+	return &missingTranslations{
+		m: make(map[missingTranslationKey]map[string]bool),
+	}
+}
+
+// add records that the translation id was missing for lang when rendering
+// the page with the given path. The path is empty if not known.
+func (m *missingTranslations) add(lang, id, path string) {
+	key := missingTranslationKey{lang: lang, id: id}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pages, found := m.m[key]
+	if !found {
+		pages = make(map[string]bool)
+		m.m[key] = pages
+	}
+	if path != "" {
+		pages[path] = true
+	}
+}
+
+func (m *missingTranslations) reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m = make(map[missingTranslationKey]map[string]bool)
+}
+
+// entries returns the collected entries sorted by language and ID.
+func (m *missingTranslations) entries() []MissingTranslation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entries := make([]MissingTranslation, 0, len(m.m))
+	for k, pages := range m.m {
+		entries = append(entries, MissingTranslation{Lang: k.lang, ID: k.id, Pages: len(pages)})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		ei, ej := entries[i], entries[j]
+		if ei.Lang != ej.Lang {
+			return ei.Lang < ej.Lang
+		}
+		return ei.ID < ej.ID
+	})
+
+	return entries
+}
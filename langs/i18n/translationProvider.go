@@ -37,6 +37,10 @@ import (
 // of bundles etc.
 type TranslationProvider struct {
 	t Translator
+
+	// The missing translations, kept when the translations are reloaded.
+	// Only set if missingTranslations is set.
+	missing *missingTranslations
 }
 
 // NewTranslationProvider creates a new translation provider.
@@ -72,7 +76,10 @@ func (tp *TranslationProvider) NewResource(dst *deps.Deps) error {
 		return err
 	}
 
-	tp.t = NewTranslator(bundle, dst.Conf, dst.Log)
+	if tp.missing == nil && dst.Conf.CollectMissingTranslations() {
+		tp.missing = newMissingTranslations()
+	}
+	tp.t = newTranslator(bundle, dst.Conf, dst.Log, tp.missing)
 
 	dst.Translate = tp.t.Func(dst.Conf.Language().Lang)
 
@@ -118,6 +125,17 @@ func addTranslationFile(bundle *i18n.Bundle, r *source.File) error {
 	return nil
 }
 
+// MissingTranslations returns the translations not found since the last
+// call to ResetMissingTranslations, see Translator.MissingTranslations.
+func (tp *TranslationProvider) MissingTranslations() []MissingTranslation {
+	return tp.t.MissingTranslations()
+}
+
+// ResetMissingTranslations clears the collected missing translations.
+func (tp *TranslationProvider) ResetMissingTranslations() {
+	tp.t.ResetMissingTranslations()
+}
+
 // CloneResource sets the language func for the new language.
 func (tp *TranslationProvider) CloneResource(dst, src *deps.Deps) error {
 	dst.Translate = tp.t.Func(dst.Conf.Language().Lang)
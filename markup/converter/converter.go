@@ -139,6 +139,15 @@ type DocumentContext struct {
 	DocumentID   string
 	DocumentName string
 	Filename     string
+
+	// HardWraps overrides the markup's hardWraps setting for this document, if set.
+	HardWraps *bool
+
+	// Unsafe overrides the markup's unsafe setting for this document, if set.
+	Unsafe *bool
+
+	// Sanitize overrides the markup's sanitizer.enable setting for this document, if set.
+	Sanitize *bool
 }
 
 // RenderContext holds contextual information about the content to render.
@@ -152,6 +161,10 @@ type RenderContext struct {
 	// Whether to render TableOfContents.
 	RenderTOC bool
 
+	// HeadingIDPrefix is prepended to all generated heading IDs, e.g. to
+	// avoid ID collisions when multiple documents are rendered into one page.
+	HeadingIDPrefix string
+
 	// GerRenderer provides hook renderers on demand.
 	GetRenderer hooks.GetRendererFunc
 }
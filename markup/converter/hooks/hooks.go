@@ -90,6 +90,67 @@ type LinkRenderer interface {
 	RenderLink(cctx context.Context, w io.Writer, ctx LinkContext) error
 }
 
+// WikilinkContext is the context passed to a wikilink render hook.
+type WikilinkContext interface {
+	// The Page being rendered.
+	Page() any
+
+	// The link target, e.g. Page Title in [[Page Title#Heading|Label]].
+	// Empty for links to a heading on the current page.
+	Target() string
+
+	// The heading part of the link, e.g. Heading in [[Page Title#Heading]].
+	Anchor() string
+
+	// The rendered (HTML) label, falling back to the link text.
+	Text() hstring.RenderedString
+
+	// The plain variant of Text.
+	PlainText() string
+
+	// The CSS class to use for links that cannot be resolved, configured in
+	// markup.goldmark.extensions.wikilink.unresolvedClass.
+	UnresolvedClass() string
+}
+
+type WikilinkRenderer interface {
+	RenderWikilink(cctx context.Context, w io.Writer, ctx WikilinkContext) error
+}
+
+// FootnoteContext is the context passed to a footnote render hook. The hook
+// is called once for each list of footnote definitions.
+type FootnoteContext interface {
+	// The Page being rendered.
+	Page() any
+
+	// The zero-based index of this list of footnotes on the page. There is
+	// one list per section when markup.goldmark.extensions.footnoteSectionLevel
+	// is set, else one list per page.
+	Ordinal() int
+
+	// The footnote definitions in this list, ordered by their number.
+	Footnotes() []Footnote
+}
+
+// Footnote is a footnote definition passed to a footnote render hook.
+type Footnote struct {
+	// The footnote number.
+	Index int
+
+	// The HTML id of the footnote definition, e.g. fn:1.
+	ID string
+
+	// The HTML ids of the references to this footnote, e.g. fnref:1.
+	RefIDs []string
+
+	// The rendered (HTML) footnote text.
+	Text hstring.RenderedString
+}
+
+type FootnoteRenderer interface {
+	RenderFootnote(cctx context.Context, w io.Writer, ctx FootnoteContext) error
+}
+
 type CodeBlockRenderer interface {
 	RenderCodeblock(cctx context.Context, w hugio.FlexiWriter, ctx CodeblockContext) error
 }
@@ -98,6 +159,66 @@ type IsDefaultCodeBlockRendererProvider interface {
 	IsDefaultCodeBlockRenderer() bool
 }
 
+// BlockquoteContext is the context passed to a blockquote render hook.
+type BlockquoteContext interface {
+	// The Page being rendered.
+	Page() any
+
+	// The blockquote type, either "alert" for GitHub-style alerts,
+	// e.g. > [!NOTE], or "regular".
+	Type() string
+
+	// The lower-case alert type, e.g. "note" or "warning".
+	// Empty for regular blockquotes.
+	AlertType() string
+
+	// The rendered (HTML) blockquote content, excluding any alert marker.
+	Text() hstring.RenderedString
+
+	// Attributes (e.g. CSS classes)
+	AttributesProvider
+}
+
+type BlockquoteRenderer interface {
+	RenderBlockquote(cctx context.Context, w io.Writer, ctx BlockquoteContext) error
+}
+
+// TableContext is the context passed to a table render hook.
+type TableContext interface {
+	// The Page being rendered.
+	Page() any
+
+	// The header rows.
+	THead() []TableRow
+
+	// The body rows.
+	TBody() []TableRow
+
+	// The alignment of each column, one of "left", "center", "right",
+	// or empty if not set.
+	Alignments() []string
+
+	// Attributes (e.g. CSS classes)
+	AttributesProvider
+}
+
+// TableRow is a row of cells passed to a table render hook.
+type TableRow []TableCell
+
+// TableCell is a table cell passed to a table render hook.
+type TableCell struct {
+	// The rendered (HTML) cell content.
+	Text hstring.RenderedString
+
+	// The alignment of the cell's column, one of "left", "center", "right",
+	// or empty if not set.
+	Alignment string
+}
+
+type TableRenderer interface {
+	RenderTable(cctx context.Context, w io.Writer, ctx TableContext) error
+}
+
 // HeadingContext contains accessors to all attributes that a HeadingRenderer
 // can use to render a heading.
 type HeadingContext interface {
@@ -137,6 +258,10 @@ const (
 	ImageRendererType
 	HeadingRendererType
 	CodeBlockRendererType
+	WikilinkRendererType
+	FootnoteRendererType
+	BlockquoteRendererType
+	TableRendererType
 )
 
 type GetRendererFunc func(t RendererType, id any) any
@@ -91,13 +91,15 @@ var _ parser.IDs = (*idFactory)(nil)
 
 type idFactory struct {
 	idType string
+	prefix string
 	vals   map[string]struct{}
 }
 
-func newIDFactory(idType string) *idFactory {
+func newIDFactory(idType, prefix string) *idFactory {
 	return &idFactory{
 		vals:   make(map[string]struct{}),
 		idType: idType,
+		prefix: prefix,
 	}
 }
 
@@ -111,6 +113,13 @@ func (ids *idFactory) Generate(value []byte, kind ast.NodeKind) []byte {
 			}
 		}
 
+		if ids.prefix != "" {
+			id := buf.String()
+			buf.Reset()
+			buf.WriteString(ids.prefix)
+			buf.WriteString(id)
+		}
+
 		if _, found := ids.vals[util.BytesToReadOnlyString(buf.Bytes())]; found {
 			// Append a hyphen and a number, starting with 1.
 			buf.WriteRune('-')
@@ -16,18 +16,23 @@ package goldmark
 
 import (
 	"bytes"
+	"sync"
 
 	"github.com/gohugoio/hugo-goldmark-extensions/passthrough"
 	"github.com/gohugoio/hugo/markup/goldmark/codeblocks"
+	"github.com/gohugoio/hugo/markup/goldmark/emojis"
+	"github.com/gohugoio/hugo/markup/goldmark/footnotes"
 	"github.com/gohugoio/hugo/markup/goldmark/goldmark_config"
 	"github.com/gohugoio/hugo/markup/goldmark/images"
 	"github.com/gohugoio/hugo/markup/goldmark/internal/extensions/attributes"
 	"github.com/gohugoio/hugo/markup/goldmark/internal/render"
+	"github.com/gohugoio/hugo/markup/goldmark/sanitizer"
+	"github.com/gohugoio/hugo/markup/goldmark/tables"
+	"github.com/gohugoio/hugo/markup/goldmark/wikilinks"
 
 	"github.com/gohugoio/hugo/markup/converter"
 	"github.com/gohugoio/hugo/markup/tableofcontents"
 	"github.com/yuin/goldmark"
-	emoji "github.com/yuin/goldmark-emoji"
 	"github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/extension"
 	"github.com/yuin/goldmark/parser"
@@ -46,13 +51,33 @@ var Provider converter.ProviderProvider = provide{}
 type provide struct{}
 
 func (p provide) New(cfg converter.ProviderConfig) (converter.Provider, error) {
-	md := newMarkdown(cfg)
+	mds := &markdowns{
+		cfg: cfg,
+		m:   make(map[markdownOptions]goldmark.Markdown),
+	}
+	rcfg := cfg.MarkupConfig().Goldmark.Renderer
+	defaults := markdownOptions{
+		hardWraps: rcfg.HardWraps,
+		unsafe:    rcfg.Unsafe,
+		sanitize:  rcfg.Sanitizer.Enable,
+	}
+	mds.get(defaults)
 
 	return converter.NewProvider("goldmark", func(ctx converter.DocumentContext) (converter.Converter, error) {
+		opts := defaults
+		if ctx.HardWraps != nil {
+			opts.hardWraps = *ctx.HardWraps
+		}
+		if ctx.Unsafe != nil {
+			opts.unsafe = *ctx.Unsafe
+		}
+		if ctx.Sanitize != nil {
+			opts.sanitize = *ctx.Sanitize
+		}
 		return &goldmarkConverter{
 			ctx: ctx,
 			cfg: cfg,
-			md:  md,
+			md:  mds.get(opts),
 			sanitizeAnchorName: func(s string) string {
 				return sanitizeAnchorNameString(s, cfg.MarkupConfig().Goldmark.Parser.AutoHeadingIDType)
 			},
@@ -74,12 +99,40 @@ func (c *goldmarkConverter) SanitizeAnchorName(s string) string {
 	return c.sanitizeAnchorName(s)
 }
 
-func newMarkdown(pcfg converter.ProviderConfig) goldmark.Markdown {
+// markdownOptions holds the renderer settings that can be overridden per document.
+type markdownOptions struct {
+	hardWraps bool
+	unsafe    bool
+	sanitize  bool
+}
+
+// markdowns caches the Goldmark instances keyed by the renderer settings
+// that can be overridden per document.
+type markdowns struct {
+	cfg converter.ProviderConfig
+
+	mu sync.Mutex
+	m  map[markdownOptions]goldmark.Markdown
+}
+
+func (m *markdowns) get(opts markdownOptions) goldmark.Markdown {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	md, found := m.m[opts]
+	if !found {
+		md = newMarkdown(m.cfg, opts)
+		m.m[opts] = md
+	}
+	return md
+}
+
+func newMarkdown(pcfg converter.ProviderConfig, opts markdownOptions) goldmark.Markdown {
 	mcfg := pcfg.MarkupConfig()
 	cfg := mcfg.Goldmark
 	var rendererOptions []renderer.Option
 
-	if cfg.Renderer.HardWraps {
+	if opts.hardWraps {
 		rendererOptions = append(rendererOptions, html.WithHardWraps())
 	}
 
@@ -87,7 +140,7 @@ func newMarkdown(pcfg converter.ProviderConfig) goldmark.Markdown {
 		rendererOptions = append(rendererOptions, html.WithXHTML())
 	}
 
-	if cfg.Renderer.Unsafe {
+	if opts.unsafe {
 		rendererOptions = append(rendererOptions, html.WithUnsafe())
 	}
 
@@ -106,7 +159,7 @@ func newMarkdown(pcfg converter.ProviderConfig) goldmark.Markdown {
 	}
 
 	if cfg.Extensions.Table {
-		extensions = append(extensions, extension.Table)
+		extensions = append(extensions, tables.New())
 	}
 
 	if cfg.Extensions.Strikethrough {
@@ -133,7 +186,7 @@ func newMarkdown(pcfg converter.ProviderConfig) goldmark.Markdown {
 	}
 
 	if cfg.Extensions.Footnote {
-		extensions = append(extensions, extension.Footnote)
+		extensions = append(extensions, footnotes.New(cfg.Extensions.FootnoteSectionLevel))
 	}
 
 	if cfg.Extensions.CJK.Enable {
@@ -180,8 +233,16 @@ func newMarkdown(pcfg converter.ProviderConfig) goldmark.Markdown {
 		))
 	}
 
+	if !opts.unsafe && opts.sanitize {
+		extensions = append(extensions, sanitizer.New(cfg.Renderer.Sanitizer))
+	}
+
+	if cfg.Extensions.Wikilink.Enable {
+		extensions = append(extensions, wikilinks.New(cfg.Extensions.Wikilink))
+	}
+
 	if pcfg.Conf.EnableEmoji() {
-		extensions = append(extensions, emoji.Emoji)
+		extensions = append(extensions, emojis.New(cfg.Extensions.Emoji))
 	}
 
 	if cfg.Parser.AutoHeadingID {
@@ -291,7 +352,7 @@ func (c *goldmarkConverter) Convert(ctx converter.RenderContext) (converter.Resu
 }
 
 func (c *goldmarkConverter) newParserContext(rctx converter.RenderContext) *parserContext {
-	ctx := parser.NewContext(parser.WithIDs(newIDFactory(c.cfg.MarkupConfig().Goldmark.Parser.AutoHeadingIDType)))
+	ctx := parser.NewContext(parser.WithIDs(newIDFactory(c.cfg.MarkupConfig().Goldmark.Parser.AutoHeadingIDType, rctx.HeadingIDPrefix)))
 	ctx.Set(tocEnableKey, rctx.RenderTOC)
 	return &parserContext{
 		Context: ctx,
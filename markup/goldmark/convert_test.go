@@ -750,3 +750,26 @@ escapedSpace=true
 
 	c.Assert(got, qt.Contains, "<p>私は太郎です。\nプログラミングが好きです。運動が苦手です。</p>\n")
 }
+
+func TestConvertWikilinks(t *testing.T) {
+	c := qt.New(t)
+
+	content := `
+[[Page One]] [[docs/page-two#Some Heading|Page *Two*]] [[a\|b]] [[ ]] [[x[y]]]
+`
+
+	confStr := `
+[markup]
+[markup.goldmark]
+[markup.goldmark.extensions.wikilink]
+enable=true
+`
+
+	cfg := config.FromTOMLConfigString(confStr)
+	conf := testconfig.GetTestConfig(nil, cfg)
+
+	b := convert(c, conf, content)
+	got := string(b.Bytes())
+
+	c.Assert(got, qt.Contains, `<p><a href="Page%20One">Page One</a> <a href="docs/page-two#Some%20Heading">Page *Two*</a> <a href="a">b</a> [[ ]] [[x[y]]]</p>`)
+}
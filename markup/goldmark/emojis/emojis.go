@@ -0,0 +1,102 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package emojis configures the Goldmark emoji extension with custom emoji
+// merged over the built-in set.
+package emojis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/markup/goldmark/goldmark_config"
+	"github.com/yuin/goldmark"
+	emoji "github.com/yuin/goldmark-emoji"
+	east "github.com/yuin/goldmark-emoji/ast"
+	"github.com/yuin/goldmark-emoji/definition"
+	"github.com/yuin/goldmark/util"
+)
+
+// New creates a new emoji extension with the custom emoji in cfg.
+func New(cfg goldmark_config.Emoji) goldmark.Extender {
+	if len(cfg.Custom) == 0 {
+		return emoji.Emoji
+	}
+
+	names := make([]string, 0, len(cfg.Custom))
+	for name := range cfg.Custom {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	r := &emojiRenderer{images: make(map[string]string)}
+	custom := make([]definition.Emoji, 0, len(names))
+	for _, name := range names {
+		v := cfg.Custom[name]
+		name = strings.ToLower(strings.Trim(name, ":"))
+		if isImage(v) {
+			r.images[name] = v
+			// Not a unicode emoji, see definition.Emoji.IsUnicode.
+			custom = append(custom, definition.NewEmoji(name, nil, name))
+		} else {
+			custom = append(custom, definition.NewEmoji(name, []rune(v), name))
+		}
+	}
+
+	// The custom emoji take precedence over the built-in ones.
+	emojis := definition.NewEmojis(custom...)
+	emojis.Add(definition.Github())
+
+	return emoji.New(
+		emoji.WithEmojis(emojis),
+		emoji.WithRenderingMethod(emoji.Func),
+		emoji.WithRendererFunc(r.renderEmoji),
+	)
+}
+
+// isImage reports whether v is the path or URL to an image rather than
+// the emoji itself.
+func isImage(v string) bool {
+	return strings.ContainsAny(v, "/.")
+}
+
+type emojiRenderer struct {
+	images map[string]string
+}
+
+// renderEmoji renders custom image emoji as img elements and all other
+// emoji as HTML entities, which is the default in goldmark-emoji.
+func (r *emojiRenderer) renderEmoji(w util.BufWriter, source []byte, node *east.Emoji, config *emoji.RendererConfig) {
+	if src, found := r.images[string(node.ShortName)]; found {
+		var slash string
+		if config.XHTML {
+			slash = " /"
+		}
+		fmt.Fprintf(w, `<img class="emoji" src="%s" alt=":%s:"%s>`, util.EscapeHTML([]byte(src)), node.ShortName, slash)
+		return
+	}
+
+	// Borrowed from goldmark-emoji.
+	if !node.Value.IsUnicode() {
+		fmt.Fprintf(w, `<span title="%s">:%s:</span>`, util.EscapeHTML(util.StringToReadOnlyBytes(node.Value.Name)), node.ShortName)
+		return
+	}
+	for _, r := range node.Value.Unicode {
+		if r == 0x200D {
+			_, _ = w.WriteString("&zwj;")
+			continue
+		}
+		fmt.Fprintf(w, "&#x%x;", r)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emojis_test
+
+import (
+	"testing"
+
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestCustomEmoji(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+enableEmoji = true
+[markup.goldmark.extensions.emoji.custom]
+shipit = "/images/emoji/shipit.png"
+party = "🎉"
+smile = "🙂"
+-- content/p1.md --
+---
+title: "p1"
+---
+Ship :shipit: and :party: with :smile: and :heart:, but not :nosuchemoji:.
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html",
+		`<p>Ship <img class="emoji" src="/images/emoji/shipit.png" alt=":shipit:"> and &#x1f389; with &#x1f642; and &#x2764;&#xfe0f;, but not :nosuchemoji:.</p>`,
+	)
+}
+
+func TestCustomEmojiDisabled(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+[markup.goldmark.extensions.emoji.custom]
+shipit = "/images/emoji/shipit.png"
+-- content/p1.md --
+---
+title: "p1"
+---
+Ship :shipit: and :smile:.
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html", "<p>Ship :shipit: and :smile:.</p>")
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package footnotes_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+const footnotesFilesTemplate = `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+[markup.goldmark.extensions]
+footnoteSectionLevel = SECTION_LEVEL
+-- content/p1.md --
+---
+title: "p1"
+---
+Intro[^a].
+
+## Tab 1
+
+First[^b] and again[^b].
+
+### Sub
+
+Sub[^c].
+
+## Tab 2
+
+Second[^d].
+
+[^a]: Note a.
+[^b]: Note *b*.
+[^c]: Note c.
+[^d]: Note d.
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+func TestFootnotesPerSection(t *testing.T) {
+	t.Parallel()
+
+	files := strings.ReplaceAll(footnotesFilesTemplate, "SECTION_LEVEL", "2")
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html",
+		`<p>Intro<sup id="fnref:1"><a href="#fn:1" class="footnote-ref" role="doc-noteref">1</a></sup>.</p>
+<div class="footnotes" role="doc-endnotes">`,
+		`First<sup id="s1-fnref:1"><a href="#s1-fn:1"`,
+		`again<sup id="s1-fnref1:1"><a href="#s1-fn:1"`,
+		`Sub<sup id="s1-fnref:2"><a href="#s1-fn:2" class="footnote-ref" role="doc-noteref">2</a></sup>`,
+		`<li id="s1-fn:2">`,
+		`<a href="#s1-fnref:2" class="footnote-backref" role="doc-backlink">`,
+		`Second<sup id="s2-fnref:1"><a href="#s2-fn:1" class="footnote-ref" role="doc-noteref">1</a></sup>.</p>
+<div class="footnotes" role="doc-endnotes">`,
+		`<li id="s2-fn:1">
+<p>Note d.`,
+	)
+	b.AssertFileContent("public/p1/index.html", "! <li id=\"fn:2\"")
+
+	files = strings.ReplaceAll(footnotesFilesTemplate, "SECTION_LEVEL", "0")
+	b = hugolib.Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html",
+		`Second<sup id="fnref:4"><a href="#fn:4"`,
+		`<li id="fn:4">`,
+	)
+}
+
+func TestFootnoteRenderHook(t *testing.T) {
+	t.Parallel()
+
+	files := strings.ReplaceAll(footnotesFilesTemplate, "SECTION_LEVEL", "2")
+	files += `
+-- layouts/_default/_markup/render-footnote.html --
+<aside class="sidenotes" data-ordinal="{{ .Ordinal }}">
+{{- range .Footnotes }}
+<p id="{{ .ID }}">{{ .Index }}: {{ .Text | safeHTML }}|{{ delimit .RefIDs "," }}</p>
+{{- end }}
+</aside>
+`
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html",
+		`<aside class="sidenotes" data-ordinal="0">
+<p id="fn:1">1: <p>Note a.</p>`,
+		`<aside class="sidenotes" data-ordinal="1">
+<p id="s1-fn:1">1: <p>Note <em>b</em>.</p>`,
+		`|s1-fnref:1,s1-fnref1:1</p>`,
+		`<p id="s1-fn:2">2: <p>Note c.</p>`,
+		`<aside class="sidenotes" data-ordinal="2">`,
+		"! footnote-backref",
+		"! doc-endnotes",
+	)
+}
@@ -0,0 +1,214 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package footnotes adds a render hook for footnotes and support for
+// numbering footnotes per section to Goldmark's footnote extension.
+package footnotes
+
+import (
+	"fmt"
+
+	"github.com/gohugoio/hugo/common/types/hstring"
+	"github.com/gohugoio/hugo/markup/converter/hooks"
+	"github.com/gohugoio/hugo/markup/goldmark/internal/render"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+type (
+	footnotesExtension struct {
+		sectionLevel int
+	}
+	htmlRenderer struct {
+		// The renderer we delegate to when there is no footnote render hook.
+		defaultRenderer renderer.NodeRenderer
+		defaults        map[ast.NodeKind]renderer.NodeRendererFunc
+	}
+)
+
+// New creates a new footnote extension. If sectionLevel is greater than zero,
+// footnotes are numbered per section, starting at each top-level heading
+// with a level less than or equal to sectionLevel.
+func New(sectionLevel int) goldmark.Extender {
+	return &footnotesExtension{sectionLevel: sectionLevel}
+}
+
+func (e *footnotesExtension) Extend(m goldmark.Markdown) {
+	opts := []extension.FootnoteOption{extension.WithFootnoteIDPrefixFunction(idPrefix)}
+	extension.NewFootnote(opts...).Extend(m)
+
+	if e.sectionLevel > 0 {
+		m.Parser().AddOptions(
+			parser.WithASTTransformers(
+				// After Goldmark's footnote transformer.
+				util.Prioritized(&sectionTransformer{level: e.sectionLevel}, 1000),
+			),
+		)
+	}
+
+	r := &htmlRenderer{
+		defaultRenderer: extension.NewFootnoteHTMLRenderer(opts...),
+		defaults:        make(map[ast.NodeKind]renderer.NodeRendererFunc),
+	}
+	r.defaultRenderer.RegisterFuncs(r)
+
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(r, 100),
+	))
+}
+
+// Register implements renderer.NodeRendererFuncRegisterer, used to collect
+// the default render funcs.
+func (r *htmlRenderer) Register(kind ast.NodeKind, fn renderer.NodeRendererFunc) {
+	r.defaults[kind] = fn
+}
+
+// SetOption implements renderer.SetOptioner.
+func (r *htmlRenderer) SetOption(name renderer.OptionName, value any) {
+	if so, ok := r.defaultRenderer.(renderer.SetOptioner); ok {
+		so.SetOption(name, value)
+	}
+}
+
+func (r *htmlRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	for kind, fn := range r.defaults {
+		reg.Register(kind, fn)
+	}
+	reg.Register(east.KindFootnoteList, r.renderFootnoteList)
+	reg.Register(east.KindFootnote, r.renderFootnote)
+	reg.Register(east.KindFootnoteBacklink, r.renderFootnoteBacklink)
+}
+
+func (r *htmlRenderer) getRenderer(w util.BufWriter) (*render.Context, hooks.FootnoteRenderer) {
+	ctx, ok := w.(*render.Context)
+	if !ok {
+		return nil, nil
+	}
+	h := ctx.RenderContext().GetRenderer(hooks.FootnoteRendererType, nil)
+	if h == nil {
+		return nil, nil
+	}
+	return ctx, h.(hooks.FootnoteRenderer)
+}
+
+func (r *htmlRenderer) renderFootnoteList(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	ctx, fr := r.getRenderer(w)
+	if fr == nil {
+		return r.defaults[east.KindFootnoteList](w, source, node, entering)
+	}
+
+	if entering {
+		return ast.WalkContinue, nil
+	}
+
+	var footnotes []hooks.Footnote
+	for c := node.FirstChild(); c != nil; c = c.NextSibling() {
+		fn, ok := c.(*east.Footnote)
+		if !ok {
+			continue
+		}
+		prefix := string(idPrefix(fn))
+		f := hooks.Footnote{
+			Index: fn.Index,
+			ID:    fmt.Sprintf("%sfn:%d", prefix, fn.Index),
+		}
+		if v, ok := fn.AttributeString(attrText); ok {
+			f.Text = hstring.RenderedString(v.([]byte))
+		}
+		_ = ast.Walk(fn, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+			if bl, ok := n.(*east.FootnoteBacklink); ok && entering {
+				f.RefIDs = append(f.RefIDs, refID(prefix, bl.RefIndex, bl.Index))
+			}
+			return ast.WalkContinue, nil
+		})
+		footnotes = append(footnotes, f)
+	}
+
+	var ordinal int
+	if v, ok := node.AttributeString(AttrOrdinal); ok {
+		ordinal = v.(int)
+	}
+
+	err := fr.RenderFootnote(
+		ctx.RenderContext().Ctx,
+		w,
+		footnoteContext{
+			page:      ctx.DocumentContext().Document,
+			ordinal:   ordinal,
+			footnotes: footnotes,
+		},
+	)
+
+	return ast.WalkContinue, err
+}
+
+func (r *htmlRenderer) renderFootnote(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	ctx, fr := r.getRenderer(w)
+	if fr == nil {
+		return r.defaults[east.KindFootnote](w, source, node, entering)
+	}
+
+	if entering {
+		// Store the current pos so we can capture the rendered text.
+		ctx.PushPos(ctx.Buffer.Len())
+		return ast.WalkContinue, nil
+	}
+
+	pos := ctx.PopPos()
+	text := make([]byte, ctx.Buffer.Len()-pos)
+	copy(text, ctx.Buffer.Bytes()[pos:])
+	ctx.Buffer.Truncate(pos)
+	node.SetAttributeString(attrText, text)
+
+	return ast.WalkContinue, nil
+}
+
+func (r *htmlRenderer) renderFootnoteBacklink(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if _, fr := r.getRenderer(w); fr == nil {
+		return r.defaults[east.KindFootnoteBacklink](w, source, node, entering)
+	}
+	// The hook gets the reference ids and renders any backlinks itself.
+	return ast.WalkContinue, nil
+}
+
+// refID returns the HTML id of a footnote reference, matching the ids
+// rendered by Goldmark.
+func refID(prefix string, refIndex, index int) string {
+	if refIndex > 0 {
+		return fmt.Sprintf("%sfnref%d:%d", prefix, refIndex, index)
+	}
+	return fmt.Sprintf("%sfnref:%d", prefix, index)
+}
+
+type footnoteContext struct {
+	page      any
+	ordinal   int
+	footnotes []hooks.Footnote
+}
+
+func (ctx footnoteContext) Page() any {
+	return ctx.page
+}
+
+func (ctx footnoteContext) Ordinal() int {
+	return ctx.ordinal
+}
+
+func (ctx footnoteContext) Footnotes() []hooks.Footnote {
+	return ctx.footnotes
+}
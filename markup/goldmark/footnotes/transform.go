@@ -0,0 +1,173 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package footnotes
+
+import (
+	"fmt"
+
+	"github.com/yuin/goldmark/ast"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+const (
+	// Don't change these; the prefix must match the internalAttrPrefix in the root goldmark package.
+
+	// The id prefix of the section a footnote node belongs to.
+	AttrIDPrefix = "_h__idPrefix"
+	// The ordinal of a footnote list.
+	AttrOrdinal = "_h__ordinal"
+	// The rendered footnote text, used by the footnote render hook.
+	attrText = "_h__text"
+)
+
+// sectionTransformer splits the footnote list created by Goldmark into one
+// list per section and renumbers the footnotes within each section.
+type sectionTransformer struct {
+	level int
+}
+
+type footnoteSection struct {
+	// The last top level node in this section.
+	last      ast.Node
+	footnotes []*east.Footnote
+}
+
+// Transform transforms the provided Markdown AST.
+func (t *sectionTransformer) Transform(doc *ast.Document, reader text.Reader, pctx parser.Context) {
+	list, ok := doc.LastChild().(*east.FootnoteList)
+	if !ok {
+		return
+	}
+
+	defs := make(map[int]*east.Footnote)
+	for c := list.FirstChild(); c != nil; c = c.NextSibling() {
+		if fn, ok := c.(*east.Footnote); ok {
+			defs[fn.Index] = fn
+		}
+	}
+
+	// Maps the original footnote index to its section and its index within
+	// that section.
+	type placement struct{ section, index int }
+	placements := make(map[int]placement)
+
+	var sections []*footnoteSection
+	sec := &footnoteSection{}
+	sections = append(sections, sec)
+
+	place := func(n *east.FootnoteLink, section int) {
+		p, found := placements[n.Index]
+		if !found {
+			fn, ok := defs[n.Index]
+			if !ok {
+				return
+			}
+			s := sections[section]
+			s.footnotes = append(s.footnotes, fn)
+			p = placement{section: section, index: len(s.footnotes)}
+			placements[n.Index] = p
+		}
+		n.Index = p.index
+		n.SetAttributeString(AttrIDPrefix, sectionIDPrefix(p.section))
+	}
+
+	for c := doc.FirstChild(); c != nil; c = c.NextSibling() {
+		if c == list {
+			break
+		}
+		if h, ok := c.(*ast.Heading); ok && h.Level <= t.level {
+			sec = &footnoteSection{}
+			sections = append(sections, sec)
+		}
+		sec.last = c
+		section := len(sections) - 1
+		ast.Walk(c, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+			if fl, ok := n.(*east.FootnoteLink); ok && entering {
+				place(fl, section)
+			}
+			return ast.WalkContinue, nil
+		})
+	}
+
+	// References inside footnote definitions. A footnote only referenced
+	// from another footnote belongs to the section of the referencing one.
+	for c := list.FirstChild(); c != nil; c = c.NextSibling() {
+		fn, ok := c.(*east.Footnote)
+		if !ok {
+			continue
+		}
+		p, found := placements[fn.Index]
+		if !found {
+			continue
+		}
+		ast.Walk(fn, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+			if fl, ok := n.(*east.FootnoteLink); ok && entering {
+				place(fl, p.section)
+			}
+			return ast.WalkContinue, nil
+		})
+	}
+
+	doc.RemoveChild(doc, list)
+
+	var ordinal int
+	for i, s := range sections {
+		if len(s.footnotes) == 0 {
+			continue
+		}
+		prefix := sectionIDPrefix(i)
+		nl := east.NewFootnoteList()
+		nl.SetAttributeString(AttrOrdinal, ordinal)
+		ordinal++
+		for j, fn := range s.footnotes {
+			fn.Index = j + 1
+			fn.SetAttributeString(AttrIDPrefix, prefix)
+			ast.Walk(fn, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+				if bl, ok := n.(*east.FootnoteBacklink); ok && entering {
+					bl.Index = fn.Index
+					bl.SetAttributeString(AttrIDPrefix, prefix)
+				}
+				return ast.WalkContinue, nil
+			})
+			nl.AppendChild(nl, fn)
+		}
+		nl.Count = len(s.footnotes)
+		if s.last == nil {
+			doc.AppendChild(doc, nl)
+		} else {
+			doc.InsertAfter(doc, s.last, nl)
+		}
+	}
+}
+
+// sectionIDPrefix returns the id prefix for the footnotes in section i.
+// Footnotes before the first section heading keep Goldmark's ids.
+func sectionIDPrefix(i int) []byte {
+	if i == 0 {
+		return nil
+	}
+	return []byte(fmt.Sprintf("s%d-", i))
+}
+
+// idPrefix returns the id prefix set on n by the section transformer, if any.
+func idPrefix(n ast.Node) []byte {
+	if v, ok := n.AttributeString(AttrIDPrefix); ok {
+		if b, ok := v.([]byte); ok {
+			return b
+		}
+	}
+	return nil
+}
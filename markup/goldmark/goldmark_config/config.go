@@ -56,6 +56,10 @@ var Default = Config{
 				Block:  [][]string{},
 			},
 		},
+		Wikilink: Wikilink{
+			Enable:          false,
+			UnresolvedClass: "wikilink-unresolved",
+		},
 	},
 	Renderer: Renderer{
 		Unsafe: false,
@@ -112,7 +116,15 @@ type Extensions struct {
 	Typographer    Typographer
 	Footnote       bool
 	DefinitionList bool
-	Passthrough    Passthrough
+
+	// When set, footnotes are numbered and listed per section, where a
+	// section starts at each top-level heading with a level less than or
+	// equal to this value. The default, 0, numbers them per page.
+	FootnoteSectionLevel int
+
+	Passthrough Passthrough
+	Wikilink    Wikilink
+	Emoji       Emoji
 
 	// GitHub flavored markdown
 	Table           bool
@@ -160,6 +172,25 @@ type Passthrough struct {
 	Delimiters DelimitersConfig
 }
 
+// Wikilink holds wikilink configuration.
+type Wikilink struct {
+	// Whether to enable the extension.
+	Enable bool
+
+	// The CSS class to use for links that cannot be resolved.
+	UnresolvedClass string
+}
+
+// Emoji holds emoji configuration. Emoji are enabled with the top level
+// enableEmoji setting.
+type Emoji struct {
+	// Custom emoji merged over the built-in set, keyed by the short name
+	// without colons, e.g. "shipit". The value is either the emoji itself,
+	// e.g. "🚢", or the path or URL to an image, which is rendered as an
+	// img element.
+	Custom map[string]string
+}
+
 type DelimitersConfig struct {
 	// The delimiters to use for inline passthroughs. Each entry in the list
 	// is a size-2 list of strings, where the first string is the opening delimiter
@@ -195,6 +226,43 @@ type Renderer struct {
 
 	// Allow raw HTML etc.
 	Unsafe bool
+
+	// Sanitize raw HTML using an allowlist instead of omitting it.
+	// Only used when Unsafe is false.
+	Sanitizer Sanitizer
+
+	// Controls where unsafe = true is allowed in front matter.
+	UnsafeOverride UnsafeOverride
+}
+
+// UnsafeOverride configures which pages may enable unsafe in front matter.
+// Setting unsafe to false or toggling the sanitizer is always allowed.
+type UnsafeOverride struct {
+	// Whether to allow unsafe = true in front matter at all.
+	// If enabled, it's allowed in cascade and in the pages matching Paths.
+	Enable bool
+
+	// Glob patterns matched against the page path, e.g. "/docs/**",
+	// of pages allowed to set unsafe = true in their own front matter.
+	Paths []string
+}
+
+// Sanitizer configures the allowlist used to sanitize raw HTML.
+type Sanitizer struct {
+	// Whether to enable the sanitizer.
+	Enable bool
+
+	// The HTML elements to keep, e.g. ["iframe", "span"].
+	Elements []string
+
+	// The attributes to keep, keyed by element name.
+	// Use "*" as key for attributes allowed on all elements.
+	// Event handler attributes (e.g. onclick) are always removed.
+	Attributes map[string][]string
+
+	// The hosts allowed in absolute URLs in attributes such as href and src.
+	// If empty, all hosts are allowed.
+	Hosts []string
 }
 
 type Parser struct {
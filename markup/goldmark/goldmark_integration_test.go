@@ -744,3 +744,218 @@ a^*=x-b^*
 %!%
 	`)
 }
+
+func TestBlockquoteHook(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+[markup.goldmark.parser.attribute]
+	block = true
+-- content/p1.md --
+---
+title: "p1"
+---
+
+> [!NOTE]
+> Note text.
+
+> [!TIP]
+> Tip text.
+
+> [!IMPORTANT]
+> Important text.
+
+> [!WARNING]
+> Warning text.
+
+> [!CAUTION]
+> Caution text.
+
+> [!caution]
+> Lower case.
+
+> [!NOTE] Not an alert.
+
+> [!NOTE]
+>
+> Second paragraph.
+
+> Regular blockquote.
+{class="foo"}
+
+> [!FOO]
+> Unknown alert.
+-- layouts/_default/_markup/render-blockquote.html --
+Type: {{ .Type }}|AlertType: {{ .AlertType }}|Class: {{ .Attributes.class }}|Text: {{ .Text | safeHTML }}|
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html",
+		"Type: alert|AlertType: note|Class: |Text: <p>Note text.</p>\n|",
+		"Type: alert|AlertType: tip|Class: |Text: <p>Tip text.</p>\n|",
+		"Type: alert|AlertType: important|Class: |Text: <p>Important text.</p>\n|",
+		"Type: alert|AlertType: warning|Class: |Text: <p>Warning text.</p>\n|",
+		"Type: alert|AlertType: caution|Class: |Text: <p>Caution text.</p>\n|",
+		"Type: alert|AlertType: caution|Class: |Text: <p>Lower case.</p>\n|",
+		"Type: regular|AlertType: |Class: |Text: <p>[!NOTE] Not an alert.</p>\n|",
+		"Type: alert|AlertType: note|Class: |Text: <p>Second paragraph.</p>\n|",
+		"Type: regular|AlertType: |Class: foo|Text: <p>Regular blockquote.</p>\n|",
+		"Type: regular|AlertType: |Class: |Text: <p>[!FOO]\nUnknown alert.</p>\n|",
+	)
+}
+
+func TestBlockquoteDefaultRenderer(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- content/p1.md --
+---
+title: "p1"
+---
+
+> [!NOTE]
+> Note text.
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html", "<blockquote>\n<p>[!NOTE]\nNote text.</p>\n</blockquote>")
+}
+
+func TestHeadingIDPrefix(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+-- content/s/_index.md --
+---
+title: "s"
+cascade:
+  headingIDPrefix: "s-"
+---
+-- content/s/p2.md --
+---
+title: "p2"
+---
+## Heading
+-- content/p1.md --
+---
+title: "p1"
+headingIDPrefix: "p1-"
+---
+## Heading
+
+## Heading
+
+## Custom {#custom}
+-- content/p3.md --
+---
+title: "p3"
+---
+## Heading
+-- layouts/_default/single.html --
+Content: {{ .Content }}|
+TOC: {{ .TableOfContents }}|
+Fragments: {{ .Fragments.Identifiers }}|
+RenderString: {{ .RenderString (dict "display" "block") "## Heading" }}|
+RenderString opts: {{ .RenderString (dict "display" "block" "headingIDPrefix" "rs-") "## Heading" }}|
+-- layouts/_default/list.html --
+{{ .Title }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html",
+		`<h2 id="p1-heading">Heading</h2>`,
+		`<h2 id="p1-heading-1">Heading</h2>`,
+		`<h2 id="custom">Custom</h2>`,
+		`<li><a href="#p1-heading">Heading</a></li>`,
+		`<li><a href="#p1-heading-1">Heading</a></li>`,
+		"Fragments: [custom p1-heading p1-heading-1]|",
+		`RenderString: <h2 id="p1-heading">Heading</h2>`,
+		`RenderString opts: <h2 id="rs-heading">Heading</h2>`,
+	)
+	b.AssertFileContent("public/s/p2/index.html",
+		`<h2 id="s-heading">Heading</h2>`,
+		`<li><a href="#s-heading">Heading</a></li>`,
+	)
+	b.AssertFileContent("public/p3/index.html",
+		`<h2 id="heading">Heading</h2>`,
+		"Fragments: [heading]|",
+		`RenderString opts: <h2 id="rs-heading">Heading</h2>`,
+	)
+}
+
+func TestHardWrapsFrontMatter(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "home"]
+defaultContentLanguage = "en"
+[languages.en]
+weight = 1
+[languages.de]
+weight = 2
+[languages.de.markup.goldmark.renderer]
+hardWraps = true
+-- content/changelog.md --
+---
+title: "Changelog"
+hardWraps: true
+---
+Line 1
+Line 2
+-- content/prose.md --
+---
+title: "Prose"
+---
+Line 1
+Line 2
+-- content/changelog.de.md --
+---
+title: "Changelog"
+---
+Line 1
+Line 2
+-- content/prose.de.md --
+---
+title: "Prose"
+hardWraps: false
+---
+Line 1
+Line 2
+-- content/notes/_index.md --
+---
+title: "Notes"
+cascade:
+  hardWraps: true
+---
+-- content/notes/n1.md --
+---
+title: "N1"
+---
+Line 1
+Line 2
+-- layouts/_default/list.html --
+List.
+-- layouts/_default/single.html --
+Content: {{ .Content }}|
+RenderString: {{ "A\nB" | .RenderString }}|
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/changelog/index.html", "<p>Line 1<br>\nLine 2</p>", "RenderString: A<br>\nB|")
+	b.AssertFileContent("public/prose/index.html", "<p>Line 1\nLine 2</p>", "RenderString: A\nB|")
+	b.AssertFileContent("public/de/changelog/index.html", "<p>Line 1<br>\nLine 2</p>")
+	b.AssertFileContent("public/de/prose/index.html", "<p>Line 1\nLine 2</p>")
+	b.AssertFileContent("public/notes/n1/index.html", "<p>Line 1<br>\nLine 2</p>")
+}
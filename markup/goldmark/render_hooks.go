@@ -15,6 +15,7 @@ package goldmark
 
 import (
 	"bytes"
+	"regexp"
 	"strings"
 
 	"github.com/gohugoio/hugo/common/types/hstring"
@@ -119,6 +120,30 @@ func (ctx headingContext) PlainText() string {
 	return ctx.plainText
 }
 
+type blockquoteContext struct {
+	page      any
+	typ       string
+	alertType string
+	text      hstring.RenderedString
+	*attributes.AttributesHolder
+}
+
+func (ctx blockquoteContext) Page() any {
+	return ctx.page
+}
+
+func (ctx blockquoteContext) Type() string {
+	return ctx.typ
+}
+
+func (ctx blockquoteContext) AlertType() string {
+	return ctx.alertType
+}
+
+func (ctx blockquoteContext) Text() hstring.RenderedString {
+	return ctx.text
+}
+
 type hookedRenderer struct {
 	linkifyProtocol []byte
 	html.Config
@@ -134,6 +159,7 @@ func (r *hookedRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer)
 	reg.Register(ast.KindAutoLink, r.renderAutoLink)
 	reg.Register(ast.KindImage, r.renderImage)
 	reg.Register(ast.KindHeading, r.renderHeading)
+	reg.Register(ast.KindBlockquote, r.renderBlockquote)
 }
 
 func (r *hookedRenderer) renderImage(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
@@ -451,6 +477,81 @@ func (r *hookedRenderer) renderHeadingDefault(w util.BufWriter, source []byte, n
 	return ast.WalkContinue, nil
 }
 
+// The GitHub alert marker, e.g. [!NOTE], as the start of the first paragraph
+// of a rendered blockquote.
+var blockquoteAlertRe = regexp.MustCompile(`^<p>\[!(?i)(note|tip|important|warning|caution)\][ \t]*(?:\n|</p>\n?)`)
+
+func (r *hookedRenderer) renderBlockquote(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*ast.Blockquote)
+	var br hooks.BlockquoteRenderer
+
+	ctx, ok := w.(*render.Context)
+	if ok {
+		h := ctx.RenderContext().GetRenderer(hooks.BlockquoteRendererType, nil)
+		ok = h != nil
+		if ok {
+			br = h.(hooks.BlockquoteRenderer)
+		}
+	}
+
+	if !ok {
+		return r.renderBlockquoteDefault(w, source, node, entering)
+	}
+
+	if entering {
+		// Store the current pos so we can capture the rendered text.
+		ctx.PushPos(ctx.Buffer.Len())
+		return ast.WalkContinue, nil
+	}
+
+	pos := ctx.PopPos()
+	text := ctx.Buffer.Bytes()[pos:]
+	ctx.Buffer.Truncate(pos)
+
+	typ := "regular"
+	var alertType string
+	if m := blockquoteAlertRe.FindSubmatchIndex(text); m != nil {
+		typ = "alert"
+		alertType = strings.ToLower(string(text[m[2]:m[3]]))
+		if bytes.HasSuffix(text[:m[1]], []byte("</p>")) || bytes.HasSuffix(text[:m[1]], []byte("</p>\n")) {
+			// The marker was alone in the first paragraph.
+			text = text[m[1]:]
+		} else {
+			text = append([]byte("<p>"), text[m[1]:]...)
+		}
+	}
+
+	err := br.RenderBlockquote(
+		ctx.RenderContext().Ctx,
+		w,
+		blockquoteContext{
+			page:             ctx.DocumentContext().Document,
+			typ:              typ,
+			alertType:        alertType,
+			text:             hstring.RenderedString(text),
+			AttributesHolder: attributes.New(n.Attributes(), attributes.AttributesOwnerGeneral),
+		},
+	)
+
+	return ast.WalkContinue, err
+}
+
+// Borrowed from Goldmark.
+func (r *hookedRenderer) renderBlockquoteDefault(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		if node.Attributes() != nil {
+			_, _ = w.WriteString("<blockquote")
+			html.RenderAttributes(w, node, html.BlockquoteAttributeFilter)
+			_ = w.WriteByte('>')
+		} else {
+			_, _ = w.WriteString("<blockquote>\n")
+		}
+	} else {
+		_, _ = w.WriteString("</blockquote>\n")
+	}
+	return ast.WalkContinue, nil
+}
+
 type links struct {
 	cfg goldmark_config.Config
 }
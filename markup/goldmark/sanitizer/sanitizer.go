@@ -0,0 +1,236 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sanitizer renders raw HTML in Markdown filtered through an
+// allowlist of elements, attributes and URL hosts.
+package sanitizer
+
+import (
+	"bytes"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/gohugoio/hugo/markup/goldmark/goldmark_config"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+	"golang.org/x/net/html"
+)
+
+// New creates a new extension that sanitizes raw HTML using the given policy.
+func New(cfg goldmark_config.Sanitizer) goldmark.Extender {
+	return &sanitizerExtension{policy: NewPolicy(cfg)}
+}
+
+type sanitizerExtension struct {
+	policy *Policy
+}
+
+func (e *sanitizerExtension) Extend(m goldmark.Markdown) {
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		// Higher priority than the default HTML renderer.
+		util.Prioritized(&htmlRenderer{policy: e.policy}, 100),
+	))
+}
+
+type htmlRenderer struct {
+	policy *Policy
+}
+
+func (r *htmlRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindHTMLBlock, r.renderHTMLBlock)
+	reg.Register(ast.KindRawHTML, r.renderRawHTML)
+}
+
+func (r *htmlRenderer) renderHTMLBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	n := node.(*ast.HTMLBlock)
+
+	// Sanitize the block as a whole so e.g. the content of a
+	// script element spanning multiple lines is removed.
+	var buf bytes.Buffer
+	l := n.Lines().Len()
+	for i := 0; i < l; i++ {
+		line := n.Lines().At(i)
+		buf.Write(line.Value(source))
+	}
+	if n.HasClosure() {
+		buf.Write(n.ClosureLine.Value(source))
+	}
+
+	r.policy.Sanitize(w, buf.Bytes())
+
+	return ast.WalkContinue, nil
+}
+
+func (r *htmlRenderer) renderRawHTML(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkSkipChildren, nil
+	}
+	n := node.(*ast.RawHTML)
+
+	var buf bytes.Buffer
+	l := n.Segments.Len()
+	for i := 0; i < l; i++ {
+		segment := n.Segments.At(i)
+		buf.Write(segment.Value(source))
+	}
+
+	r.policy.Sanitize(w, buf.Bytes())
+
+	return ast.WalkSkipChildren, nil
+}
+
+// The attributes holding a URL.
+var urlAttributes = map[string]bool{
+	"action":     true,
+	"background": true,
+	"cite":       true,
+	"formaction": true,
+	"href":       true,
+	"longdesc":   true,
+	"poster":     true,
+	"src":        true,
+}
+
+// The elements whose content is always removed unless the element itself
+// is allowed.
+var rawTextElements = map[string]bool{
+	"noscript": true,
+	"script":   true,
+	"style":    true,
+	"template": true,
+	"textarea": true,
+	"title":    true,
+	"xmp":      true,
+}
+
+// The URL schemes allowed in URL attributes.
+var allowedSchemes = map[string]bool{
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+}
+
+// Policy is a compiled sanitizer configuration.
+type Policy struct {
+	elements   map[string]bool
+	attributes map[string]map[string]bool
+	hosts      map[string]bool
+}
+
+// NewPolicy creates a new Policy from cfg.
+func NewPolicy(cfg goldmark_config.Sanitizer) *Policy {
+	p := &Policy{
+		elements:   make(map[string]bool),
+		attributes: make(map[string]map[string]bool),
+	}
+	for _, e := range cfg.Elements {
+		p.elements[strings.ToLower(e)] = true
+	}
+	for e, attrs := range cfg.Attributes {
+		e = strings.ToLower(e)
+		if p.attributes[e] == nil {
+			p.attributes[e] = make(map[string]bool)
+		}
+		for _, a := range attrs {
+			p.attributes[e][strings.ToLower(a)] = true
+		}
+	}
+	if len(cfg.Hosts) > 0 {
+		p.hosts = make(map[string]bool)
+		for _, h := range cfg.Hosts {
+			p.hosts[strings.ToLower(h)] = true
+		}
+	}
+	return p
+}
+
+// Sanitize writes the sanitized version of the HTML fragment in src to w.
+// Elements not in the allowlist are removed, but their text content is kept,
+// except for elements such as script and style where the content is removed
+// as well. Attributes not in the allowlist, event handler attributes and URL
+// attributes with a disallowed scheme or host are removed. Comments are
+// always removed.
+func (p *Policy) Sanitize(w io.Writer, src []byte) {
+	z := html.NewTokenizer(bytes.NewReader(src))
+	var skip string
+
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			return
+		}
+		tok := z.Token()
+
+		if skip != "" {
+			if tt == html.EndTagToken && tok.Data == skip {
+				skip = ""
+			}
+			continue
+		}
+
+		switch tt {
+		case html.TextToken:
+			io.WriteString(w, html.EscapeString(tok.Data))
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if !p.elements[tok.Data] {
+				if tt == html.StartTagToken && rawTextElements[tok.Data] {
+					skip = tok.Data
+				}
+				continue
+			}
+			tok.Attr = p.filterAttributes(tok.Data, tok.Attr)
+			io.WriteString(w, tok.String())
+		case html.EndTagToken:
+			if p.elements[tok.Data] {
+				io.WriteString(w, tok.String())
+			}
+		}
+	}
+}
+
+func (p *Policy) filterAttributes(element string, attrs []html.Attribute) []html.Attribute {
+	var filtered []html.Attribute
+	for _, attr := range attrs {
+		if attr.Namespace != "" || strings.HasPrefix(attr.Key, "on") {
+			continue
+		}
+		if !p.attributes[element][attr.Key] && !p.attributes["*"][attr.Key] {
+			continue
+		}
+		if urlAttributes[attr.Key] && !p.isAllowedURL(attr.Val) {
+			continue
+		}
+		filtered = append(filtered, attr)
+	}
+	return filtered
+}
+
+func (p *Policy) isAllowedURL(s string) bool {
+	u, err := url.Parse(strings.TrimSpace(s))
+	if err != nil {
+		return false
+	}
+	if u.Scheme != "" && !allowedSchemes[strings.ToLower(u.Scheme)] {
+		return false
+	}
+	if u.Host == "" || p.hosts == nil {
+		return true
+	}
+	return p.hosts[strings.ToLower(u.Hostname())]
+}
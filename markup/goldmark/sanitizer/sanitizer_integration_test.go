@@ -0,0 +1,226 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sanitizer_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestSanitizer(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "home"]
+[markup.goldmark.renderer]
+unsafe = UNSAFE
+[markup.goldmark.renderer.sanitizer]
+enable = true
+elements = ["iframe", "span"]
+hosts = ["www.youtube.com"]
+[markup.goldmark.renderer.sanitizer.attributes]
+"*" = ["class"]
+iframe = ["src", "width"]
+-- content/p1.md --
+---
+title: "P1"
+---
+Inline: <span class="a" id="b" onclick="alert(1)">span</span> <b>bold</b> <span class="c"><!-- comment -->x</span>|
+
+<iframe src="https://www.youtube.com/embed/abc" width="560" title="t"></iframe>
+
+<iframe src="https://example.com/embed/abc"></iframe>
+
+<div class="d">
+<script>
+alert("script");
+</script>
+<a href="javascript:alert(1)">text</a>
+<span class="e" style="color: red">&lt;escaped&gt;</span>
+</div>
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	b := hugolib.Test(t, strings.ReplaceAll(files, "UNSAFE", "false"))
+
+	b.AssertFileContent("public/p1/index.html",
+		`Inline: <span class="a">span</span> bold <span class="c">x</span>|`,
+		`<iframe src="https://www.youtube.com/embed/abc" width="560"></iframe>`,
+		`<iframe></iframe>`,
+		"\n\ntext\n<span class=\"e\">&lt;escaped&gt;</span>\n",
+		"! script",
+		"! <div",
+		"! raw HTML omitted",
+		"! comment",
+	)
+
+	b = hugolib.Test(t, strings.ReplaceAll(files, "UNSAFE", "true"))
+
+	b.AssertFileContent("public/p1/index.html",
+		`<span class="a" id="b" onclick="alert(1)">span</span>`,
+		"<script>",
+	)
+}
+
+func TestSanitizerDisabled(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "home"]
+-- content/p1.md --
+---
+title: "P1"
+---
+Inline: <span class="a">span</span>
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html", "Inline: <!-- raw HTML omitted -->span<!-- raw HTML omitted -->")
+}
+
+func TestSanitizerPerSection(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "home"]
+[markup.goldmark.renderer]
+unsafe = false
+[markup.goldmark.renderer.sanitizer]
+enable = true
+elements = ["span"]
+[markup.goldmark.renderer.unsafeOverride]
+enable = true
+paths = ["/trusted/**"]
+-- content/docs/_index.md --
+---
+title: "Docs"
+cascade:
+  unsafe: true
+---
+-- content/docs/p1.md --
+---
+title: "Docs P1"
+---
+RAW
+-- content/community/_index.md --
+---
+title: "Community"
+cascade:
+  sanitize: true
+---
+-- content/community/p1.md --
+---
+title: "Community P1"
+---
+RAW
+-- content/community/p2.md --
+---
+title: "Community P2"
+sanitize: false
+---
+RAW
+-- content/community/p3.md --
+---
+title: "Community P3"
+unsafe: true
+---
+RAW
+-- content/community/p4.md --
+---
+title: "Community P4"
+cascade:
+  unsafe: true
+---
+RAW
+-- content/trusted/p1.md --
+---
+title: "Trusted P1"
+unsafe: true
+sanitize: true
+---
+RAW
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/_default/list.html --
+{{ .Title }}
+`
+
+	files = strings.ReplaceAll(files, "RAW", `<span class="a">span</span><script>alert(1)</script>`)
+
+	for _, sanitize := range []string{"true", "false"} {
+		b := hugolib.Test(t, strings.Replace(files, "enable = true", "enable = "+sanitize, 1))
+
+		// Trusted section or path: raw HTML rendered as is.
+		b.AssertFileContent("public/docs/p1/index.html", `<span class="a">span</span><script>alert(1)</script>`)
+		b.AssertFileContent("public/trusted/p1/index.html", `<span class="a">span</span><script>alert(1)</script>`)
+
+		// User-contributed section: sanitized.
+		b.AssertFileContent("public/community/p1/index.html", `<span>span</span>alert(1)`, "! <script>", "! raw HTML omitted")
+
+		// Sanitizer disabled for the page: raw HTML omitted.
+		b.AssertFileContent("public/community/p2/index.html", "raw HTML omitted", "! <span", "! <script>")
+
+		// unsafe = true in the page's own front matter or cascade is ignored.
+		for _, filename := range []string{"public/community/p3/index.html", "public/community/p4/index.html"} {
+			b.AssertFileContent(filename, `<span>span</span>alert(1)`, "! <script>")
+		}
+	}
+}
+
+func TestSanitizerUnsafeOverrideDisabled(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "home"]
+[markup.goldmark.renderer.unsafeOverride]
+paths = ["/docs/**"]
+-- content/docs/_index.md --
+---
+title: "Docs"
+cascade:
+  unsafe: true
+---
+-- content/docs/p1.md --
+---
+title: "Docs P1"
+---
+<span>span</span>
+-- content/docs/p2.md --
+---
+title: "Docs P2"
+unsafe: true
+---
+<span>span</span>
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/_default/list.html --
+{{ .Title }}
+`
+
+	b := hugolib.Test(t, files, hugolib.TestOptWarn())
+
+	b.AssertFileContent("public/docs/p1/index.html", "raw HTML omitted", "! <span>")
+	b.AssertFileContent("public/docs/p2/index.html", "raw HTML omitted", "! <span>")
+	b.AssertLogContains("WARN  Ignoring unsafe = true in front matter of \"/content/docs/p2.md\"")
+}
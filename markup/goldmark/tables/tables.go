@@ -0,0 +1,204 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tables adds a render hook for tables to Goldmark's table extension.
+package tables
+
+import (
+	"github.com/gohugoio/hugo/common/types/hstring"
+	"github.com/gohugoio/hugo/markup/converter/hooks"
+	"github.com/gohugoio/hugo/markup/goldmark/internal/render"
+	"github.com/gohugoio/hugo/markup/internal/attributes"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// The rendered cell text, stored on the cell node until the table is rendered.
+const attrText = "_h__text"
+
+type (
+	tableExtension struct{}
+	htmlRenderer   struct {
+		// The renderer we delegate to when there is no table render hook.
+		defaultRenderer renderer.NodeRenderer
+		defaults        map[ast.NodeKind]renderer.NodeRendererFunc
+	}
+)
+
+// New creates a new table extension.
+func New() goldmark.Extender {
+	return &tableExtension{}
+}
+
+func (e *tableExtension) Extend(m goldmark.Markdown) {
+	extension.Table.Extend(m)
+
+	r := &htmlRenderer{
+		defaultRenderer: extension.NewTableHTMLRenderer(),
+		defaults:        make(map[ast.NodeKind]renderer.NodeRendererFunc),
+	}
+	r.defaultRenderer.RegisterFuncs(r)
+
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(r, 100),
+	))
+}
+
+// Register implements renderer.NodeRendererFuncRegisterer, used to collect
+// the default render funcs.
+func (r *htmlRenderer) Register(kind ast.NodeKind, fn renderer.NodeRendererFunc) {
+	r.defaults[kind] = fn
+}
+
+// SetOption implements renderer.SetOptioner.
+func (r *htmlRenderer) SetOption(name renderer.OptionName, value any) {
+	if so, ok := r.defaultRenderer.(renderer.SetOptioner); ok {
+		so.SetOption(name, value)
+	}
+}
+
+func (r *htmlRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(east.KindTable, r.renderTable)
+	reg.Register(east.KindTableHeader, r.renderTableRow)
+	reg.Register(east.KindTableRow, r.renderTableRow)
+	reg.Register(east.KindTableCell, r.renderTableCell)
+}
+
+func (r *htmlRenderer) getRenderer(w util.BufWriter) (*render.Context, hooks.TableRenderer) {
+	ctx, ok := w.(*render.Context)
+	if !ok {
+		return nil, nil
+	}
+	h := ctx.RenderContext().GetRenderer(hooks.TableRendererType, nil)
+	if h == nil {
+		return nil, nil
+	}
+	return ctx, h.(hooks.TableRenderer)
+}
+
+func (r *htmlRenderer) renderTable(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	ctx, tr := r.getRenderer(w)
+	if tr == nil {
+		return r.defaults[east.KindTable](w, source, node, entering)
+	}
+
+	if entering {
+		return ast.WalkContinue, nil
+	}
+
+	n := node.(*east.Table)
+
+	alignments := make([]string, len(n.Alignments))
+	for i, a := range n.Alignments {
+		alignments[i] = alignment(a)
+	}
+
+	var thead, tbody []hooks.TableRow
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		row := make(hooks.TableRow, 0, c.ChildCount())
+		for cc := c.FirstChild(); cc != nil; cc = cc.NextSibling() {
+			cell, ok := cc.(*east.TableCell)
+			if !ok {
+				continue
+			}
+			tc := hooks.TableCell{Alignment: alignment(cell.Alignment)}
+			if v, ok := cell.AttributeString(attrText); ok {
+				tc.Text = hstring.RenderedString(v.([]byte))
+			}
+			row = append(row, tc)
+		}
+		if c.Kind() == east.KindTableHeader {
+			thead = append(thead, row)
+		} else {
+			tbody = append(tbody, row)
+		}
+	}
+
+	err := tr.RenderTable(
+		ctx.RenderContext().Ctx,
+		w,
+		tableContext{
+			page:             ctx.DocumentContext().Document,
+			thead:            thead,
+			tbody:            tbody,
+			alignments:       alignments,
+			AttributesHolder: attributes.New(n.Attributes(), attributes.AttributesOwnerGeneral),
+		},
+	)
+
+	return ast.WalkContinue, err
+}
+
+func (r *htmlRenderer) renderTableRow(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if _, tr := r.getRenderer(w); tr == nil {
+		return r.defaults[node.Kind()](w, source, node, entering)
+	}
+	// The hook renders the rows itself.
+	return ast.WalkContinue, nil
+}
+
+func (r *htmlRenderer) renderTableCell(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	ctx, tr := r.getRenderer(w)
+	if tr == nil {
+		return r.defaults[east.KindTableCell](w, source, node, entering)
+	}
+
+	if entering {
+		// Store the current pos so we can capture the rendered text.
+		ctx.PushPos(ctx.Buffer.Len())
+		return ast.WalkContinue, nil
+	}
+
+	pos := ctx.PopPos()
+	text := make([]byte, ctx.Buffer.Len()-pos)
+	copy(text, ctx.Buffer.Bytes()[pos:])
+	ctx.Buffer.Truncate(pos)
+	node.SetAttributeString(attrText, text)
+
+	return ast.WalkContinue, nil
+}
+
+func alignment(a east.Alignment) string {
+	if a == east.AlignNone {
+		return ""
+	}
+	return a.String()
+}
+
+type tableContext struct {
+	page       any
+	thead      []hooks.TableRow
+	tbody      []hooks.TableRow
+	alignments []string
+	*attributes.AttributesHolder
+}
+
+func (ctx tableContext) Page() any {
+	return ctx.page
+}
+
+func (ctx tableContext) THead() []hooks.TableRow {
+	return ctx.thead
+}
+
+func (ctx tableContext) TBody() []hooks.TableRow {
+	return ctx.tbody
+}
+
+func (ctx tableContext) Alignments() []string {
+	return ctx.alignments
+}
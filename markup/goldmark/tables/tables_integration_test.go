@@ -0,0 +1,101 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tables_test
+
+import (
+	"testing"
+
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestTableHook(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+[markup.goldmark.parser.attribute]
+block = true
+-- content/p1.md --
+---
+title: "p1"
+---
+| Item | In Stock | Price |
+| :--- | :------: | ----: |
+| *Python* Hat | True | 23.99 |
+| SQL Hat | False | 23.99 |
+{.foo}
+
+| A | B |
+| - | - |
+| 1 | 2 |
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/_default/_markup/render-table.html --
+<div class="scroll"><table{{ with .Attributes.class }} class="{{ . }}"{{ end }}>
+Alignments: {{ .Alignments }}|
+{{- range .THead }}<thead><tr>{{ range . }}<th>{{ .Text | safeHTML }}</th>{{ end }}</tr></thead>{{ end }}
+{{- $head := index .THead 0 }}
+{{- range .TBody }}<tr>{{ range $i, $c := . }}<td data-label="{{ (index $head $i).Text | plainify }}"{{ with .Alignment }} style="text-align: {{ . }}"{{ end }}>{{ .Text | safeHTML }}</td>{{ end }}</tr>{{ end }}
+</table></div>
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html",
+		`<div class="scroll"><table class="foo">`,
+		"Alignments: [left center right]|",
+		"<thead><tr><th>Item</th><th>In Stock</th><th>Price</th></tr></thead>",
+		`<tr><td data-label="Item" style="text-align: left"><em>Python</em> Hat</td><td data-label="In Stock" style="text-align: center">True</td><td data-label="Price" style="text-align: right">23.99</td></tr>`,
+		"Alignments: [ ]|",
+		`<tr><td data-label="A">1</td><td data-label="B">2</td></tr>`,
+	)
+}
+
+func TestTableNoHook(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+-- content/p1.md --
+---
+title: "p1"
+---
+| Item | Price |
+| :--- | ----: |
+| Hat | 23.99 |
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html",
+		`<table>
+<thead>
+<tr>
+<th style="text-align:left">Item</th>
+<th style="text-align:right">Price</th>
+</tr>
+</thead>
+<tbody>
+<tr>
+<td style="text-align:left">Hat</td>
+<td style="text-align:right">23.99</td>
+</tr>
+</tbody>
+</table>`,
+	)
+}
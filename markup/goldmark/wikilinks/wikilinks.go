@@ -0,0 +1,239 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wikilinks adds support for [[target#anchor|label]] style links.
+package wikilinks
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gohugoio/hugo/common/types/hstring"
+	"github.com/gohugoio/hugo/markup/converter/hooks"
+	"github.com/gohugoio/hugo/markup/goldmark/goldmark_config"
+	"github.com/gohugoio/hugo/markup/goldmark/internal/render"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// KindWikilink is the kind of a wikilink node.
+var KindWikilink = ast.NewNodeKind("Wikilink")
+
+// Wikilink is a [[target#anchor|label]] link. The label, or the raw link
+// text if no label is set, is added as a child text node.
+type Wikilink struct {
+	ast.BaseInline
+
+	// The link target, e.g. a page title or path. Empty for links to a
+	// heading on the current page.
+	Target []byte
+
+	// The part after '#', if any.
+	Anchor []byte
+}
+
+// Kind implements ast.Node.Kind.
+func (n *Wikilink) Kind() ast.NodeKind {
+	return KindWikilink
+}
+
+// Dump implements ast.Node.Dump.
+func (n *Wikilink) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{
+		"Target": string(n.Target),
+		"Anchor": string(n.Anchor),
+	}, nil)
+}
+
+type (
+	wikilinksExtension struct {
+		cfg goldmark_config.Wikilink
+	}
+	wikilinkParser struct{}
+	htmlRenderer   struct {
+		cfg goldmark_config.Wikilink
+	}
+)
+
+// New creates a new wikilink extension.
+func New(cfg goldmark_config.Wikilink) goldmark.Extender {
+	return &wikilinksExtension{cfg: cfg}
+}
+
+func (e *wikilinksExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithInlineParsers(
+			// Before the link parser.
+			util.Prioritized(&wikilinkParser{}, 199),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(&htmlRenderer{cfg: e.cfg}, 100),
+	))
+}
+
+func (p *wikilinkParser) Trigger() []byte {
+	return []byte{'['}
+}
+
+func (p *wikilinkParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, segment := block.PeekLine()
+	if len(line) < 5 || line[1] != '[' {
+		return nil
+	}
+	end := bytes.Index(line[2:], []byte("]]"))
+	if end < 1 {
+		return nil
+	}
+	inner := line[2 : 2+end]
+	if bytes.ContainsAny(inner, "[]") {
+		return nil
+	}
+
+	dest, labelStart, labelEnd := inner, 0, len(inner)
+	if i := bytes.IndexByte(inner, '|'); i != -1 {
+		dest = inner[:i]
+		// An escaped pipe, as needed in tables.
+		dest = bytes.TrimSuffix(dest, []byte{'\\'})
+		labelStart = i + 1
+	}
+	labelStart, labelEnd = trimSpace(inner, labelStart, labelEnd)
+	if labelStart == labelEnd {
+		return nil
+	}
+
+	target, anchor, _ := bytes.Cut(dest, []byte{'#'})
+	target, anchor = bytes.TrimSpace(target), bytes.TrimSpace(anchor)
+	if len(target) == 0 && len(anchor) == 0 {
+		return nil
+	}
+
+	n := &Wikilink{Target: target, Anchor: anchor}
+	start := segment.Start + 2
+	n.AppendChild(n, ast.NewTextSegment(text.NewSegment(start+labelStart, start+labelEnd)))
+	block.Advance(2 + end + 2)
+
+	return n
+}
+
+func trimSpace(b []byte, start, end int) (int, int) {
+	for start < end && util.IsSpace(b[start]) {
+		start++
+	}
+	for end > start && util.IsSpace(b[end-1]) {
+		end--
+	}
+	return start, end
+}
+
+func (r *htmlRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindWikilink, r.renderWikilink)
+}
+
+func (r *htmlRenderer) renderWikilink(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*Wikilink)
+	var lr hooks.WikilinkRenderer
+
+	ctx, ok := w.(*render.Context)
+	if ok {
+		h := ctx.RenderContext().GetRenderer(hooks.WikilinkRendererType, nil)
+		ok = h != nil
+		if ok {
+			lr = h.(hooks.WikilinkRenderer)
+		}
+	}
+
+	if !ok {
+		return r.renderWikilinkDefault(w, source, node, entering)
+	}
+
+	if entering {
+		// Store the current pos so we can capture the rendered text.
+		ctx.PushPos(ctx.Buffer.Len())
+		return ast.WalkContinue, nil
+	}
+
+	pos := ctx.PopPos()
+	text := ctx.Buffer.Bytes()[pos:]
+	ctx.Buffer.Truncate(pos)
+
+	err := lr.RenderWikilink(
+		ctx.RenderContext().Ctx,
+		w,
+		wikilinkContext{
+			page:            ctx.DocumentContext().Document,
+			target:          string(n.Target),
+			anchor:          string(n.Anchor),
+			text:            hstring.RenderedString(text),
+			plainText:       string(n.Text(source)),
+			unresolvedClass: r.cfg.UnresolvedClass,
+		},
+	)
+
+	return ast.WalkContinue, err
+}
+
+// renderWikilinkDefault renders the link as is, there is no way to resolve
+// the target without a render hook.
+func (r *htmlRenderer) renderWikilinkDefault(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*Wikilink)
+	if entering {
+		dest := n.Target
+		if len(n.Anchor) > 0 {
+			dest = []byte(fmt.Sprintf("%s#%s", n.Target, n.Anchor))
+		}
+		_, _ = w.WriteString(`<a href="`)
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape(dest, true)))
+		_, _ = w.WriteString(`">`)
+	} else {
+		_, _ = w.WriteString("</a>")
+	}
+	return ast.WalkContinue, nil
+}
+
+type wikilinkContext struct {
+	page            any
+	target          string
+	anchor          string
+	text            hstring.RenderedString
+	plainText       string
+	unresolvedClass string
+}
+
+func (ctx wikilinkContext) Page() any {
+	return ctx.page
+}
+
+func (ctx wikilinkContext) Target() string {
+	return ctx.target
+}
+
+func (ctx wikilinkContext) Anchor() string {
+	return ctx.anchor
+}
+
+func (ctx wikilinkContext) Text() hstring.RenderedString {
+	return ctx.text
+}
+
+func (ctx wikilinkContext) PlainText() string {
+	return ctx.plainText
+}
+
+func (ctx wikilinkContext) UnresolvedClass() string {
+	return ctx.unresolvedClass
+}
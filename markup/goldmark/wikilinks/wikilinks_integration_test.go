@@ -0,0 +1,110 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wikilinks_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestWikilinks(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+[markup.goldmark.extensions.wikilink]
+enable = true
+-- content/docs/first-steps.md --
+---
+title: "First Steps"
+---
+## Install Hugo
+-- content/docs/p2.md --
+---
+title: "My Other Page"
+---
+-- content/p1.md --
+---
+title: "P1"
+---
+By path: [[docs/first-steps]]|
+By file name: [[First Steps]]|
+By title: [[My Other Page]]|
+Label: [[First Steps|Start here]]|
+Heading: [[First Steps#Install Hugo]]|
+Current page: [[#My Heading]]|
+Unresolved: [[Does not exist]]|
+Not a wikilink: [[]] [ [x] ]|
+
+| Table |
+| ----- |
+| [[My Other Page\|Other]] |
+
+## My Heading
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/_default/list.html --
+{{ .Content }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/p1/index.html",
+		`By path: <a href="/docs/first-steps/">docs/first-steps</a>|`,
+		`By file name: <a href="/docs/first-steps/">First Steps</a>|`,
+		`By title: <a href="/docs/p2/">My Other Page</a>|`,
+		`Label: <a href="/docs/first-steps/">Start here</a>|`,
+		`Heading: <a href="/docs/first-steps/#install-hugo">First Steps#Install Hugo</a>|`,
+		`Current page: <a href="/p1/#my-heading">#My Heading</a>|`,
+		`Unresolved: <a class="wikilink-unresolved">Does not exist</a>|`,
+		`Not a wikilink: [[]] [ [x] ]|`,
+		`<td><a href="/docs/p2/">Other</a></td>`,
+	)
+}
+
+func TestWikilinksRenderHook(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section"]
+[markup.goldmark.extensions.wikilink]
+enable = ENABLE
+unresolvedClass = "broken"
+-- content/p1.md --
+---
+title: "P1"
+---
+Link: [[Foo Bar#Baz|The *label*]]|
+-- layouts/_default/_markup/render-wikilink.html --
+Target: {{ .Target }}|Anchor: {{ .Anchor }}|Text: {{ .Text }}|PlainText: {{ .PlainText }}|Class: {{ .UnresolvedClass }}|Page: {{ .Page.Title }}
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	b := hugolib.Test(t, strings.ReplaceAll(files, "ENABLE", "true"))
+
+	b.AssertFileContent("public/p1/index.html",
+		"Link: Target: Foo Bar|Anchor: Baz|Text: The *label*|PlainText: The *label*|Class: broken|Page: P1\n|",
+	)
+
+	b = hugolib.Test(t, strings.ReplaceAll(files, "ENABLE", "false"))
+
+	b.AssertFileContent("public/p1/index.html",
+		"Link: [[Foo Bar#Baz|The <em>label</em>]]|",
+	)
+}
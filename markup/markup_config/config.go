@@ -14,8 +14,11 @@
 package markup_config
 
 import (
+	"fmt"
+
 	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/config"
+	hglob "github.com/gohugoio/hugo/hugofs/glob"
 	"github.com/gohugoio/hugo/markup/asciidocext/asciidocext_config"
 	"github.com/gohugoio/hugo/markup/goldmark/goldmark_config"
 	"github.com/gohugoio/hugo/markup/highlight"
@@ -61,6 +64,13 @@ func Decode(cfg config.Provider) (conf Config, err error) {
 		return
 	}
 
+	for _, pattern := range conf.Goldmark.Renderer.UnsafeOverride.Paths {
+		if _, err = hglob.GetGlob(pattern); err != nil {
+			err = fmt.Errorf("failed to compile markup.goldmark.renderer.unsafeOverride.paths pattern %q: %w", pattern, err)
+			return
+		}
+	}
+
 	return
 }
 
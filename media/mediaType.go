@@ -163,6 +163,29 @@ func (m Type) String() string {
 	return m.Type
 }
 
+// MimeSuffix returns the optional suffix after the "+" in the MIME type,
+// e.g. "xml" in "application/rss+xml".
+func (m Type) MimeSuffix() string {
+	return m.mimeSuffix
+}
+
+// Match reports whether m matches the given MIME type pattern, e.g.
+// "image/svg+xml", "image/*" or "image". The match is case insensitive.
+func (m Type) Match(pattern string) bool {
+	if pattern == "" || m.Type == "" {
+		return false
+	}
+	mainType, subType, found := strings.Cut(strings.ToLower(pattern), "/")
+	if mainType != "*" && mainType != strings.ToLower(m.MainType) {
+		return false
+	}
+	if !found || subType == "*" {
+		return true
+	}
+	_, mSubType, _ := strings.Cut(strings.ToLower(m.Type), "/")
+	return subType == mSubType
+}
+
 // Suffixes returns all valid file suffixes for this type.
 func (m Type) Suffixes() []string {
 	if m.SuffixesCSV == "" {
@@ -113,6 +113,36 @@ func TestFromTypeString(t *testing.T) {
 	c.Assert(f, qt.Equals, Type{Type: "text/xml", MainType: "text", SubType: "xml", mimeSuffix: ""})
 }
 
+func TestTypeMatch(t *testing.T) {
+	c := qt.New(t)
+
+	svg := Builtin.SVGType
+	c.Assert(svg.MimeSuffix(), qt.Equals, "xml")
+	c.Assert(Builtin.PNGType.MimeSuffix(), qt.Equals, "")
+
+	for _, test := range []struct {
+		m       Type
+		pattern string
+		expect  bool
+	}{
+		{svg, "image/svg+xml", true},
+		{svg, "IMAGE/SVG+XML", true},
+		{svg, "image/*", true},
+		{svg, "image", true},
+		{svg, "*/*", true},
+		{svg, "*/svg+xml", true},
+		{svg, "image/svg", false},
+		{svg, "image/png", false},
+		{svg, "text/*", false},
+		{svg, "", false},
+		{Builtin.PNGType, "image/png", true},
+		{Builtin.PNGType, "image/*", true},
+		{Type{}, "*/*", false},
+	} {
+		c.Assert(test.m.Match(test.pattern), qt.Equals, test.expect, qt.Commentf("%s %s", test.m, test.pattern))
+	}
+}
+
 func TestFromStringAndExt(t *testing.T) {
 	c := qt.New(t)
 	f, err := FromStringAndExt("text/html", "html")
@@ -14,6 +14,9 @@
 package minifiers
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/gohugoio/hugo/common/maps"
 	"github.com/spf13/cast"
 
@@ -72,16 +75,53 @@ type MinifyConfig struct {
 	DisableXML  bool
 
 	Tdewolff TdewolffConfig
+
+	// Precompress configures pre-compressed variants of the published files.
+	Precompress PrecompressConfig
+}
+
+// PrecompressGzip is the gzip precompress algorithm.
+const PrecompressGzip = "gzip"
+
+// PrecompressConfig configures writing pre-compressed variants, e.g.
+// index.html.gz, next to the published files, to be served by static hosts
+// that support it.
+type PrecompressConfig struct {
+	// The compression algorithms to use. Currently only gzip is supported.
+	// Precompression is disabled if this is empty.
+	Algorithms []string
+
+	// The media types to compress, e.g. text/html. Use e.g. text/* to match
+	// all types with the main type text. Defaults to text/* and the common
+	// text based application types.
+	// Already compressed formats, e.g. PNG images, are always skipped.
+	MediaTypes []string
+
+	// An optional glob pattern matched against the published path, e.g.
+	// "**.{html,css,js}".
+	Glob string
 }
 
 var defaultConfig = MinifyConfig{
 	Tdewolff: defaultTdewolffConfig,
 }
 
+// defaultPrecompressMediaTypes is used when no media types are configured.
+var defaultPrecompressMediaTypes = []string{
+	"text/*",
+	"application/javascript",
+	"application/json",
+	"application/manifest+json",
+	"application/xml",
+	"application/rss+xml",
+	"image/svg+xml",
+}
+
 func DecodeConfig(v any) (conf MinifyConfig, err error) {
 	conf = defaultConfig
 
 	if v == nil {
+		conf.Precompress.MediaTypes = append([]string(nil), defaultPrecompressMediaTypes...)
 		return
 	}
 
@@ -131,5 +171,18 @@ func DecodeConfig(v any) (conf MinifyConfig, err error) {
 		return
 	}
 
+	if conf.Precompress.MediaTypes == nil {
+		conf.Precompress.MediaTypes = append([]string(nil), defaultPrecompressMediaTypes...)
+	}
+
+	for i, a := range conf.Precompress.Algorithms {
+		a = strings.ToLower(a)
+		if a != PrecompressGzip {
+			err = fmt.Errorf("minify.precompress: unsupported algorithm %q, supported: %s", a, PrecompressGzip)
+			return
+		}
+		conf.Precompress.Algorithms[i] = a
+	}
+
 	return
 }
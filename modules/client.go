@@ -181,6 +181,16 @@ func (c *Client) Tidy() error {
 	return c.tidy(tc.AllModules, false)
 }
 
+// VendorOptions configures which modules to write to the _vendor folder.
+type VendorOptions struct {
+	// Glob patterns matching the module paths to vendor.
+	// If not set, all modules are vendored.
+	Only []string
+
+	// Glob patterns matching module paths to skip when vendoring.
+	Exclude []string
+}
+
 // Vendor writes all the module dependencies to a _vendor folder.
 //
 // Unlike Go, we support it for any level.
@@ -194,6 +204,36 @@ func (c *Client) Tidy() error {
 // meaning that if the top-level module is vendored, that will be the full
 // set of dependencies.
 func (c *Client) Vendor() error {
+	return c.VendorWithOptions(VendorOptions{})
+}
+
+// VendorWithOptions writes the module dependencies matching opts to a _vendor folder.
+//
+// Any module not vendored will be resolved the regular way (e.g. Go Modules)
+// when building, so a partial _vendor folder can be mixed with non-vendored
+// modules.
+func (c *Client) VendorWithOptions(opts VendorOptions) error {
+	onlyGlobs, err := compileGlobs(opts.Only)
+	if err != nil {
+		return err
+	}
+	excludeGlobs, err := compileGlobs(opts.Exclude)
+	if err != nil {
+		return err
+	}
+
+	var only, exclude glob.Glob
+	if onlyGlobs != nil {
+		only = hglob.Or(onlyGlobs...)
+		// Check the patterns before we delete any existing _vendor dir.
+		if err := c.checkVendorPatterns(opts.Only, onlyGlobs); err != nil {
+			return err
+		}
+	}
+	if excludeGlobs != nil {
+		exclude = hglob.Or(excludeGlobs...)
+	}
+
 	vendorDir := filepath.Join(c.ccfg.WorkingDir, vendord)
 	if err := c.rmVendorDir(vendorDir); err != nil {
 		return err
@@ -228,6 +268,10 @@ func (c *Client) Vendor() error {
 			continue
 		}
 
+		if (only != nil && !only.Match(t.Path())) || (exclude != nil && exclude.Match(t.Path())) {
+			continue
+		}
+
 		if !t.IsGoMod() && !t.Vendor() {
 			// We currently do not vendor components living in the
 			// theme directory, see https://github.com/gohugoio/hugo/issues/5993
@@ -401,7 +445,8 @@ var verifyErrorDirRe = regexp.MustCompile(`dir has been modified \((.*?)\)`)
 
 // Verify checks that the dependencies of the current module,
 // which are stored in a local downloaded source cache, have not been
-// modified since being downloaded.
+// modified since being downloaded, and that the mounted content matches
+// any modules.lock file.
 func (c *Client) Verify(clean bool) error {
 	// TODO(bep) add path to mod clean
 	err := c.runVerify()
@@ -421,7 +466,15 @@ func (c *Client) Verify(clean bool) error {
 			err = c.runVerify()
 		}
 	}
-	return err
+	if err != nil {
+		return err
+	}
+
+	mc, coll := c.collect(true)
+	if coll.err != nil {
+		return coll.err
+	}
+	return c.verifyLockFile(mc.AllModules, c.ccfg.UpdateLockFile)
 }
 
 func (c *Client) Clean(pattern string) error {
@@ -723,6 +776,43 @@ func (c *Client) shouldVendor(path string) bool {
 	return c.noVendor == nil || !c.noVendor.Match(path)
 }
 
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+	globs := make([]glob.Glob, len(patterns))
+	for i, pattern := range patterns {
+		g, err := hglob.GetGlob(hglob.NormalizePath(pattern))
+		if err != nil {
+			return nil, fmt.Errorf("invalid module path pattern %q: %w", pattern, err)
+		}
+		globs[i] = g
+	}
+	return globs, nil
+}
+
+// checkVendorPatterns returns an error if any of the given patterns does not
+// match any module in the dependency tree.
+func (c *Client) checkVendorPatterns(patterns []string, globs []glob.Glob) error {
+	mc, coll := c.collect(true)
+	if coll.err != nil {
+		return coll.err
+	}
+	for i, g := range globs {
+		var found bool
+		for _, m := range mc.AllModules {
+			if m.Owner() != nil && g.Match(m.Path()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("vendor pattern %q does not match any module", patterns[i])
+		}
+	}
+	return nil
+}
+
 func (c *Client) createThemeDirname(modulePath string, isProjectMod bool) (string, error) {
 	invalid := fmt.Errorf("invalid module path %q; must be relative to themesDir when defined outside of the project", modulePath)
 
@@ -767,6 +857,13 @@ type ClientConfig struct {
 
 	CacheDir     string // Module cache
 	ModuleConfig Config
+
+	// Accept changed content hashes when verifying modules.lock.
+	UpdateLockFile bool
+
+	// Skip verifying modules.lock when collecting the modules.
+	// This is set for the commands needed to recover from a mismatch.
+	SkipLockFile bool
 }
 
 func (c ClientConfig) shouldIgnoreVendor(path string) bool {
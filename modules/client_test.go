@@ -143,6 +143,31 @@ project github.com/gohugoio/hugoTestModules1_darwin/modh2_2_2@v1.3.0+vendor
 		c.Assert(graphb.String(), qt.Equals, expect)
 	})
 
+	c.Run("VendorOnly", func(c *qt.C) {
+		client, clean := newClient(
+			c, func(cfg *ClientConfig) {
+				cfg.ModuleConfig = DefaultModuleConfig
+			}, defaultImport)
+		defer clean()
+
+		c.Assert(client.Init(modPath), qt.IsNil)
+		_, err := client.Collect()
+		c.Assert(err, qt.IsNil)
+		c.Assert(client.VendorWithOptions(VendorOptions{
+			Only:    []string{"github.com/gohugoio/hugoTestModules1_darwin/modh2_2*"},
+			Exclude: []string{"**/modh2_2_2"},
+		}), qt.IsNil)
+
+		expectVendored := `project github.com/gohugoio/hugoTestModules1_darwin/modh2_2@v1.4.0+vendor
+project github.com/gohugoio/hugoTestModules1_darwin/modh2_2_1v@v1.3.0+vendor
+github.com/gohugoio/hugoTestModules1_darwin/modh2_2@v1.4.0+vendor github.com/gohugoio/hugoTestModules1_darwin/modh2_2_2@v1.3.0
+`
+
+		var graphb bytes.Buffer
+		c.Assert(client.Graph(&graphb), qt.IsNil)
+		c.Assert(graphb.String(), qt.Equals, expectVendored)
+	})
+
 	c.Run("VendorClosest", func(c *qt.C) {
 		mcfg := DefaultModuleConfig
 		mcfg.VendorClosest = true
@@ -67,6 +67,12 @@ func (h *Client) Collect() (ModulesConfig, error) {
 		return mc, err
 	}
 
+	if !h.ccfg.SkipLockFile {
+		if err := h.verifyLockFile(mc.AllModules, h.ccfg.UpdateLockFile); err != nil {
+			return mc, err
+		}
+	}
+
 	return mc, nil
 }
 
@@ -165,6 +171,10 @@ type collected struct {
 	// Set if a Go modules enabled project.
 	gomods goModules
 
+	// Set when the Go modules have been loaded. For a vendored project this
+	// is done lazily, when we find a module that is not vendored.
+	gomodsLoaded bool
+
 	// Ordered list of collected modules, including Go Modules and theme
 	// components stored below /themes.
 	modules Modules
@@ -190,7 +200,8 @@ func (c *collector) initModules() error {
 		gomods:   goModules{},
 	}
 
-	// If both these are true, we don't even need Go installed to build.
+	// If both these are true, we don't even need Go installed to build,
+	// unless the project is only partially vendored, see add.
 	if c.ccfg.IgnoreVendor == nil && c.isVendored(c.ccfg.WorkingDir) {
 		return nil
 	}
@@ -246,6 +257,14 @@ func (c *collector) add(owner *moduleAdapter, moduleImport Import) (*moduleAdapt
 	}
 
 	if moduleDir == "" {
+		// Not vendored (or a partial _vendor dir), make sure we have
+		// the Go modules loaded.
+		if !c.gomodsLoaded && c.GoModulesFilename != "" && isProbablyModule(modulePath) {
+			if err := c.loadModules(); err != nil {
+				return nil, err
+			}
+		}
+
 		var versionQuery string
 		mod = c.gomods.GetByPath(modulePath)
 		if mod != nil {
@@ -584,6 +603,7 @@ func (c *collector) loadModules() error {
 		return err
 	}
 	c.gomods = modules
+	c.gomodsLoaded = true
 	return nil
 }
 
@@ -680,6 +700,17 @@ func (c *collector) normalizeMounts(owner *moduleAdapter, mounts []Mount) ([]Mou
 			return nil, fmt.Errorf("%s: mount target must be one of: %v", errMsg, files.ComponentFolders)
 		}
 
+		mnt.Policy = strings.ToLower(mnt.Policy)
+		switch mnt.Policy {
+		case "", MountPolicyMerge:
+		case MountPolicyOverride:
+			if targetBase != files.ComponentFolderContent {
+				return nil, fmt.Errorf("%s: mount policy %q is only supported for content mounts", errMsg, mnt.Policy)
+			}
+		default:
+			return nil, fmt.Errorf("%s: mount policy must be one of: %v", errMsg, []string{MountPolicyMerge, MountPolicyOverride})
+		}
+
 		out = append(out, mnt)
 	}
 
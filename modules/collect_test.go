@@ -14,9 +14,15 @@
 package modules
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/config/security"
+	"github.com/gohugoio/hugo/htesting"
+	"github.com/gohugoio/hugo/hugofs"
 )
 
 func TestPathKey(t *testing.T) {
@@ -49,3 +55,55 @@ func TestFilterUnwantedMounts(t *testing.T) {
 	c.Assert(len(filtered), qt.Equals, 2)
 	c.Assert(filtered, qt.DeepEquals, []Mount{{Source: "a", Target: "b", Lang: "en"}, {Source: "b", Target: "c", Lang: "en"}})
 }
+
+func TestCollectPartiallyVendored(t *testing.T) {
+	c := qt.New(t)
+
+	workingDir, clean, err := htesting.CreateTempDir(hugofs.Os, "hugo-modules-partial-vendor")
+	c.Assert(err, qt.IsNil)
+	defer clean()
+
+	writeFile := func(name, content string) {
+		filename := filepath.Join(workingDir, filepath.FromSlash(name))
+		c.Assert(os.MkdirAll(filepath.Dir(filename), 0o777), qt.IsNil)
+		c.Assert(os.WriteFile(filename, []byte(content), 0o666), qt.IsNil)
+	}
+
+	// The vendored module is not in go.mod, so this would fail if we tried to fetch it.
+	writeFile("go.mod", `module github.com/example/project
+
+go 1.20
+
+require github.com/example/notvendored v1.0.0
+
+replace github.com/example/notvendored => ./notvendored
+`)
+	writeFile("notvendored/go.mod", "module github.com/example/notvendored\n")
+	writeFile("notvendored/layouts/notvendored.html", "notvendored")
+	writeFile("_vendor/modules.txt", "# github.com/example/vendored v1.0.0\n")
+	writeFile("_vendor/github.com/example/vendored/layouts/vendored.html", "vendored")
+
+	mcfg := DefaultModuleConfig
+	mcfg.Imports = []Import{{Path: "github.com/example/vendored"}, {Path: "github.com/example/notvendored"}}
+
+	client := NewClient(ClientConfig{
+		Fs:           hugofs.Os,
+		WorkingDir:   workingDir,
+		CacheDir:     filepath.Join(workingDir, "modcache"),
+		ThemesDir:    filepath.Join(workingDir, "themes"),
+		Exec:         hexec.New(security.DefaultConfig),
+		ModuleConfig: mcfg,
+	})
+
+	mc, err := client.Collect()
+	c.Assert(err, qt.IsNil)
+	c.Assert(len(mc.AllModules), qt.Equals, 3)
+
+	vendored, notVendored := mc.AllModules[1], mc.AllModules[2]
+	c.Assert(vendored.Path(), qt.Equals, "github.com/example/vendored")
+	c.Assert(vendored.Vendor(), qt.IsTrue)
+	c.Assert(notVendored.Path(), qt.Equals, "github.com/example/notvendored")
+	c.Assert(notVendored.Vendor(), qt.IsFalse)
+	c.Assert(notVendored.IsGoMod(), qt.IsTrue)
+	c.Assert(notVendored.Dir(), qt.Equals, filepath.Join(workingDir, "notvendored")+string(os.PathSeparator))
+}
@@ -402,6 +402,34 @@ type Mount struct {
 
 	// Exclude all files matching the given Glob patterns (string or slice).
 	ExcludeFiles any
+
+	// The priority of this mount. When two content mounts contain the same
+	// file, the file in the mount with the highest priority wins.
+	// Mounts with the same priority are ordered by module and then by their
+	// position in the mounts list, with the first entry winning.
+	Priority int
+
+	// How this content mount is combined with lower priority content mounts
+	// with an overlapping target, one of "merge" (default) or "override".
+	// An "override" mount hides all files below its target in lower priority
+	// mounts.
+	Policy string
+}
+
+const (
+	// MountPolicyMerge merges the files in a mount with the files in the
+	// other mounts with the same target.
+	MountPolicyMerge = "merge"
+
+	// MountPolicyOverride hides the files below the mount target in all lower
+	// priority mounts.
+	MountPolicyOverride = "override"
+)
+
+// IsOverride reports whether this mount hides the files below its target in
+// lower priority mounts.
+func (m Mount) IsOverride() bool {
+	return m.Policy == MountPolicyOverride
 }
 
 // Used as key to remove duplicates.
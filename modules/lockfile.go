@@ -0,0 +1,222 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/herrors"
+	"github.com/spf13/afero"
+)
+
+const (
+	lockFilename = "modules.lock"
+
+	// Prefix used for the content hashes in modules.lock.
+	lockHashPrefix = "sha256:"
+)
+
+// lockEntry is a line in modules.lock on the form:
+//
+//	github.com/gohugoio/hugo-mod-bootstrap-scss/v5 v5.20300.20200 sha256:0f4a…
+type lockEntry struct {
+	Path    string
+	Version string
+	Hash    string
+}
+
+// verifyLockFile verifies the content hashes of the remote modules in mods
+// against the modules.lock file in the project root, and writes any new or
+// updated entries back to disk.
+//
+// An entry for a given module path and version that does not match the content
+// on disk is an error, unless update is set.
+// The modules.lock file is only created if update is set.
+func (c *Client) verifyLockFile(mods Modules, update bool) error {
+	filename := filepath.Join(c.ccfg.WorkingDir, lockFilename)
+
+	if !update {
+		if exists, _ := afero.Exists(c.fs, filename); !exists {
+			return nil
+		}
+	}
+
+	existing, err := c.readLockFile(filename)
+	if err != nil {
+		return err
+	}
+
+	var entries []lockEntry
+	for _, m := range mods {
+		if !isLockable(m) {
+			continue
+		}
+		hash, err := hashModuleMounts(c.fs, m)
+		if err != nil {
+			return fmt.Errorf("failed to hash module %q: %w", m.Path(), err)
+		}
+
+		if prev, found := existing[m.Path()]; found && !update {
+			if prev.Version == m.Version() && prev.Hash != hash {
+				return fmt.Errorf("module %s %s: content hash %s does not match %s in %s; run with --modUpdate to accept the change", m.Path(), m.Version(), hash, prev.Hash, lockFilename)
+			}
+		}
+
+		entries = append(entries, lockEntry{Path: m.Path(), Version: m.Version(), Hash: hash})
+	}
+
+	if len(entries) == 0 && len(existing) == 0 {
+		return nil
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Path < entries[j].Path
+	})
+
+	var b bytes.Buffer
+	for _, e := range entries {
+		fmt.Fprintf(&b, "%s %s %s\n", e.Path, e.Version, e.Hash)
+	}
+
+	if old, err := afero.ReadFile(c.fs, filename); err == nil && bytes.Equal(old, b.Bytes()) {
+		return nil
+	}
+
+	if err := afero.WriteFile(c.fs, filename, b.Bytes(), 0o666); err != nil {
+		if update {
+			return fmt.Errorf("failed to write %s: %w", lockFilename, err)
+		}
+		c.logger.Warnf("Failed to write %s: %s", lockFilename, err)
+	}
+
+	return nil
+}
+
+func (c *Client) readLockFile(filename string) (map[string]lockEntry, error) {
+	entries := make(map[string]lockEntry)
+
+	f, err := c.fs.Open(filename)
+	if err != nil {
+		if herrors.IsNotExist(err) {
+			return entries, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.Fields(line)
+		if len(parts) != 3 || !strings.HasPrefix(parts[2], lockHashPrefix) {
+			return nil, fmt.Errorf("invalid line in %s: %q", lockFilename, line)
+		}
+		entries[parts[0]] = lockEntry{Path: parts[0], Version: parts[1], Hash: parts[2]}
+	}
+
+	return entries, scanner.Err()
+}
+
+// isLockable reports whether m is a remote module with a fixed version,
+// which excludes the project itself, components in /themes and modules
+// replaced by a local directory.
+func isLockable(m Module) bool {
+	if m.Owner() == nil {
+		return false
+	}
+	if !m.IsGoMod() && !m.Vendor() {
+		return false
+	}
+	if r := m.Replace(); r != nil && r.Version() == "" {
+		return false
+	}
+	return m.Version() != ""
+}
+
+// hashModuleMounts creates a hash of the file tree mounted by m.
+// File paths are relative to the module dir and always use forward slashes,
+// so the hash is the same on all operating systems.
+func hashModuleMounts(fs afero.Fs, m Module) (string, error) {
+	dir := m.Dir()
+
+	files := make(map[string]string)
+	for _, mount := range m.Mounts() {
+		source := filepath.Join(dir, mount.Source)
+		if _, err := fs.Stat(source); err != nil {
+			if herrors.IsNotExist(err) {
+				continue
+			}
+			return "", err
+		}
+		err := afero.Walk(fs, source, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			files[filepath.ToSlash(rel)] = path
+			return nil
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	for _, name := range names {
+		fh, err := hashFile(fs, files[name])
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%s  %s\n", fh, name)
+	}
+
+	return lockHashPrefix + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func hashFile(fs afero.Fs, filename string) (string, error) {
+	f, err := fs.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
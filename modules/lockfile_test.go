@@ -0,0 +1,89 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"path/filepath"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/common/loggers"
+	"github.com/spf13/afero"
+)
+
+func TestVerifyLockFile(t *testing.T) {
+	c := qt.New(t)
+
+	fs := afero.NewMemMapFs()
+	workingDir := filepath.FromSlash("/project")
+	modDir := filepath.FromSlash("/project/_vendor/github.com/foo/bar")
+
+	writeFile := func(name, content string) {
+		c.Assert(afero.WriteFile(fs, filepath.Join(modDir, filepath.FromSlash(name)), []byte(content), 0o666), qt.IsNil)
+	}
+
+	writeFile("layouts/_default/single.html", "single")
+	writeFile("layouts/partials/foo.html", "foo")
+	writeFile("README.md", "not mounted")
+
+	client := &Client{fs: fs, logger: loggers.NewDefault(), ccfg: ClientConfig{WorkingDir: workingDir}}
+	project := &moduleAdapter{projectMod: true, dir: workingDir}
+	mod := &moduleAdapter{
+		path:    "github.com/foo/bar",
+		dir:     modDir,
+		version: "v1.0.0",
+		vendor:  true,
+		owner:   project,
+		mounts:  []Mount{{Source: "layouts", Target: "layouts"}},
+	}
+	mods := Modules{project, mod}
+
+	filename := filepath.Join(workingDir, lockFilename)
+
+	// The lock file is only created on request.
+	c.Assert(client.verifyLockFile(mods, false), qt.IsNil)
+	exists, _ := afero.Exists(fs, filename)
+	c.Assert(exists, qt.IsFalse)
+
+	c.Assert(client.verifyLockFile(mods, true), qt.IsNil)
+	b, err := afero.ReadFile(fs, filename)
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Equals, "github.com/foo/bar v1.0.0 sha256:74798f90f5344e32a91fac754b33ed4edf4976448592b30d1ee224c7bb5a3508\n")
+
+	// Files outside of the mounts do not matter.
+	writeFile("README.md", "changed")
+	c.Assert(client.verifyLockFile(mods, false), qt.IsNil)
+
+	// Changed content for the same version is an error.
+	writeFile("layouts/partials/foo.html", "changed")
+	err = client.verifyLockFile(mods, false)
+	c.Assert(err, qt.ErrorMatches, `module github.com/foo/bar v1.0.0: content hash sha256:\w+ does not match sha256:74798f90\w+ in modules.lock; .*`)
+
+	// Unless we ask for an update.
+	c.Assert(client.verifyLockFile(mods, true), qt.IsNil)
+	c.Assert(client.verifyLockFile(mods, false), qt.IsNil)
+
+	// A new version replaces the entry.
+	mod.version = "v1.1.0"
+	writeFile("layouts/partials/foo.html", "v1.1.0")
+	c.Assert(client.verifyLockFile(mods, false), qt.IsNil)
+	b, err = afero.ReadFile(fs, filename)
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Contains, "github.com/foo/bar v1.1.0 sha256:")
+
+	// Failing to write the lock file on update is an error.
+	client.fs = afero.NewReadOnlyFs(fs)
+	mod.version = "v1.2.0"
+	c.Assert(client.verifyLockFile(mods, true), qt.ErrorMatches, "failed to write modules.lock: .*")
+}
@@ -59,6 +59,9 @@ type Format struct {
 	// Enable to override the global uglyURLs setting.
 	Ugly bool `json:"ugly"`
 
+	// Enable to never minify this output format, even if minifyOutput is enabled.
+	NoMinify bool `json:"noMinify"`
+
 	// Enable if it doesn't make sense to include this format in an alternative
 	// format listing, CSS being one good example.
 	// Note that we use the term "alternative" and not "alternate" here, as it
@@ -16,7 +16,11 @@ package parser
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/gohugoio/hugo/parser/metadecoders"
 
@@ -33,6 +37,15 @@ const (
 )
 
 func InterfaceToConfig(in any, format metadecoders.Format, w io.Writer) error {
+	return InterfaceToConfigIndent(in, format, "", w)
+}
+
+// InterfaceToConfigIndent is like InterfaceToConfig, but uses indent for
+// each level of indentation. An empty indent means the default for the
+// format. The YAML encoder does not support custom indentation, so indent
+// is ignored for YAML.
+// A metadecoders.OrderedMap in will be written with its keys in order.
+func InterfaceToConfigIndent(in any, format metadecoders.Format, indent string, w io.Writer) error {
 	if in == nil {
 		return errors.New("input was nil")
 	}
@@ -48,11 +61,25 @@ func InterfaceToConfig(in any, format metadecoders.Format, w io.Writer) error {
 		return err
 
 	case metadecoders.TOML:
+		if m, ok := in.(metadecoders.OrderedMap); ok {
+			// The TOML encoder preserves the field order of structs only.
+			v, err := orderedMapToStruct(m)
+			if err != nil {
+				return err
+			}
+			in = v
+		}
 		enc := toml.NewEncoder(w)
 		enc.SetIndentTables(true)
+		if indent != "" {
+			enc.SetIndentSymbol(indent)
+		}
 		return enc.Encode(in)
 	case metadecoders.JSON:
-		b, err := json.MarshalIndent(in, "", "   ")
+		if indent == "" {
+			indent = "   "
+		}
+		b, err := json.MarshalIndent(in, "", indent)
 		if err != nil {
 			return err
 		}
@@ -65,7 +92,10 @@ func InterfaceToConfig(in any, format metadecoders.Format, w io.Writer) error {
 		_, err = w.Write([]byte{'\n'})
 		return err
 	case metadecoders.XML:
-		b, err := xml.AnyXmlIndent(in, "", "\t", "root")
+		if indent == "" {
+			indent = "\t"
+		}
+		b, err := xml.AnyXmlIndent(in, "", indent, "root")
 		if err != nil {
 			return err
 		}
@@ -77,6 +107,56 @@ func InterfaceToConfig(in any, format metadecoders.Format, w io.Writer) error {
 	}
 }
 
+var anyType = reflect.TypeOf((*any)(nil)).Elem()
+
+// orderedMapToStruct converts m to a struct value with one field per key,
+// in order, so it can be encoded by encoders that sort map keys.
+func orderedMapToStruct(m metadecoders.OrderedMap) (any, error) {
+	fields := make([]reflect.StructField, len(m))
+	values := make([]any, len(m))
+	for i, item := range m {
+		if item.Key == "" || strings.Contains(item.Key, ",") {
+			return nil, fmt.Errorf("key %q is not supported in ordered TOML output", item.Key)
+		}
+		v, err := orderedValueToStruct(item.Value)
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = reflect.StructField{
+			Name: fmt.Sprintf("F%d", i),
+			Type: anyType,
+			Tag:  reflect.StructTag("toml:" + strconv.Quote(item.Key)),
+		}
+		values[i] = v
+	}
+
+	sv := reflect.New(reflect.StructOf(fields)).Elem()
+	for i, v := range values {
+		if v != nil {
+			sv.Field(i).Set(reflect.ValueOf(v))
+		}
+	}
+	return sv.Interface(), nil
+}
+
+func orderedValueToStruct(v any) (any, error) {
+	switch vv := v.(type) {
+	case metadecoders.OrderedMap:
+		return orderedMapToStruct(vv)
+	case []any:
+		s := make([]any, len(vv))
+		for i, e := range vv {
+			ev, err := orderedValueToStruct(e)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = ev
+		}
+		return s, nil
+	}
+	return v, nil
+}
+
 func InterfaceToFrontMatter(in any, format metadecoders.Format, w io.Writer) error {
 	if in == nil {
 		return errors.New("input was nil")
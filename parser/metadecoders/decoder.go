@@ -46,6 +46,10 @@ type Decoder struct {
 	// If true, a quote may appear in an unquoted field and a non-doubled quote
 	// may appear in a quoted field. It defaults to false.
 	LazyQuotes bool
+
+	// Format, if set, is the format of the data. This disables any format
+	// detection from the media type or the content.
+	Format Format
 }
 
 // OptionsKey is used in cache keys.
@@ -54,6 +58,7 @@ func (d Decoder) OptionsKey() string {
 	sb.WriteRune(d.Delimiter)
 	sb.WriteRune(d.Comment)
 	sb.WriteString(strconv.FormatBool(d.LazyQuotes))
+	sb.WriteString(string(d.Format))
 	return sb.String()
 }
 
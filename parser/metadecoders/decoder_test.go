@@ -306,3 +306,43 @@ func BenchmarkStringifyMapKeysIntegers(b *testing.B) {
 		stringifyMapKeys(maps[i])
 	}
 }
+
+func TestUnmarshalToOrderedMap(t *testing.T) {
+	c := qt.New(t)
+
+	expect := OrderedMap{
+		{Key: "b", Value: "x"},
+		{Key: "a", Value: OrderedMap{{Key: "d", Value: "v"}, {Key: "c", Value: []any{OrderedMap{{Key: "f", Value: "z"}, {Key: "e", Value: "w"}}}}}},
+	}
+
+	for _, test := range []struct {
+		data   string
+		format Format
+	}{
+		{`{"b": "x", "a": {"d": "v", "c": [{"f": "z", "e": "w"}]}}`, JSON},
+		{"b: x\na:\n  d: v\n  c:\n  - f: z\n    e: w\n", YAML},
+	} {
+		m, err := Default.UnmarshalToOrderedMap([]byte(test.data), test.format)
+		c.Assert(err, qt.IsNil, qt.Commentf(string(test.format)))
+		c.Assert(m, qt.DeepEquals, expect, qt.Commentf(string(test.format)))
+	}
+
+	m, err := Default.UnmarshalToOrderedMap([]byte(`b = "x"
+[a]
+d = "v"
+[[a.c]]
+f = "z"
+e = "w"
+`), TOML)
+	c.Assert(err, qt.IsNil)
+	c.Assert(m, qt.DeepEquals, expect)
+
+	m, err = Default.UnmarshalToOrderedMap([]byte(`b = "x"
+a = { d = "v", c = [{ f = "z", e = "w" }] }
+`), TOML)
+	c.Assert(err, qt.IsNil)
+	c.Assert(m, qt.DeepEquals, expect)
+
+	_, err = Default.UnmarshalToOrderedMap([]byte(`[1, 2]`), JSON)
+	c.Assert(err, qt.Not(qt.IsNil))
+}
@@ -67,6 +67,22 @@ func FormatFromString(formatStr string) Format {
 	return ""
 }
 
+// FormatFromMediaType returns the Format for the given media type, e.g.
+// "application/toml" or "application/ld+json".
+// It returns an empty string for unknown formats.
+func FormatFromMediaType(mediaType string) Format {
+	mediaType, _, _ = strings.Cut(mediaType, ";")
+	_, subType, found := strings.Cut(strings.TrimSpace(mediaType), "/")
+	if !found {
+		return ""
+	}
+	subType, suffix, _ := strings.Cut(subType, "+")
+	if suffix != "" {
+		return FormatFromStrings(suffix, subType)
+	}
+	return FormatFromString(subType)
+}
+
 // FormatFromContentString tries to detect the format (JSON, YAML, TOML or XML)
 // in the given string.
 // It return an empty string if no format could be detected.
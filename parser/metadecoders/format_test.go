@@ -39,6 +39,25 @@ func TestFormatFromString(t *testing.T) {
 	}
 }
 
+func TestFormatFromMediaType(t *testing.T) {
+	c := qt.New(t)
+	for _, test := range []struct {
+		s      string
+		expect Format
+	}{
+		{"application/json", JSON},
+		{"application/json; charset=utf-8", JSON},
+		{"application/ld+json", JSON},
+		{"application/toml", TOML},
+		{"text/csv", CSV},
+		{"application/yaml", YAML},
+		{"text/plain", ""},
+		{"json", ""},
+	} {
+		c.Assert(FormatFromMediaType(test.s), qt.Equals, test.expect, qt.Commentf(test.s))
+	}
+}
+
 func TestFormatFromContentString(t *testing.T) {
 	t.Parallel()
 	c := qt.New(t)
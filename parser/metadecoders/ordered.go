@@ -0,0 +1,320 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadecoders
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/pelletier/go-toml/v2/unstable"
+	"github.com/spf13/cast"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// OrderedMap is a map that preserves the order of its keys.
+// Nested maps are also of type OrderedMap.
+type OrderedMap []OrderedMapItem
+
+// OrderedMapItem is a key/value pair in an OrderedMap.
+type OrderedMapItem struct {
+	Key   string
+	Value any
+}
+
+// ToOrderedMap converts m, including any nested maps, to an OrderedMap with
+// the keys sorted.
+func ToOrderedMap(m map[string]any) OrderedMap {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	om := make(OrderedMap, len(keys))
+	for i, k := range keys {
+		om[i] = OrderedMapItem{Key: k, Value: toOrderedValue(m[k])}
+	}
+	return om
+}
+
+func toOrderedValue(v any) any {
+	switch vv := v.(type) {
+	case map[string]any:
+		return ToOrderedMap(vv)
+	case []any:
+		for i, e := range vv {
+			vv[i] = toOrderedValue(e)
+		}
+	}
+	return v
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m OrderedMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, item := range m {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(item.Key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(item.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (m OrderedMap) MarshalYAML() (any, error) {
+	ms := make(yaml.MapSlice, len(m))
+	for i, item := range m {
+		ms[i] = yaml.MapItem{Key: item.Key, Value: item.Value}
+	}
+	return ms, nil
+}
+
+// UnmarshalToOrderedMap is like UnmarshalToMap, but preserves the order of
+// the keys for the JSON, YAML and TOML formats. For the other formats the keys
+// are sorted.
+func (d Decoder) UnmarshalToOrderedMap(data []byte, f Format) (OrderedMap, error) {
+	if len(data) == 0 {
+		return OrderedMap{}, nil
+	}
+
+	switch f {
+	case JSON:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		v, err := decodeJSONOrdered(dec)
+		if err != nil {
+			return nil, toFileError(f, data, fmt.Errorf("unmarshal failed: %w", err))
+		}
+		m, ok := v.(OrderedMap)
+		if !ok {
+			return nil, fmt.Errorf("unmarshal failed: expected a map, got %T", v)
+		}
+		return m, nil
+	case YAML:
+		var ms yaml.MapSlice
+		if err := yaml.Unmarshal(data, &ms); err != nil {
+			return nil, toFileError(f, data, fmt.Errorf("failed to unmarshal YAML: %w", err))
+		}
+		return fromYAMLOrdered(ms).(OrderedMap), nil
+	case TOML:
+		m, err := d.UnmarshalToMap(data, f)
+		if err != nil {
+			return nil, err
+		}
+		order, err := tomlKeyOrder(data)
+		if err != nil {
+			return nil, toFileError(f, data, fmt.Errorf("unmarshal failed: %w", err))
+		}
+		return toOrderedMapWithOrder(m, "", order), nil
+	default:
+		m, err := d.UnmarshalToMap(data, f)
+		if err != nil {
+			return nil, err
+		}
+		return ToOrderedMap(m), nil
+	}
+}
+
+// The separator used in key paths, and the path element used for array elements.
+const (
+	keyPathSep   = "\x00"
+	keyPathArray = "[]"
+)
+
+// tomlKeyOrder returns the position of each key path in data in the order
+// they are first declared. The TOML decoder only gives us maps, so we get
+// the order from the parsed expressions.
+func tomlKeyOrder(data []byte) (map[string]int, error) {
+	order := make(map[string]int)
+	add := func(path string) {
+		if _, found := order[path]; !found {
+			order[path] = len(order)
+		}
+	}
+
+	var addValue func(path string, n *unstable.Node)
+	addKeyValue := func(prefix string, n *unstable.Node) {
+		path := prefix
+		it := n.Key()
+		for it.Next() {
+			path += keyPathSep + string(it.Node().Data)
+			add(path)
+		}
+		addValue(path, n.Value())
+	}
+	addValue = func(path string, n *unstable.Node) {
+		switch n.Kind {
+		case unstable.InlineTable:
+			it := n.Children()
+			for it.Next() {
+				addKeyValue(path, it.Node())
+			}
+		case unstable.Array:
+			it := n.Children()
+			for it.Next() {
+				addValue(path+keyPathSep+keyPathArray, it.Node())
+			}
+		}
+	}
+
+	var p unstable.Parser
+	p.Reset(data)
+	var current string
+	for p.NextExpression() {
+		e := p.Expression()
+		switch e.Kind {
+		case unstable.Table, unstable.ArrayTable:
+			current = ""
+			it := e.Key()
+			for it.Next() {
+				current += keyPathSep + string(it.Node().Data)
+				add(current)
+			}
+			if e.Kind == unstable.ArrayTable {
+				current += keyPathSep + keyPathArray
+			}
+		case unstable.KeyValue:
+			addKeyValue(current, e)
+		}
+	}
+
+	return order, p.Error()
+}
+
+// toOrderedMapWithOrder converts m to an OrderedMap with the keys ordered by
+// their position in order. Keys not found in order are sorted last.
+func toOrderedMapWithOrder(m map[string]any, path string, order map[string]int) OrderedMap {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	pos := func(k string) int {
+		if i, found := order[path+keyPathSep+k]; found {
+			return i
+		}
+		return len(order)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		pi, pj := pos(keys[i]), pos(keys[j])
+		if pi != pj {
+			return pi < pj
+		}
+		return keys[i] < keys[j]
+	})
+
+	om := make(OrderedMap, len(keys))
+	for i, k := range keys {
+		om[i] = OrderedMapItem{Key: k, Value: toOrderedValueWithOrder(m[k], path+keyPathSep+k, order)}
+	}
+	return om
+}
+
+func toOrderedValueWithOrder(v any, path string, order map[string]int) any {
+	switch vv := v.(type) {
+	case map[string]any:
+		return toOrderedMapWithOrder(vv, path, order)
+	case []any:
+		for i, e := range vv {
+			vv[i] = toOrderedValueWithOrder(e, path+keyPathSep+keyPathArray, order)
+		}
+	}
+	return v
+}
+
+func decodeJSONOrdered(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return tok, nil
+	}
+
+	switch delim {
+	case '{':
+		m := OrderedMap{}
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			k, ok := tok.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected a string key, got %v", tok)
+			}
+			v, err := decodeJSONOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			m = append(m, OrderedMapItem{Key: k, Value: v})
+		}
+		// Consume the closing delimiter.
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return m, nil
+	case '[':
+		s := []any{}
+		for dec.More() {
+			v, err := decodeJSONOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			s = append(s, v)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return s, nil
+	default:
+		return nil, errors.New("unexpected delimiter")
+	}
+}
+
+func fromYAMLOrdered(v any) any {
+	switch vv := v.(type) {
+	case yaml.MapSlice:
+		m := make(OrderedMap, len(vv))
+		for i, item := range vv {
+			k, err := cast.ToStringE(item.Key)
+			if err != nil {
+				k = fmt.Sprintf("%v", item.Key)
+			}
+			m[i] = OrderedMapItem{Key: k, Value: fromYAMLOrdered(item.Value)}
+		}
+		return m
+	case []any:
+		for i, e := range vv {
+			vv[i] = fromYAMLOrdered(e)
+		}
+	}
+	return v
+}
@@ -0,0 +1,293 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publisher
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobwas/glob"
+	"github.com/gohugoio/hugo/hugofs"
+	hglob "github.com/gohugoio/hugo/hugofs/glob"
+	"github.com/gohugoio/hugo/media"
+	"github.com/gohugoio/hugo/minifiers"
+	"github.com/spf13/afero"
+)
+
+type precompressAlgorithm struct {
+	suffix    string
+	newWriter func(w io.Writer) (io.WriteCloser, error)
+}
+
+var precompressAlgorithms = map[string]precompressAlgorithm{
+	minifiers.PrecompressGzip: {
+		suffix: ".gz",
+		newWriter: func(w io.Writer) (io.WriteCloser, error) {
+			// No name or modification time in the header,
+			// so the output is stable across builds.
+			return gzip.NewWriterLevel(w, gzip.BestCompression)
+		},
+	},
+}
+
+// compressedSuffixes are formats that gain nothing from being compressed again.
+var compressedSuffixes = map[string]bool{
+	"gz": true, "br": true, "zst": true, "zip": true, "7z": true, "bz2": true, "xz": true,
+	"png": true, "jpg": true, "jpeg": true, "gif": true, "webp": true, "avif": true, "heic": true,
+	"woff": true, "woff2": true,
+	"mp3": true, "mp4": true, "m4a": true, "ogg": true, "webm": true, "mov": true,
+	"pdf": true,
+}
+
+// Precompressor writes pre-compressed variants of published files as
+// configured in minify.precompress.
+type Precompressor struct {
+	algorithms []precompressAlgorithm
+	mediaTypes []string
+	glob       glob.Glob
+	types      media.Types
+}
+
+// NewPrecompressor creates a new Precompressor. It returns nil if
+// precompression is disabled.
+func NewPrecompressor(cfg minifiers.PrecompressConfig, types media.Types) (*Precompressor, error) {
+	if len(cfg.Algorithms) == 0 {
+		return nil, nil
+	}
+
+	p := &Precompressor{mediaTypes: cfg.MediaTypes, types: types}
+	for _, a := range cfg.Algorithms {
+		alg, found := precompressAlgorithms[a]
+		if !found {
+			return nil, fmt.Errorf("minify.precompress: unsupported algorithm %q", a)
+		}
+		p.algorithms = append(p.algorithms, alg)
+	}
+
+	if cfg.Glob != "" {
+		g, err := hglob.GetGlob(cfg.Glob)
+		if err != nil {
+			return nil, fmt.Errorf("minify.precompress: invalid glob %q: %w", cfg.Glob, err)
+		}
+		p.glob = g
+	}
+
+	return p, nil
+}
+
+// match returns the algorithms to apply to the published file with the
+// given filename, if any.
+func (p *Precompressor) match(filename string) []precompressAlgorithm {
+	if p == nil {
+		return nil
+	}
+
+	filename = strings.TrimPrefix(filepath.ToSlash(filename), "/")
+	ext := path.Ext(filename)
+	if ext == "" {
+		return nil
+	}
+	suffix := strings.ToLower(ext[1:])
+	if compressedSuffixes[suffix] {
+		return nil
+	}
+	if p.glob != nil && !p.glob.Match(filename) {
+		return nil
+	}
+
+	mt, _, found := p.types.GetBySuffix(suffix)
+	if !found {
+		return nil
+	}
+	for _, t := range p.mediaTypes {
+		if t == mt.Type || (strings.HasSuffix(t, "/*") && strings.TrimSuffix(t, "/*") == mt.MainType) {
+			return p.algorithms
+		}
+	}
+
+	return nil
+}
+
+// Compress returns the pre-compressed variants of the published file with
+// the given filename and content, keyed by their filename.
+func (p *Precompressor) Compress(filename string, content []byte) (map[string][]byte, error) {
+	algs := p.match(filename)
+	if algs == nil {
+		return nil, nil
+	}
+	m := make(map[string][]byte)
+	for _, alg := range algs {
+		var b bytes.Buffer
+		w, err := alg.newWriter(&b)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(content); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		m[filename+alg.suffix] = b.Bytes()
+	}
+	return m, nil
+}
+
+// WrapFs returns a filesystem that writes the pre-compressed variants of
+// every matching file written to fs along with the file.
+// If fs already does this, or p is nil, fs is returned as is.
+func (p *Precompressor) WrapFs(fs afero.Fs) afero.Fs {
+	if p == nil {
+		return fs
+	}
+	var found bool
+	hugofs.WalkFilesystems(fs, func(fs afero.Fs) bool {
+		_, found = fs.(*precompressFs)
+		return found
+	})
+	if found {
+		return fs
+	}
+	return &precompressFs{Fs: fs, p: p}
+}
+
+var (
+	_ afero.Fs                   = (*precompressFs)(nil)
+	_ hugofs.FilesystemUnwrapper = (*precompressFs)(nil)
+)
+
+type precompressFs struct {
+	afero.Fs
+	p *Precompressor
+}
+
+func (fs *precompressFs) UnwrapFilesystem() afero.Fs {
+	return fs.Fs
+}
+
+func (fs *precompressFs) Name() string {
+	return "precompressFs"
+}
+
+func (fs *precompressFs) Create(name string) (afero.File, error) {
+	f, err := fs.Fs.Create(name)
+	if err != nil {
+		return f, err
+	}
+	return fs.wrapFile(name, f)
+}
+
+func (fs *precompressFs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	f, err := fs.Fs.OpenFile(name, flag, perm)
+	if err != nil || flag&os.O_TRUNC == 0 || (flag&os.O_RDWR == 0 && flag&os.O_WRONLY == 0) {
+		// Only files written from the start can be compressed while written.
+		return f, err
+	}
+	return fs.wrapFile(name, f)
+}
+
+func (fs *precompressFs) Remove(name string) error {
+	if err := fs.Fs.Remove(name); err != nil {
+		return err
+	}
+	for _, alg := range fs.p.match(name) {
+		// The compressed file may not exist, e.g. if the filter changed.
+		_ = fs.Fs.Remove(name + alg.suffix)
+	}
+	return nil
+}
+
+func (fs *precompressFs) wrapFile(name string, f afero.File) (afero.File, error) {
+	algs := fs.p.match(name)
+	if algs == nil {
+		return f, nil
+	}
+	pf := &precompressFile{File: f}
+	for _, alg := range algs {
+		cf, err := fs.Fs.Create(name + alg.suffix)
+		if err != nil {
+			pf.closeCompressed()
+			f.Close()
+			return nil, err
+		}
+		cw, err := alg.newWriter(cf)
+		if err != nil {
+			cf.Close()
+			pf.closeCompressed()
+			f.Close()
+			return nil, err
+		}
+		pf.files = append(pf.files, cf)
+		pf.writers = append(pf.writers, cw)
+	}
+	return pf, nil
+}
+
+// precompressFile writes everything written to File to its compressed
+// variants.
+type precompressFile struct {
+	afero.File
+	files   []afero.File
+	writers []io.WriteCloser
+}
+
+func (f *precompressFile) Write(p []byte) (n int, err error) {
+	n, err = f.File.Write(p)
+	if err != nil {
+		return
+	}
+	for _, w := range f.writers {
+		if _, err = w.Write(p[:n]); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (f *precompressFile) WriteString(s string) (int, error) {
+	return f.Write([]byte(s))
+}
+
+func (f *precompressFile) WriteAt(p []byte, off int64) (int, error) {
+	return 0, errors.New("precompress: WriteAt is not supported")
+}
+
+func (f *precompressFile) Close() error {
+	err := f.closeCompressed()
+	if cerr := f.File.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func (f *precompressFile) closeCompressed() error {
+	var err error
+	for i, w := range f.writers {
+		if werr := w.Close(); err == nil {
+			err = werr
+		}
+		if cerr := f.files[i].Close(); err == nil {
+			err = cerr
+		}
+	}
+	f.writers, f.files = nil, nil
+	return err
+}
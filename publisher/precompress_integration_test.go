@@ -0,0 +1,108 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publisher_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+	"github.com/spf13/afero"
+)
+
+func TestPrecompress(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "rss", "404", "robotsTXT", "section", "sitemap"]
+[build]
+buildManifest = true
+[minify.precompress]
+algorithms = ["gzip"]
+glob = "**.{html,css,png}"
+-- assets/main.css --
+body { color: red; }
+-- assets/app.js --
+console.log("app");
+-- assets/pixel.png --
+iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAQAAAC1HAwCAAAAC0lEQVR42mNkYAAAAAYAAjCB0C8AAAAASUVORK5CYII=
+-- layouts/index.html --
+Home.
+{{ (resources.Get "main.css").RelPermalink }}
+{{ (resources.Get "app.js").RelPermalink }}
+{{ (resources.Get "pixel.png").RelPermalink }}
+`
+
+	b := hugolib.Test(t, files)
+
+	gunzip := func(filename string) string {
+		f, err := b.H.Fs.PublishDir.Open(filename + ".gz")
+		b.Assert(err, qt.IsNil)
+		defer f.Close()
+		r, err := gzip.NewReader(f)
+		b.Assert(err, qt.IsNil)
+		content, err := io.ReadAll(r)
+		b.Assert(err, qt.IsNil)
+		return string(content)
+	}
+
+	b.Assert(gunzip("index.html"), qt.Equals, b.FileContent("public/index.html"))
+	b.Assert(gunzip("main.css"), qt.Equals, "body { color: red; }")
+
+	// Not matched by the glob.
+	b.AssertFileExists("public/app.js", true)
+	b.AssertFileExists("public/app.js.gz", false)
+	// Already compressed.
+	b.AssertFileExists("public/pixel.png", true)
+	b.AssertFileExists("public/pixel.png.gz", false)
+
+	b.AssertFileContent("public/hugo_build_manifest.json",
+		`"path": "index.html.gz",`,
+		`"path": "main.css.gz",`,
+	)
+
+	// Removing a published file removes its compressed variants.
+	b.Assert(b.H.Fs.PublishDir.Remove("main.css"), qt.IsNil)
+	b.AssertFileExists("public/main.css.gz", false)
+
+	// The compressed output is stable.
+	b.Assert(afero.WriteFile(b.H.Fs.PublishDir, "a.html", []byte("a"), 0o666), qt.IsNil)
+	c1, err := afero.ReadFile(b.H.Fs.PublishDir, "a.html.gz")
+	b.Assert(err, qt.IsNil)
+	b.Assert(afero.WriteFile(b.H.Fs.PublishDir, "a.html", []byte("a"), 0o666), qt.IsNil)
+	c2, err := afero.ReadFile(b.H.Fs.PublishDir, "a.html.gz")
+	b.Assert(err, qt.IsNil)
+	b.Assert(bytes.Equal(c1, c2), qt.IsTrue)
+}
+
+func TestPrecompressUnsupportedAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+[minify.precompress]
+algorithms = ["zopfli"]
+-- layouts/index.html --
+Home.
+`
+
+	_, err := hugolib.TestE(t, files)
+	qt.Assert(t, err, qt.IsNotNil)
+	qt.Assert(t, err.Error(), qt.Contains, `minify.precompress: unsupported algorithm "zopfli", supported: gzip`)
+}
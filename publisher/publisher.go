@@ -63,6 +63,10 @@ type Descriptor struct {
 	// If set, will replace all relative URLs with this one.
 	AbsURLPath string
 
+	// The path element in baseURL, e.g. "/blog", if any.
+	// If set, this is replaced with AbsURLPath in relative URLs starting with it.
+	AbsURLBasePath string
+
 	// Enable to minify the output using the OutputFormat defined above to
 	// pick the correct minifier configuration.
 	Minify bool
@@ -152,7 +156,7 @@ type Publisher interface {
 	PublishStats() PublishStats
 }
 
-// XML transformer := transform.New(urlreplacers.NewAbsURLInXMLTransformer(path))
+// XML transformer := transform.New(urlreplacers.NewAbsURLInXMLTransformer(path, basePath))
 func (p DestinationPublisher) createTransformerChain(f Descriptor) transform.Chain {
 	transformers := transform.NewEmpty()
 
@@ -160,10 +164,10 @@ func (p DestinationPublisher) createTransformerChain(f Descriptor) transform.Cha
 
 	if f.AbsURLPath != "" {
 		if isHTML {
-			transformers = append(transformers, urlreplacers.NewAbsURLTransformer(f.AbsURLPath))
+			transformers = append(transformers, urlreplacers.NewAbsURLTransformer(f.AbsURLPath, f.AbsURLBasePath))
 		} else {
 			// Assume XML.
-			transformers = append(transformers, urlreplacers.NewAbsURLInXMLTransformer(f.AbsURLPath))
+			transformers = append(transformers, urlreplacers.NewAbsURLInXMLTransformer(f.AbsURLPath, f.AbsURLBasePath))
 		}
 	}
 
@@ -179,7 +183,7 @@ func (p DestinationPublisher) createTransformerChain(f Descriptor) transform.Cha
 
 	}
 
-	if p.min.MinifyOutput {
+	if p.min.MinifyOutput && !f.OutputFormat.NoMinify {
 		minifyTransformer := p.min.Transformer(f.OutputFormat.MediaType)
 		if minifyTransformer != nil {
 			transformers = append(transformers, minifyTransformer)
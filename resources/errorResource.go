@@ -132,6 +132,26 @@ func (e *errorResource) Colors() ([]string, error) {
 	panic(e.ResourceError)
 }
 
+func (e *errorResource) DominantColor() (string, error) {
+	panic(e.ResourceError)
+}
+
+func (e *errorResource) AverageColor() (string, error) {
+	panic(e.ResourceError)
+}
+
+func (e *errorResource) BlurHash() (string, error) {
+	panic(e.ResourceError)
+}
+
+func (e *errorResource) LQIP() (string, error) {
+	panic(e.ResourceError)
+}
+
+func (e *errorResource) Format() images.Format {
+	panic(e.ResourceError)
+}
+
 func (e *errorResource) DecodeImage() (image.Image, error) {
 	panic(e.ResourceError)
 }
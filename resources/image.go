@@ -62,12 +62,22 @@ type imageResource struct {
 	// original (first).
 	root *imageResource
 
+	// Set for processed images. Holds the state needed to process the
+	// image in memory and to write it to the file cache on demand.
+	lazy *lazyImage
+
 	metaInit    sync.Once
 	metaInitErr error
 	meta        *imageMeta
 
 	dominantColorInit sync.Once
+	dominantColorErr  error
 	dominantColors    []string
+	averageColor      string
+
+	placeholdersInit sync.Once
+	placeholdersErr  error
+	placeholders     *imagePlaceholders
 
 	baseResource
 }
@@ -76,13 +86,18 @@ type imageMeta struct {
 	Exif *exif.ExifInfo
 }
 
+type imagePlaceholders struct {
+	BlurHash string
+	LQIP     string
+}
+
 func (i *imageResource) Exif() *exif.ExifInfo {
 	return i.root.getExif()
 }
 
 func (i *imageResource) getExif() *exif.ExifInfo {
 	i.metaInit.Do(func() {
-		supportsExif := i.Format == images.JPEG || i.Format == images.TIFF
+		supportsExif := i.Image.Format == images.JPEG || i.Image.Format == images.TIFF
 		if !supportsExif {
 			return
 		}
@@ -144,19 +159,106 @@ func (i *imageResource) getExif() *exif.ExifInfo {
 // Colors returns a slice of the most dominant colors in an image
 // using a simple histogram method.
 func (i *imageResource) Colors() ([]string, error) {
-	var err error
+	if err := i.initColors(); err != nil {
+		return nil, err
+	}
+	return i.dominantColors, nil
+}
+
+// DominantColor returns the most dominant color in an image, the first of Colors.
+func (i *imageResource) DominantColor() (string, error) {
+	if err := i.initColors(); err != nil {
+		return "", err
+	}
+	if len(i.dominantColors) == 0 {
+		return "", nil
+	}
+	return i.dominantColors[0], nil
+}
+
+// AverageColor returns the average color of an image.
+func (i *imageResource) AverageColor() (string, error) {
+	if err := i.initColors(); err != nil {
+		return "", err
+	}
+	return i.averageColor, nil
+}
+
+func (i *imageResource) initColors() error {
 	i.dominantColorInit.Do(func() {
 		var img image.Image
-		img, err = i.DecodeImage()
-		if err != nil {
+		img, i.dominantColorErr = i.DecodeImage()
+		if i.dominantColorErr != nil {
 			return
 		}
 		colors := color_extractor.ExtractColors(img)
 		for _, c := range colors {
 			i.dominantColors = append(i.dominantColors, images.ColorToHexString(c))
 		}
+		i.averageColor = images.ColorToHexString(images.AverageColor(img))
 	})
-	return i.dominantColors, nil
+	return i.dominantColorErr
+}
+
+// BlurHash returns a BlurHash string computed from a downscaled version of the image.
+func (i *imageResource) BlurHash() (string, error) {
+	p, err := i.getPlaceholders()
+	if err != nil {
+		return "", err
+	}
+	return p.BlurHash, nil
+}
+
+// Format returns the format of the image, e.g. webp.
+func (i *imageResource) Format() images.Format {
+	return i.Image.Format
+}
+
+// LQIP returns a base64 encoded data URI of a heavily downscaled and blurred
+// version of the image.
+func (i *imageResource) LQIP() (string, error) {
+	p, err := i.getPlaceholders()
+	if err != nil {
+		return "", err
+	}
+	return p.LQIP, nil
+}
+
+func (i *imageResource) getPlaceholders() (*imagePlaceholders, error) {
+	i.placeholdersInit.Do(func() {
+		key := i.getImagePlaceholdersCacheTargetPath()
+
+		read := func(info filecache.ItemInfo, r io.ReadSeeker) error {
+			p := &imagePlaceholders{}
+			if err := json.NewDecoder(r).Decode(p); err != nil {
+				return err
+			}
+			i.placeholders = p
+			return nil
+		}
+
+		create := func(info filecache.ItemInfo, w io.WriteCloser) error {
+			defer w.Close()
+			img, err := i.DecodeImage()
+			if err != nil {
+				return err
+			}
+
+			p := &imagePlaceholders{
+				BlurHash: images.BlurHash(img, i.getSpec().imaging.Cfg.Config.Imaging.BlurHash),
+			}
+			if p.LQIP, err = images.LQIP(img); err != nil {
+				return err
+			}
+			i.placeholders = p
+
+			return json.NewEncoder(w).Encode(p)
+		}
+
+		_, i.placeholdersErr = i.getSpec().ImageCache.fcache.ReadOrCreate(key, read, create)
+	})
+
+	return i.placeholders, i.placeholdersErr
 }
 
 // Clone is for internal use.
@@ -164,6 +266,7 @@ func (i *imageResource) Clone() resource.Resource {
 	gr := i.baseResource.Clone().(baseResource)
 	return &imageResource{
 		root:         i.root,
+		lazy:         i.lazy,
 		Image:        i.WithSpec(gr),
 		baseResource: gr,
 	}
@@ -173,6 +276,7 @@ func (i *imageResource) cloneTo(targetPath string) resource.Resource {
 	gr := i.baseResource.cloneTo(targetPath).(baseResource)
 	return &imageResource{
 		root:         i.root,
+		lazy:         i.lazy,
 		Image:        i.WithSpec(gr),
 		baseResource: gr,
 	}
@@ -239,11 +343,15 @@ func (i *imageResource) Fill(spec string) (images.ImageResource, error) {
 func (i *imageResource) Filter(filters ...any) (images.ImageResource, error) {
 	var conf images.ImageConfig
 
-	var gfilters []gift.Filter
-
-	for _, f := range filters {
-		gfilters = append(gfilters, images.ToFilters(f)...)
+	// Reuse the resolved filters and key when passed a pipeline.
+	var pipeline *images.FilterPipeline
+	if len(filters) == 1 {
+		pipeline, _ = filters[0].(*images.FilterPipeline)
 	}
+	if pipeline == nil {
+		pipeline = images.NewFilterPipeline(filters...)
+	}
+	gfilters := pipeline.Filters()
 
 	var (
 		targetFormat images.Format
@@ -254,7 +362,7 @@ func (i *imageResource) Filter(filters ...any) (images.ImageResource, error) {
 		if specProvider, ok := f.(images.ImageProcessSpecProvider); ok {
 			action, options := i.resolveActionOptions(specProvider.ImageProcessSpec())
 			var err error
-			conf, err = images.DecodeImageConfig(action, options, i.Proc.Cfg, i.Format)
+			conf, err = images.DecodeImageConfig(action, options, i.Proc.Cfg, i.Image.Format)
 			if err != nil {
 				return nil, err
 			}
@@ -272,20 +380,27 @@ func (i *imageResource) Filter(filters ...any) (images.ImageResource, error) {
 	}
 
 	conf.Action = "filter"
-	conf.Key = identity.HashString(gfilters)
+	conf.Key = pipeline.Key()
 	conf.TargetFormat = targetFormat
 	if conf.TargetFormat == 0 {
-		conf.TargetFormat = i.Format
+		conf.TargetFormat = i.Image.Format
+	}
+
+	var sources []images.ImageSource
+	for _, f := range gfilters {
+		if p, ok := images.UnwrapFilter(f).(images.ImageSourcesProvider); ok {
+			sources = append(sources, p.ImageSources()...)
+		}
 	}
 
-	return i.doWithImageConfig(conf, func(src image.Image) (image.Image, error) {
+	return i.doWithImageConfig(conf, sources, func(src image.Image) (image.Image, error) {
 		var filters []gift.Filter
 		for _, f := range gfilters {
 			f = images.UnwrapFilter(f)
 			if specProvider, ok := f.(images.ImageProcessSpecProvider); ok {
 				processSpec := specProvider.ImageProcessSpec()
 				action, options := i.resolveActionOptions(processSpec)
-				conf, err := images.DecodeImageConfig(action, options, i.Proc.Cfg, i.Format)
+				conf, err := images.DecodeImageConfig(action, options, i.Proc.Cfg, i.Image.Format)
 				if err != nil {
 					return nil, err
 				}
@@ -325,32 +440,33 @@ func (i *imageResource) processActionSpec(action, spec string) (images.ImageReso
 }
 
 func (i *imageResource) processActionOptions(action string, options []string) (images.ImageResource, error) {
-	conf, err := images.DecodeImageConfig(action, options, i.Proc.Cfg, i.Format)
+	conf, err := images.DecodeImageConfig(action, options, i.Proc.Cfg, i.Image.Format)
 	if err != nil {
 		return nil, err
 	}
 
-	img, err := i.doWithImageConfig(conf, func(src image.Image) (image.Image, error) {
-		return i.Proc.ApplyFiltersFromConfig(src, conf)
-	})
-	if err != nil {
-		return nil, err
+	if conf.MetadataIgnored(i.Image.Format) {
+		i.getSpec().Logger.Warnf("Image %q: metadata is only preserved when converting from JPEG to JPEG, the metadata option is ignored.", i.Name())
 	}
 
-	if action == images.ActionFill {
-		if conf.Anchor == 0 && img.Width() == 0 || img.Height() == 0 {
-			// See https://github.com/gohugoio/hugo/issues/7955
-			// Smartcrop fails silently in some rare cases.
-			// Fall back to a center fill.
-			conf.Anchor = gift.CenterAnchor
-			conf.AnchorStr = "center"
-			return i.doWithImageConfig(conf, func(src image.Image) (image.Image, error) {
-				return i.Proc.ApplyFiltersFromConfig(src, conf)
-			})
+	return i.doWithImageConfig(conf, nil, func(src image.Image) (image.Image, error) {
+		img, err := i.Proc.ApplyFiltersFromConfig(src, conf)
+		if err != nil {
+			return nil, err
 		}
-	}
-
-	return img, nil
+		if action == images.ActionFill {
+			if b := img.Bounds(); b.Dx() == 0 || b.Dy() == 0 {
+				// See https://github.com/gohugoio/hugo/issues/7955
+				// Smartcrop fails silently in some rare cases.
+				// Fall back to a center fill.
+				centerConf := conf
+				centerConf.Anchor = gift.CenterAnchor
+				centerConf.AnchorStr = "center"
+				return i.Proc.ApplyFiltersFromConfig(src, centerConf)
+			}
+		}
+		return img, nil
+	})
 }
 
 // Serialize image processing. The imaging library spins up its own set of Go routines,
@@ -362,8 +478,20 @@ const imageProcWorkers = 1
 
 var imageProcSem = make(chan bool, imageProcWorkers)
 
-func (i *imageResource) doWithImageConfig(conf images.ImageConfig, f func(src image.Image) (image.Image, error)) (images.ImageResource, error) {
-	img, err := i.getSpec().ImageCache.getOrCreate(i, conf, func() (*imageResource, image.Image, error) {
+// sources are the other images used to create the image, e.g. in an overlay filter.
+func (i *imageResource) doWithImageConfig(conf images.ImageConfig, sources []images.ImageSource, f func(src image.Image) (image.Image, error)) (images.ImageResource, error) {
+	img, err := i.getSpec().ImageCache.getOrCreate(i, conf, func() (image.Image, error) {
+		// Process the images in a chain of image operations first,
+		// as that takes the semaphore.
+		if err := prepareDecode(i); err != nil {
+			return nil, &os.PathError{Op: conf.Action, Path: i.TargetPath(), Err: err}
+		}
+		for _, s := range sources {
+			if err := prepareDecode(s); err != nil {
+				return nil, &os.PathError{Op: conf.Action, Path: i.TargetPath(), Err: err}
+			}
+		}
+
 		imageProcSem <- true
 		defer func() {
 			<-imageProcSem
@@ -371,12 +499,12 @@ func (i *imageResource) doWithImageConfig(conf images.ImageConfig, f func(src im
 
 		src, err := i.DecodeImage()
 		if err != nil {
-			return nil, nil, &os.PathError{Op: conf.Action, Path: i.TargetPath(), Err: err}
+			return nil, &os.PathError{Op: conf.Action, Path: i.TargetPath(), Err: err}
 		}
 
 		converted, err := f(src)
 		if err != nil {
-			return nil, nil, &os.PathError{Op: conf.Action, Path: i.TargetPath(), Err: err}
+			return nil, &os.PathError{Op: conf.Action, Path: i.TargetPath(), Err: err}
 		}
 
 		hasAlpha := !images.IsOpaque(converted)
@@ -410,13 +538,7 @@ func (i *imageResource) doWithImageConfig(conf images.ImageConfig, f func(src im
 			}
 		}
 
-		ci := i.clone(converted)
-		targetPath := i.relTargetPathFromConfig(conf)
-		ci.setTargetPath(targetPath)
-		ci.Format = conf.TargetFormat
-		ci.setMediaType(conf.TargetFormat.MediaType())
-
-		return ci, converted, nil
+		return converted, nil
 	})
 	if err != nil {
 		return nil, err
@@ -436,13 +558,36 @@ func (g *giphy) GIF() *gif.GIF {
 // DecodeImage decodes the image source into an Image.
 // This for internal use only.
 func (i *imageResource) DecodeImage() (image.Image, error) {
+	if i.lazy != nil {
+		return i.lazy.decode()
+	}
+	return i.decodeSource()
+}
+
+// prepareDecode processes v in memory if it's an image in a chain of
+// image operations that is not yet in the file cache.
+func prepareDecode(v any) error {
+	var img *imageResource
+	switch vv := v.(type) {
+	case *imageResource:
+		img = vv
+	case *resourceAdapter:
+		img, _ = vv.getImageOps().(*imageResource)
+	}
+	if img == nil || img.lazy == nil {
+		return nil
+	}
+	return img.lazy.prepare()
+}
+
+func (i *imageResource) decodeSource() (image.Image, error) {
 	f, err := i.ReadSeekCloser()
 	if err != nil {
 		return nil, fmt.Errorf("failed to open image for decode: %w", err)
 	}
 	defer f.Close()
 
-	if i.Format == images.GIF {
+	if i.Image.Format == images.GIF {
 		g, err := gif.DecodeAll(f)
 		if err != nil {
 			return nil, fmt.Errorf("failed to decode gif: %w", err)
@@ -453,18 +598,11 @@ func (i *imageResource) DecodeImage() (image.Image, error) {
 	return img, err
 }
 
-func (i *imageResource) clone(img image.Image) *imageResource {
+func (i *imageResource) clone() *imageResource {
 	spec := i.baseResource.Clone().(baseResource)
 
-	var image *images.Image
-	if img != nil {
-		image = i.WithImage(img)
-	} else {
-		image = i.WithSpec(spec)
-	}
-
 	return &imageResource{
-		Image:        image,
+		Image:        i.WithSpec(spec),
 		root:         i.root,
 		baseResource: spec,
 	}
@@ -482,9 +620,21 @@ func (i *imageResource) getImageMetaCacheTargetPath() string {
 	return df.TargetPath()
 }
 
+func (i *imageResource) getImagePlaceholdersCacheTargetPath() string {
+	const imagePlaceholdersVersionNumber = 1 // Increment to invalidate the placeholders cache
+
+	cfgHash := i.getSpec().imaging.Cfg.SourceHash
+	df := i.getResourcePaths()
+	p1, _ := paths.FileAndExt(df.File)
+	h := i.hash()
+	idStr := identity.HashString(h, i.size(), imagePlaceholdersVersionNumber, cfgHash)
+	df.File = fmt.Sprintf("%s_%s_placeholders.json", p1, idStr)
+	return df.TargetPath()
+}
+
 func (i *imageResource) relTargetPathFromConfig(conf images.ImageConfig) internal.ResourcePaths {
 	p1, p2 := paths.FileAndExt(i.getResourcePaths().File)
-	if conf.TargetFormat != i.Format {
+	if conf.TargetFormat != i.Image.Format {
 		p2 = conf.TargetFormat.DefaultExtension()
 	}
 
@@ -494,7 +644,7 @@ func (i *imageResource) relTargetPathFromConfig(conf images.ImageConfig) interna
 	// Do not change for no good reason.
 	const md5Threshold = 100
 
-	key := conf.GetKey(i.Format)
+	key := conf.GetKey(i.Image.Format)
 
 	// It is useful to have the key in clear text, but when nesting transforms, it
 	// can easily be too long to read, and maybe even too long
@@ -16,6 +16,7 @@ package resources
 import (
 	"image"
 	"io"
+	"sync"
 
 	"github.com/gohugoio/hugo/common/hugio"
 	"github.com/gohugoio/hugo/resources/images"
@@ -35,65 +36,79 @@ type ImageCache struct {
 
 func (c *ImageCache) getOrCreate(
 	parent *imageResource, conf images.ImageConfig,
-	createImage func() (*imageResource, image.Image, error),
+	createImage func() (image.Image, error),
 ) (*resourceAdapter, error) {
 	relTarget := parent.relTargetPathFromConfig(conf)
 	relTargetPath := relTarget.TargetPath()
 	memKey := dynacache.CleanKey(relTargetPath)
 
 	v, err := c.mcache.GetOrCreate(memKey, func(key string) (*resourceAdapter, error) {
-		var img *imageResource
-
-		// These funcs are protected by a named lock.
-		// read clones the parent to its new name and copies
-		// the content to the destinations.
-		read := func(info filecache.ItemInfo, r io.ReadSeeker) error {
-			img = parent.clone(nil)
-			targetPath := img.getResourcePaths()
-			targetPath.File = relTarget.File
-			img.setTargetPath(targetPath)
-			img.setOpenSource(func() (hugio.ReadSeekCloser, error) {
-				return c.fcache.Fs.Open(info.Name)
-			})
-			img.setSourceFilenameIsHash(true)
-			img.setMediaType(conf.TargetFormat.MediaType())
-
-			if err := img.InitConfig(r); err != nil {
-				return err
-			}
-
-			return nil
-		}
-
-		// create creates the image and encodes it to the cache (w).
-		create := func(info filecache.ItemInfo, w io.WriteCloser) (err error) {
-			defer w.Close()
-
-			var conv image.Image
-			img, conv, err = createImage()
-			if err != nil {
-				return
-			}
-			targetPath := img.getResourcePaths()
-			targetPath.File = relTarget.File
-			img.setTargetPath(targetPath)
-			img.setOpenSource(func() (hugio.ReadSeekCloser, error) {
-				return c.fcache.Fs.Open(info.Name)
-			})
-			return img.EncodeTo(conf, conv, w)
-		}
-
-		// Now look in the file cache.
-
 		// The definition of this counter is not that we have processed that amount
 		// (e.g. resized etc.), it can be fetched from file cache,
 		//  but the count of processed image variations for this site.
 		c.pathSpec.ProcessingStats.Incr(&c.pathSpec.ProcessingStats.ProcessedImages)
 
-		_, err := c.fcache.ReadOrCreate(relTargetPath, read, create)
-		if err != nil {
-			return nil, err
+		img := parent.clone()
+		targetPath := img.getResourcePaths()
+		targetPath.File = relTarget.File
+		img.setTargetPath(targetPath)
+		img.setSourceFilenameIsHash(true)
+		img.setMediaType(conf.TargetFormat.MediaType())
+		img.Image.Format = conf.TargetFormat
+
+		// Nothing is processed or written to the file cache until
+		// something needs the image. The intermediate images in a chain of
+		// image operations are processed in memory and only written to the
+		// file cache if they are published or otherwise read.
+		img.lazy = &lazyImage{
+			create:     createImage,
+			decodeFile: img.decodeSource,
 		}
+		img.lazy.materialize = func() (string, error) {
+			// These funcs are protected by a named lock.
+			// read validates the cached image, the config is loaded on demand.
+			read := func(info filecache.ItemInfo, r io.ReadSeeker) error {
+				_, _, err := image.DecodeConfig(r)
+				return err
+			}
+
+			// create creates the image and encodes it to the cache (w).
+			create := func(info filecache.ItemInfo, w io.WriteCloser) (err error) {
+				defer w.Close()
+
+				conv, err := img.lazy.decode()
+				if err != nil {
+					return
+				}
+
+				var ww io.Writer = w
+				if conf.MetadataApplies(parent.Image.Format) {
+					var src hugio.ReadSeekCloser
+					src, err = parent.ReadSeekCloser()
+					if err != nil {
+						return
+					}
+					defer src.Close()
+					bounds := conv.Bounds()
+					ww, err = images.WrapMetadataWriter(w, src, conf.Metadata, bounds.Dx(), bounds.Dy())
+					if err != nil {
+						return
+					}
+				}
+
+				return img.EncodeTo(conf, conv, ww)
+			}
+
+			info, err := c.fcache.ReadOrCreate(relTargetPath, read, create)
+			return info.Name, err
+		}
+
+		img.setOpenSource(func() (hugio.ReadSeekCloser, error) {
+			if err := img.lazy.init(); err != nil {
+				return nil, err
+			}
+			return c.fcache.Fs.Open(img.lazy.filename)
+		})
 
 		imgAdapter := newResourceAdapter(parent.getSpec(), true, img)
 
@@ -103,6 +118,73 @@ func (c *ImageCache) getOrCreate(
 	return v, err
 }
 
+// lazyImage holds the state of a processed image that may not yet have
+// been processed and written to the file cache.
+type lazyImage struct {
+	// create processes the image in memory.
+	create func() (image.Image, error)
+
+	// materialize encodes the image to the file cache,
+	// if not already there, and returns its filename.
+	materialize func() (string, error)
+
+	// decodeFile decodes the image from the file cache.
+	decodeFile func() (image.Image, error)
+
+	filename        string
+	materializeInit sync.Once
+	materializeErr  error
+
+	mu           sync.Mutex
+	materialized bool
+	processed    bool
+	img          image.Image
+	imgErr       error
+}
+
+func (l *lazyImage) init() error {
+	l.materializeInit.Do(func() {
+		l.filename, l.materializeErr = l.materialize()
+		if l.materializeErr == nil {
+			l.mu.Lock()
+			// The image is in the file cache, free the memory.
+			l.materialized = true
+			l.img = nil
+			l.mu.Unlock()
+		}
+	})
+	return l.materializeErr
+}
+
+// decode returns the processed image, from the file cache if it's
+// written there, else processed in memory.
+// The in-memory result is memoized, so a chain of image operations is processed once.
+func (l *lazyImage) decode() (image.Image, error) {
+	l.mu.Lock()
+	if l.materialized {
+		l.mu.Unlock()
+		return l.decodeFile()
+	}
+	defer l.mu.Unlock()
+	if !l.processed {
+		l.img, l.imgErr = l.create()
+		l.processed = true
+	}
+	return l.img, l.imgErr
+}
+
+// prepare processes the image in memory if it's not in the file cache.
+func (l *lazyImage) prepare() error {
+	l.mu.Lock()
+	materialized := l.materialized
+	l.mu.Unlock()
+	if materialized {
+		return nil
+	}
+	_, err := l.decode()
+	return err
+}
+
 func newImageCache(fileCache *filecache.Cache, memCache *dynacache.Cache, ps *helpers.PathSpec) *ImageCache {
 	return &ImageCache{
 		fcache: fileCache,
@@ -42,6 +42,8 @@ import (
 
 	"github.com/gohugoio/hugo/media"
 	"github.com/gohugoio/hugo/resources/images"
+	"github.com/gohugoio/hugo/resources/images/exif"
+	"github.com/gohugoio/hugo/resources/resource"
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/gohugoio/hugo/htesting/hqt"
@@ -87,6 +89,12 @@ func TestImageTransformBasic(t *testing.T) {
 	colors, err := image.Colors()
 	c.Assert(err, qt.IsNil)
 	c.Assert(colors, qt.DeepEquals, []string{"#2d2f33", "#a49e93", "#d39e59", "#a76936", "#737a84", "#7c838b"})
+	dominant, err := image.DominantColor()
+	c.Assert(err, qt.IsNil)
+	c.Assert(dominant, qt.Equals, "#2d2f33")
+	average, err := image.AverageColor()
+	c.Assert(err, qt.IsNil)
+	c.Assert(average, qt.Equals, "#554d45")
 
 	c.Assert(image.RelPermalink(), qt.Equals, "/a/sunset.jpg")
 	c.Assert(image.ResourceType(), qt.Equals, "image")
@@ -444,6 +452,90 @@ func TestImageExif(t *testing.T) {
 	getAndCheckExif(c, image)
 }
 
+func TestImageMetadata(t *testing.T) {
+	c := qt.New(t)
+	fs := afero.NewMemMapFs()
+	spec := newTestResourceSpec(specDescriptor{fs: fs, c: c})
+	image := fetchResourceForSpec(spec, c, "sunset.jpg").(images.ImageResource)
+
+	decoder, err := exif.NewDecoder(exif.IncludeFields(".*"))
+	c.Assert(err, qt.IsNil)
+
+	decodeExif := func(c *qt.C, img images.ImageResource) (*exif.ExifInfo, error) {
+		r, err := img.(resource.ReadSeekCloserResource).ReadSeekCloser()
+		c.Assert(err, qt.IsNil)
+		defer r.Close()
+		return decoder.Decode(r)
+	}
+
+	// Default is to strip everything.
+	resized, err := image.Resize("300x200")
+	c.Assert(err, qt.IsNil)
+	x, err := decodeExif(c, resized)
+	c.Assert(err, qt.IsNil)
+	c.Assert(x, qt.IsNil)
+
+	resized, err = image.Resize("300x200 metadata=keep:exif;strip:gps")
+	c.Assert(err, qt.IsNil)
+	c.Assert(resized.RelPermalink(), qt.Contains, "_mkexif-sgps")
+	x, err = decodeExif(c, resized)
+	c.Assert(err, qt.IsNil)
+	c.Assert(x.Lat, qt.Equals, float64(0))
+	c.Assert(x.Long, qt.Equals, float64(0))
+	c.Assert(x.Tags["LensModel"], qt.Equals, "smc PENTAX-DA* 16-50mm F2.8 ED AL [IF] SDM")
+
+	resized, err = image.Resize("300x200 metadata=keep:all")
+	c.Assert(err, qt.IsNil)
+	x, err = decodeExif(c, resized)
+	c.Assert(err, qt.IsNil)
+	c.Assert(x.Lat, qt.Equals, float64(36.59744166666667))
+
+	resized, err = image.Resize("300x200 metadata=keep:copyright")
+	c.Assert(err, qt.IsNil)
+	x, err = decodeExif(c, resized)
+	c.Assert(err, qt.IsNil)
+	c.Assert(x.Lat, qt.Equals, float64(0))
+	_, found := x.Tags["LensModel"]
+	c.Assert(found, qt.IsFalse)
+
+	// metadata.jpg has Artist, Copyright and GPS in both Exif and XMP.
+	image = fetchResourceForSpec(spec, c, "metadata.jpg").(images.ImageResource)
+
+	resized, err = image.Resize("32x24 metadata=keep:copyright")
+	c.Assert(err, qt.IsNil)
+	x, err = decodeExif(c, resized)
+	c.Assert(err, qt.IsNil)
+	c.Assert(x.Tags["Copyright"], qt.Equals, "(c) Jane Doe")
+	c.Assert(x.Tags["Artist"], qt.Equals, "Jane Doe")
+	c.Assert(x.Lat, qt.Equals, float64(0))
+
+	resized, err = image.Resize("32x24 metadata=keep:copyright,iptc;strip:gps")
+	c.Assert(err, qt.IsNil)
+	c.Assert(resized.RelPermalink(), qt.Contains, "_mkcopyright.iptc-sgps")
+	x, err = decodeExif(c, resized)
+	c.Assert(err, qt.IsNil)
+	c.Assert(x.Tags["Copyright"], qt.Equals, "(c) Jane Doe")
+	c.Assert(x.Lat, qt.Equals, float64(0))
+
+	resized, err = image.Resize("32x24 metadata=keep:all;strip:gps")
+	c.Assert(err, qt.IsNil)
+	x, err = decodeExif(c, resized)
+	c.Assert(err, qt.IsNil)
+	c.Assert(x.Lat, qt.Equals, float64(0))
+	c.Assert(x.Tags["Copyright"], qt.Equals, "(c) Jane Doe")
+
+	// Metadata is only preserved for JPEG to JPEG.
+	resized, err = image.Resize("32x24 png metadata=keep:all")
+	c.Assert(err, qt.IsNil)
+	c.Assert(resized.RelPermalink(), qt.Not(qt.Contains), "_mkall")
+
+	_, err = image.Resize("300x200 metadata=keep:exif;strip:all")
+	c.Assert(err, qt.ErrorMatches, `.*all is not supported in strip`)
+
+	_, err = image.Resize("300x200 metadata=keep:foo")
+	c.Assert(err, qt.ErrorMatches, `.*unknown metadata class "foo"`)
+}
+
 func BenchmarkImageExif(b *testing.B) {
 	getImages := func(c *qt.C, b *testing.B, fs afero.Fs) []images.ImageResource {
 		spec := newTestResourceSpec(specDescriptor{fs: fs, c: c})
@@ -610,6 +702,8 @@ func TestImageOperationsGolden(t *testing.T) {
 	var err error
 	gopher, err = gopher.Resize("30x")
 	c.Assert(err, qt.IsNil)
+	// Publish the intermediate images, so they're written to the file cache.
+	c.Assert(gopher.RelPermalink(), qt.Not(qt.Equals), "")
 
 	f := &images.Filters{}
 
@@ -630,6 +724,7 @@ func TestImageOperationsGolden(t *testing.T) {
 		// Check the Opacity filter.
 		opacity30, err := orig.Filter(f.Opacity(30))
 		c.Assert(err, qt.IsNil)
+		c.Assert(opacity30.RelPermalink(), qt.Not(qt.Equals), "")
 		overlay, err := sunset.Filter(f.Overlay(opacity30.(images.ImageSource), 20, 20))
 		c.Assert(err, qt.IsNil)
 		rel := overlay.RelPermalink()
@@ -703,6 +798,7 @@ func TestImageOperationsGolden(t *testing.T) {
 
 		resized, err := orig.Fill("400x200 center")
 		c.Assert(err, qt.IsNil)
+		c.Assert(resized.RelPermalink(), qt.Not(qt.Equals), "")
 
 		for _, filter := range filters {
 			resized, err := resized.Filter(filter)
@@ -828,6 +924,34 @@ func BenchmarkResizeParallel(b *testing.B) {
 	})
 }
 
+func BenchmarkImageFilter(b *testing.B) {
+	c := qt.New(b)
+	_, img := fetchSunset(c)
+	f := &images.Filters{}
+	filters := []gift.Filter{f.GaussianBlur(6), f.Pixelate(8), f.Sepia(50), f.Brightness(12)}
+
+	run := func(b *testing.B, filter any) {
+		// Process the image once so we measure the per-image overhead.
+		if _, err := img.Filter(filter); err != nil {
+			b.Fatal(err)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := img.Filter(filter); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+
+	b.Run("Slice", func(b *testing.B) {
+		run(b, filters)
+	})
+
+	b.Run("Pipeline", func(b *testing.B) {
+		run(b, images.NewFilterPipeline(filters))
+	})
+}
+
 func assertWidthHeight(c *qt.C, img images.ImageResource, w, h int) {
 	c.Helper()
 	c.Assert(img, qt.Not(qt.IsNil))
@@ -16,7 +16,9 @@ package images
 import (
 	"encoding/hex"
 	"fmt"
+	"image"
 	"image/color"
+	"math"
 	"strings"
 )
 
@@ -52,6 +54,44 @@ func ColorToHexString(c color.Color) string {
 	return fmt.Sprintf("#%.2x%.2x%.2x", rgba.R, rgba.G, rgba.B)
 }
 
+// AverageColor returns the average color of img, weighted by alpha.
+// Like the palette extraction in Colors, large images are sampled
+// at an interval so at most about 224 pixels are read per row and column.
+func AverageColor(img image.Image) color.Color {
+	const downSizeTo = 224
+
+	b := img.Bounds()
+	stepX, stepY := 1, 1
+	if b.Dx() > downSizeTo {
+		stepX = b.Dx() / downSizeTo
+	}
+	if b.Dy() > downSizeTo {
+		stepY = b.Dy() / downSizeTo
+	}
+
+	var r, g, bl, a float64
+	for x := b.Min.X; x < b.Max.X; x += stepX {
+		for y := b.Min.Y; y < b.Max.Y; y += stepY {
+			// These are alpha-premultiplied.
+			cr, cg, cb, ca := img.At(x, y).RGBA()
+			r += float64(cr)
+			g += float64(cg)
+			bl += float64(cb)
+			a += float64(ca)
+		}
+	}
+
+	if a == 0 {
+		return color.Transparent
+	}
+
+	toUint8 := func(v float64) uint8 {
+		return uint8(math.Round(v / a * 255))
+	}
+
+	return color.RGBA{R: toUint8(r), G: toUint8(g), B: toUint8(bl), A: 255}
+}
+
 func hexStringToColor(s string) (color.Color, error) {
 	s = strings.TrimPrefix(s, "#")
 
@@ -14,6 +14,7 @@
 package images
 
 import (
+	"image"
 	"image/color"
 	"testing"
 
@@ -111,3 +112,25 @@ func TestReplaceColorInPalette(t *testing.T) {
 	c.Assert(palette, qt.HasLen, 2)
 	c.Assert(palette[0], qt.Equals, offWhite)
 }
+
+func TestAverageColor(t *testing.T) {
+	c := qt.New(t)
+
+	img := image.NewNRGBA(image.Rect(0, 0, 500, 300))
+	for x := 0; x < 500; x++ {
+		for y := 0; y < 300; y++ {
+			switch {
+			case x < 250:
+				img.Set(x, y, color.NRGBA{R: 255, A: 255})
+			case y < 150:
+				img.Set(x, y, color.NRGBA{B: 255, A: 255})
+			default:
+				// Transparent pixels do not contribute to the average.
+				img.Set(x, y, color.NRGBA{G: 255})
+			}
+		}
+	}
+
+	c.Assert(ColorToHexString(AverageColor(img)), qt.Equals, "#aa0055")
+	c.Assert(AverageColor(image.NewNRGBA(image.Rect(0, 0, 10, 10))), qt.Equals, color.Transparent)
+}
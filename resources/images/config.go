@@ -191,6 +191,11 @@ func DecodeConfig(in map[string]any) (*config.ConfigNamespace[ImagingConfig, Ima
 
 		i.ResampleFilter = filter
 
+		i.Metadata, err = DecodeMetadataPolicy(i.Imaging.Metadata)
+		if err != nil {
+			return i, nil, err
+		}
+
 		return i, nil, nil
 	}
 
@@ -218,7 +223,13 @@ func DecodeImageConfig(action string, options []string, defaults *config.ConfigN
 	for _, part := range options {
 		part = strings.ToLower(part)
 
-		if part == smartCropIdentifier {
+		if strings.HasPrefix(part, metadataOptionPrefix) {
+			c.Metadata, err = DecodeMetadataPolicy(strings.TrimPrefix(part, metadataOptionPrefix))
+			if err != nil {
+				return c, err
+			}
+			c.metadataSetForImage = true
+		} else if part == smartCropIdentifier {
 			c.AnchorStr = smartCropIdentifier
 		} else if pos, ok := anchorPositions[part]; ok {
 			c.Anchor = pos
@@ -365,6 +376,10 @@ type ImageConfig struct {
 
 	Anchor    gift.Anchor
 	AnchorStr string
+
+	// What metadata (Exif, IPTC etc.) to preserve from the source image.
+	Metadata            MetadataPolicy
+	metadataSetForImage bool // Whether the above is set for this image.
 }
 
 func (i ImageConfig) GetKey(format Format) string {
@@ -402,6 +417,10 @@ func (i ImageConfig) GetKey(format Format) string {
 		k += "_" + anchor
 	}
 
+	if mk := i.Metadata.Key(); mk != "" && i.MetadataApplies(format) {
+		k += "_m" + mk
+	}
+
 	if v, ok := imageFormatsVersions[format]; ok {
 		k += "_" + strconv.Itoa(v)
 	}
@@ -413,11 +432,24 @@ func (i ImageConfig) GetKey(format Format) string {
 	return k
 }
 
+// MetadataApplies reports whether the metadata policy can be applied
+// when converting from the given source format.
+func (i ImageConfig) MetadataApplies(sourceFormat Format) bool {
+	return !i.Metadata.IsZero() && sourceFormat == JPEG && i.TargetFormat == JPEG
+}
+
+// MetadataIgnored reports whether a metadata option set for this image
+// cannot be applied when converting from the given source format.
+func (i ImageConfig) MetadataIgnored(sourceFormat Format) bool {
+	return i.metadataSetForImage && !i.MetadataApplies(sourceFormat)
+}
+
 type ImagingConfigInternal struct {
 	BgColor        color.Color
 	Hint           webpoptions.EncodingPreset
 	ResampleFilter gift.Resampling
 	Anchor         gift.Anchor
+	Metadata       MetadataPolicy
 
 	Imaging ImagingConfig
 }
@@ -443,6 +475,11 @@ func (i *ImagingConfigInternal) Compile(externalCfg *ImagingConfig) error {
 	}
 	i.ResampleFilter = filter
 
+	i.Metadata, err = DecodeMetadataPolicy(externalCfg.Metadata)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -467,7 +504,16 @@ type ImagingConfig struct {
 	// Default color used in fill operations (e.g. "fff" for white).
 	BgColor string
 
+	// What metadata to preserve when re-encoding JPEG images, e.g. "keep:copyright,iptc"
+	// or "keep:exif;strip:gps".
+	// Valid classes are "all", "exif", "gps", "copyright", "iptc", "xmp" and "icc".
+	// Default is to strip all metadata.
+	Metadata string
+
 	Exif ExifConfig
+
+	// Configures the placeholders returned by BlurHash.
+	BlurHash BlurHashConfig
 }
 
 func (cfg *ImagingConfig) init() error {
@@ -489,7 +535,7 @@ func (cfg *ImagingConfig) init() error {
 		cfg.Exif.ExcludeFields = "GPS|Exif|Exposure[M|P|B]|Contrast|Resolution|Sharp|JPEG|Metering|Sensing|Saturation|ColorSpace|Flash|WhiteBalance"
 	}
 
-	return nil
+	return cfg.BlurHash.init()
 }
 
 type ExifConfig struct {
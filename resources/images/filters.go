@@ -20,6 +20,7 @@ import (
 
 	"github.com/gohugoio/hugo/common/hugio"
 	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/identity"
 	"github.com/gohugoio/hugo/resources/resource"
 
 	"github.com/disintegration/gift"
@@ -311,6 +312,37 @@ type filter struct {
 	gift.Filter
 }
 
+// FilterPipeline is a chain of filters built once and applied to any
+// number of images. The filters and their cache key are resolved when the
+// pipeline is created and not for every image it is applied to.
+type FilterPipeline struct {
+	filters []gift.Filter
+	key     string
+}
+
+// NewFilterPipeline creates a new FilterPipeline from the given filters,
+// which may be filters, slices of filters or other pipelines.
+func NewFilterPipeline(filters ...any) *FilterPipeline {
+	var gfilters []gift.Filter
+	for _, f := range filters {
+		gfilters = append(gfilters, ToFilters(f)...)
+	}
+	return &FilterPipeline{
+		filters: gfilters,
+		key:     identity.HashString(gfilters),
+	}
+}
+
+// Filters returns the filters in p.
+func (p *FilterPipeline) Filters() []gift.Filter {
+	return p.filters
+}
+
+// Key returns the cache key of the filters in p.
+func (p *FilterPipeline) Key() string {
+	return p.key
+}
+
 // For cache-busting.
 type filterOpts struct {
 	Version int
@@ -16,6 +16,7 @@ package images
 import (
 	"testing"
 
+	"github.com/disintegration/gift"
 	qt "github.com/frankban/quicktest"
 	"github.com/gohugoio/hugo/identity"
 )
@@ -30,3 +31,15 @@ func TestFilterHash(t *testing.T) {
 	c.Assert(identity.HashString(f.Gamma(32)), qt.Not(qt.Equals), identity.HashString(f.Gamma(33)))
 	c.Assert(identity.HashString(f.Gamma(32)), qt.Equals, identity.HashString(f.Gamma(32)))
 }
+
+func TestFilterPipeline(t *testing.T) {
+	c := qt.New(t)
+
+	f := &Filters{}
+
+	p := NewFilterPipeline(f.Grayscale(), []gift.Filter{f.Gamma(32), f.Invert()})
+	c.Assert(p.Filters(), qt.HasLen, 3)
+	c.Assert(p.Key(), qt.Equals, identity.HashString(p.Filters()))
+	c.Assert(NewFilterPipeline(p).Key(), qt.Equals, p.Key())
+	c.Assert(NewFilterPipeline(f.Grayscale()).Key(), qt.Not(qt.Equals), p.Key())
+}
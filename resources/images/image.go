@@ -330,9 +330,10 @@ func GetDefaultImageConfig(action string, defaults *config.ConfigNamespace[Imagi
 		defaults = defaultImageConfig
 	}
 	return ImageConfig{
-		Action:  action,
-		Hint:    defaults.Config.Hint,
-		Quality: defaults.Config.Imaging.Quality,
+		Action:   action,
+		Hint:     defaults.Config.Hint,
+		Quality:  defaults.Config.Imaging.Quality,
+		Metadata: defaults.Config.Metadata,
 	}
 }
 
@@ -353,6 +354,26 @@ const (
 	WEBP
 )
 
+// String returns the name of this format, e.g. jpeg for JPEG.
+func (f Format) String() string {
+	switch f {
+	case JPEG:
+		return "jpeg"
+	case PNG:
+		return "png"
+	case GIF:
+		return "gif"
+	case TIFF:
+		return "tiff"
+	case BMP:
+		return "bmp"
+	case WEBP:
+		return "webp"
+	default:
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+}
+
 // RequiresDefaultQuality returns if the default quality needs to be applied to
 // images of this format.
 func (f Format) RequiresDefaultQuality() bool {
@@ -415,6 +436,8 @@ func UnwrapFilter(in gift.Filter) gift.Filter {
 // ToFilters converts the given input to a slice of gift.Filter.
 func ToFilters(in any) []gift.Filter {
 	switch v := in.(type) {
+	case *FilterPipeline:
+		return v.filters
 	case []gift.Filter:
 		return v
 	case []filter:
@@ -33,6 +33,11 @@ type ImageResourceOps interface {
 	// Width returns the width of the Image.
 	Width() int
 
+	// Format returns the format of the Image, e.g. webp.
+	// For a processed image, this is the target format, which may differ from
+	// the source, e.g. after {{ $image.Resize "100x webp" }}.
+	Format() Format
+
 	// Process applies the given image processing options to the image.
 	Process(spec string) (ImageResource, error)
 
@@ -65,6 +70,20 @@ type ImageResourceOps interface {
 	// using a simple histogram method.
 	Colors() ([]string, error)
 
+	// DominantColor returns the most dominant color in the image, the first of Colors.
+	DominantColor() (string, error)
+
+	// AverageColor returns the average color of the image.
+	AverageColor() (string, error)
+
+	// BlurHash returns a BlurHash string computed from a downscaled version of the image,
+	// to be used as a placeholder while the image loads.
+	BlurHash() (string, error)
+
+	// LQIP returns a base64 encoded data URI of a heavily downscaled and blurred version
+	// of the image, to be used as a low quality image placeholder.
+	LQIP() (string, error)
+
 	// For internal use.
 	DecodeImage() (image.Image, error)
 }
@@ -0,0 +1,678 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package images
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Metadata classes that can be preserved when re-encoding an image.
+const (
+	MetadataAll       = "all"
+	MetadataExif      = "exif"
+	MetadataGPS       = "gps"
+	MetadataCopyright = "copyright"
+	MetadataIPTC      = "iptc"
+	MetadataXMP       = "xmp"
+	MetadataICC       = "icc"
+)
+
+// The image processing option prefix, e.g. "metadata=keep:copyright,iptc;strip:gps".
+const metadataOptionPrefix = "metadata="
+
+var metadataClasses = map[string]bool{
+	MetadataAll:       true,
+	MetadataExif:      true,
+	MetadataGPS:       true,
+	MetadataCopyright: true,
+	MetadataIPTC:      true,
+	MetadataXMP:       true,
+	MetadataICC:       true,
+}
+
+// MetadataPolicy describes what metadata to keep when re-encoding an image.
+// The zero value strips all metadata, which is the default.
+//
+// Note that metadata is currently only preserved when both the source and the
+// target image are JPEG.
+type MetadataPolicy struct {
+	keep  map[string]bool
+	strip map[string]bool
+}
+
+// DecodeMetadataPolicy decodes a policy on the form "keep:copyright,iptc;strip:gps".
+func DecodeMetadataPolicy(s string) (MetadataPolicy, error) {
+	var p MetadataPolicy
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return p, nil
+	}
+
+	for _, part := range strings.Split(s, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		directive, classes, found := strings.Cut(part, ":")
+		if !found {
+			return p, fmt.Errorf("invalid metadata policy %q: expected keep:CLASSES or strip:CLASSES", s)
+		}
+		var m map[string]bool
+		switch strings.TrimSpace(directive) {
+		case "keep":
+			if p.keep == nil {
+				p.keep = make(map[string]bool)
+			}
+			m = p.keep
+		case "strip":
+			if p.strip == nil {
+				p.strip = make(map[string]bool)
+			}
+			m = p.strip
+		default:
+			return p, fmt.Errorf("invalid metadata policy %q: unknown directive %q", s, directive)
+		}
+		for _, class := range strings.Split(classes, ",") {
+			class = strings.TrimSpace(class)
+			if !metadataClasses[class] {
+				return p, fmt.Errorf("invalid metadata policy %q: unknown metadata class %q", s, class)
+			}
+			m[class] = true
+		}
+	}
+
+	if p.strip[MetadataAll] {
+		return p, fmt.Errorf("invalid metadata policy %q: all is not supported in strip", s)
+	}
+
+	if p.keep[MetadataGPS] && !p.Has(MetadataExif) {
+		return p, fmt.Errorf("invalid metadata policy %q: gps requires exif", s)
+	}
+
+	return p, nil
+}
+
+// IsZero returns true if all metadata should be stripped.
+func (p MetadataPolicy) IsZero() bool {
+	return len(p.keep) == 0
+}
+
+// Has reports whether the given metadata class should be kept.
+func (p MetadataPolicy) Has(class string) bool {
+	if p.strip[class] {
+		return false
+	}
+	if p.keep[class] || p.keep[MetadataAll] {
+		return true
+	}
+	// GPS and copyright are part of the Exif data.
+	if class == MetadataGPS || class == MetadataCopyright {
+		return p.Has(MetadataExif)
+	}
+	return false
+}
+
+// Key returns a short, file name friendly representation of p.
+func (p MetadataPolicy) Key() string {
+	if p.IsZero() {
+		return ""
+	}
+	sorted := func(m map[string]bool) string {
+		var s []string
+		for k := range m {
+			s = append(s, k)
+		}
+		sort.Strings(s)
+		return strings.Join(s, ".")
+	}
+	k := "k" + sorted(p.keep)
+	if len(p.strip) > 0 {
+		k += "-s" + sorted(p.strip)
+	}
+	return k
+}
+
+// WrapMetadataWriter reads the metadata segments allowed by p from the JPEG in
+// src and returns a writer that inserts them after the start of image marker
+// of the JPEG written to w.
+// The width and height are the dimensions of the new image.
+func WrapMetadataWriter(w io.Writer, src io.Reader, p MetadataPolicy, width, height int) (io.Writer, error) {
+	if p.IsZero() {
+		return w, nil
+	}
+	segments, err := extractJPEGMetadata(src, p, width, height)
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) == 0 {
+		return w, nil
+	}
+	return &jpegMetadataWriter{w: w, segments: segments}, nil
+}
+
+type jpegMetadataWriter struct {
+	w        io.Writer
+	segments []jpegSegment
+	head     []byte
+	done     bool
+}
+
+func (w *jpegMetadataWriter) Write(p []byte) (int, error) {
+	if w.done {
+		return w.w.Write(p)
+	}
+	n := 0
+	for len(w.head) < 2 && len(p) > 0 {
+		w.head = append(w.head, p[0])
+		p = p[1:]
+		n++
+	}
+	if len(w.head) < 2 {
+		return n, nil
+	}
+
+	w.done = true
+	if _, err := w.w.Write(w.head); err != nil {
+		return 0, err
+	}
+	if w.head[0] == 0xff && w.head[1] == markerSOI {
+		for _, s := range w.segments {
+			if err := s.writeTo(w.w); err != nil {
+				return 0, err
+			}
+		}
+	}
+	m, err := w.w.Write(p)
+	return n + m, err
+}
+
+const (
+	markerSOI   = 0xd8
+	markerSOS   = 0xda
+	markerAPP1  = 0xe1
+	markerAPP2  = 0xe2
+	markerAPP13 = 0xed
+)
+
+var (
+	exifHeader = []byte("Exif\x00\x00")
+	xmpHeader  = []byte("http://ns.adobe.com/xap/1.0/\x00")
+	iccHeader  = []byte("ICC_PROFILE\x00")
+	iptcHeader = []byte("Photoshop 3.0\x00")
+)
+
+type jpegSegment struct {
+	marker byte
+	data   []byte
+}
+
+func (s jpegSegment) writeTo(w io.Writer) error {
+	if len(s.data)+2 > 0xffff {
+		return errors.New("jpeg segment too large")
+	}
+	var header [4]byte
+	header[0] = 0xff
+	header[1] = s.marker
+	binary.BigEndian.PutUint16(header[2:], uint16(len(s.data)+2))
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(s.data)
+	return err
+}
+
+// extractJPEGMetadata reads the segments before the image data in the JPEG in r
+// and returns the ones allowed by p.
+func extractJPEGMetadata(r io.Reader, p MetadataPolicy, width, height int) ([]jpegSegment, error) {
+	br := bufio.NewReader(r)
+
+	var soi [2]byte
+	if _, err := io.ReadFull(br, soi[:]); err != nil {
+		return nil, err
+	}
+	if soi[0] != 0xff || soi[1] != markerSOI {
+		// Not a JPEG.
+		return nil, nil
+	}
+
+	var segments []jpegSegment
+
+	for {
+		marker, err := readMarker(br)
+		if err != nil {
+			return nil, err
+		}
+		if marker == markerSOS {
+			break
+		}
+		if marker >= 0xd0 && marker <= 0xd7 {
+			// RST markers, no payload.
+			continue
+		}
+		var length [2]byte
+		if _, err := io.ReadFull(br, length[:]); err != nil {
+			return nil, err
+		}
+		n := int(binary.BigEndian.Uint16(length[:])) - 2
+		if n < 0 {
+			return nil, errors.New("invalid jpeg segment length")
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(br, data); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case marker == markerAPP1 && bytes.HasPrefix(data, exifHeader):
+			if p.Has(MetadataExif) {
+				tiff := data[len(exifHeader):]
+				if !p.Has(MetadataGPS) {
+					stripExifTags(tiff, exifTagGPSIFD)
+				}
+				if !p.Has(MetadataCopyright) {
+					stripExifTags(tiff, exifTagCopyright, exifTagArtist)
+				}
+				// The thumbnail in IFD1 is a preview of the original image,
+				// which may have been cropped.
+				dropExifThumbnail(tiff)
+				setExifDimensions(tiff, width, height)
+				segments = append(segments, jpegSegment{marker: marker, data: data})
+			} else if p.Has(MetadataCopyright) {
+				if tiff := copyrightOnlyExif(data[len(exifHeader):]); tiff != nil {
+					segments = append(segments, jpegSegment{marker: marker, data: append(append([]byte{}, exifHeader...), tiff...)})
+				}
+			}
+		case marker == markerAPP1 && bytes.HasPrefix(data, xmpHeader):
+			if p.Has(MetadataXMP) {
+				if !p.Has(MetadataGPS) {
+					data = stripXMPGPS(data)
+				}
+				segments = append(segments, jpegSegment{marker: marker, data: data})
+			}
+		case marker == markerAPP2 && bytes.HasPrefix(data, iccHeader):
+			if p.Has(MetadataICC) {
+				segments = append(segments, jpegSegment{marker: marker, data: data})
+			}
+		case marker == markerAPP13 && bytes.HasPrefix(data, iptcHeader):
+			if p.Has(MetadataIPTC) {
+				segments = append(segments, jpegSegment{marker: marker, data: data})
+			}
+		}
+	}
+
+	return segments, nil
+}
+
+func readMarker(br *bufio.Reader) (byte, error) {
+	b, err := br.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	if b != 0xff {
+		return 0, errors.New("invalid jpeg marker")
+	}
+	// Skip any fill bytes.
+	for {
+		b, err = br.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if b != 0xff {
+			return b, nil
+		}
+	}
+}
+
+// Matches the GPS properties in the exif namespace in XMP, on attribute and element form.
+var xmpGPSRe = regexp.MustCompile(`(?s)\s+exif:GPS\w+\s*=\s*(?:"[^"]*"|'[^']*')|<exif:GPS\w+\b[^>]*/>|<exif:GPS\w+\b[^>]*>.*?</exif:GPS\w+>`)
+
+func stripXMPGPS(data []byte) []byte {
+	return xmpGPSRe.ReplaceAll(data, nil)
+}
+
+const (
+	exifTagArtist          = 0x013b
+	exifTagCopyright       = 0x8298
+	exifTagExifIFD         = 0x8769
+	exifTagGPSIFD          = 0x8825
+	exifTagThumbnailOffset = 0x0201
+	exifTagThumbnailLength = 0x0202
+	exifTagPixelXDimension = 0xa002
+	exifTagPixelYDimension = 0xa003
+)
+
+var exifTypeSizes = map[uint16]uint32{
+	1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8, 11: 4, 12: 8,
+}
+
+// tiffReader provides bounds checked access to a TIFF structure.
+type tiffReader struct {
+	b     []byte
+	order binary.ByteOrder
+}
+
+func newTiffReader(b []byte) (tiffReader, bool) {
+	if len(b) < 8 {
+		return tiffReader{}, false
+	}
+	var order binary.ByteOrder
+	switch string(b[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return tiffReader{}, false
+	}
+	return tiffReader{b: b, order: order}, true
+}
+
+func (t tiffReader) u16(off uint32) (uint16, bool) {
+	if uint64(off)+2 > uint64(len(t.b)) {
+		return 0, false
+	}
+	return t.order.Uint16(t.b[off:]), true
+}
+
+func (t tiffReader) u32(off uint32) (uint32, bool) {
+	if uint64(off)+4 > uint64(len(t.b)) {
+		return 0, false
+	}
+	return t.order.Uint32(t.b[off:]), true
+}
+
+// uint returns the value of a SHORT or LONG entry with count 1.
+func (t tiffReader) uint(e ifdEntry) (uint32, bool) {
+	if e.count != 1 {
+		return 0, false
+	}
+	switch e.typ {
+	case 3:
+		v, ok := t.u16(e.offset + 8)
+		return uint32(v), ok
+	case 4:
+		return t.u32(e.offset + 8)
+	}
+	return 0, false
+}
+
+func (t tiffReader) putU16(off uint32, v uint16) {
+	if uint64(off)+2 <= uint64(len(t.b)) {
+		t.order.PutUint16(t.b[off:], v)
+	}
+}
+
+func (t tiffReader) putU32(off uint32, v uint32) {
+	if uint64(off)+4 <= uint64(len(t.b)) {
+		t.order.PutUint32(t.b[off:], v)
+	}
+}
+
+func (t tiffReader) zero(off, n uint32) {
+	if uint64(off)+uint64(n) > uint64(len(t.b)) {
+		return
+	}
+	for i := off; i < off+n; i++ {
+		t.b[i] = 0
+	}
+}
+
+// ifdEntry is a 12 byte entry in an IFD.
+type ifdEntry struct {
+	offset uint32 // offset of the entry itself.
+	tag    uint16
+	typ    uint16
+	count  uint32
+}
+
+func (e ifdEntry) size() uint32 {
+	return exifTypeSizes[e.typ] * e.count
+}
+
+// valueOffset returns the location of the entry's value.
+func (t tiffReader) valueOffset(e ifdEntry) (uint32, bool) {
+	if e.size() <= 4 {
+		return e.offset + 8, true
+	}
+	return t.u32(e.offset + 8)
+}
+
+func (t tiffReader) entries(ifdOffset uint32) ([]ifdEntry, bool) {
+	n, ok := t.u16(ifdOffset)
+	if !ok {
+		return nil, false
+	}
+	entries := make([]ifdEntry, 0, n)
+	for i := uint32(0); i < uint32(n); i++ {
+		off := ifdOffset + 2 + i*12
+		tag, ok1 := t.u16(off)
+		typ, ok2 := t.u16(off + 2)
+		count, ok3 := t.u32(off + 4)
+		if !ok1 || !ok2 || !ok3 {
+			return nil, false
+		}
+		entries = append(entries, ifdEntry{offset: off, tag: tag, typ: typ, count: count})
+	}
+	return entries, true
+}
+
+func (t tiffReader) ifd0() ([]ifdEntry, bool) {
+	off, ok := t.u32(4)
+	if !ok {
+		return nil, false
+	}
+	return t.entries(off)
+}
+
+// stripExifTags zeroes out the values of the given tags in IFD0 of the TIFF
+// structure in b. For the GPS IFD pointer, the GPS IFD itself is emptied.
+func stripExifTags(b []byte, tags ...uint16) {
+	t, ok := newTiffReader(b)
+	if !ok {
+		return
+	}
+	entries, ok := t.ifd0()
+	if !ok {
+		return
+	}
+
+	for _, e := range entries {
+		for _, tag := range tags {
+			if e.tag != tag {
+				continue
+			}
+			if tag == exifTagGPSIFD {
+				if gpsOffset, ok := t.u32(e.offset + 8); ok {
+					t.emptyIFD(gpsOffset)
+				}
+				continue
+			}
+			if off, ok := t.valueOffset(e); ok {
+				t.zero(off, e.size())
+			}
+		}
+	}
+}
+
+// dropExifThumbnail removes IFD1, holding the thumbnail, from the TIFF
+// structure in b.
+func dropExifThumbnail(b []byte) {
+	t, ok := newTiffReader(b)
+	if !ok {
+		return
+	}
+	ifd0Offset, ok := t.u32(4)
+	if !ok {
+		return
+	}
+	n, ok := t.u16(ifd0Offset)
+	if !ok {
+		return
+	}
+	nextOffset := ifd0Offset + 2 + uint32(n)*12
+	ifd1Offset, ok := t.u32(nextOffset)
+	if !ok || ifd1Offset == 0 {
+		return
+	}
+
+	if entries, ok := t.entries(ifd1Offset); ok {
+		var thumbOffset, thumbLength uint32
+		for _, e := range entries {
+			v, ok := t.uint(e)
+			if !ok {
+				continue
+			}
+			switch e.tag {
+			case exifTagThumbnailOffset:
+				thumbOffset = v
+			case exifTagThumbnailLength:
+				thumbLength = v
+			}
+		}
+		t.zero(thumbOffset, thumbLength)
+		t.emptyIFD(ifd1Offset)
+		t.zero(ifd1Offset+2, 4)
+	}
+
+	t.putU32(nextOffset, 0)
+}
+
+// setExifDimensions updates the pixel dimensions in the Exif IFD of the TIFF
+// structure in b.
+func setExifDimensions(b []byte, width, height int) {
+	t, ok := newTiffReader(b)
+	if !ok {
+		return
+	}
+	entries, ok := t.ifd0()
+	if !ok {
+		return
+	}
+	for _, e := range entries {
+		if e.tag != exifTagExifIFD {
+			continue
+		}
+		exifOffset, ok := t.u32(e.offset + 8)
+		if !ok {
+			return
+		}
+		exifEntries, ok := t.entries(exifOffset)
+		if !ok {
+			return
+		}
+		for _, ee := range exifEntries {
+			var v int
+			switch ee.tag {
+			case exifTagPixelXDimension:
+				v = width
+			case exifTagPixelYDimension:
+				v = height
+			default:
+				continue
+			}
+			switch {
+			case ee.typ == 3 && v <= 0xffff:
+				t.putU16(ee.offset+8, uint16(v))
+			case ee.typ == 4:
+				t.putU32(ee.offset+8, uint32(v))
+			}
+		}
+	}
+}
+
+// emptyIFD zeroes all the values in the IFD at the given offset and sets its
+// entry count to 0.
+func (t tiffReader) emptyIFD(offset uint32) {
+	entries, ok := t.entries(offset)
+	if !ok {
+		return
+	}
+	for _, e := range entries {
+		if e.size() > 4 {
+			if off, ok := t.valueOffset(e); ok {
+				t.zero(off, e.size())
+			}
+		}
+		t.zero(e.offset, 12)
+	}
+	t.zero(offset, 2)
+}
+
+// copyrightOnlyExif creates a new TIFF structure holding only the copyright
+// related tags in IFD0 of b. It returns nil if none is found.
+func copyrightOnlyExif(b []byte) []byte {
+	t, ok := newTiffReader(b)
+	if !ok {
+		return nil
+	}
+	entries, ok := t.ifd0()
+	if !ok {
+		return nil
+	}
+
+	type value struct {
+		tag  uint16
+		data []byte
+	}
+	var values []value
+	for _, e := range entries {
+		if (e.tag != exifTagArtist && e.tag != exifTagCopyright) || e.typ != 2 {
+			continue
+		}
+		off, ok := t.valueOffset(e)
+		if !ok || uint64(off)+uint64(e.size()) > uint64(len(t.b)) {
+			continue
+		}
+		values = append(values, value{tag: e.tag, data: t.b[off : off+e.size()]})
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].tag < values[j].tag })
+
+	order := binary.BigEndian
+	ifdSize := uint32(2 + 12*len(values) + 4)
+	dataOffset := 8 + ifdSize
+
+	out := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	out = order.AppendUint16(out, uint16(len(values)))
+	var data []byte
+	for _, v := range values {
+		out = order.AppendUint16(out, v.tag)
+		out = order.AppendUint16(out, 2)
+		out = order.AppendUint32(out, uint32(len(v.data)))
+		if len(v.data) <= 4 {
+			var inline [4]byte
+			copy(inline[:], v.data)
+			out = append(out, inline[:]...)
+		} else {
+			out = order.AppendUint32(out, dataOffset+uint32(len(data)))
+			data = append(data, v.data...)
+			if len(data)%2 != 0 {
+				// Values should start on a word boundary.
+				data = append(data, 0)
+			}
+		}
+	}
+	out = order.AppendUint32(out, 0)
+	return append(out, data...)
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package images
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestDecodeMetadataPolicy(t *testing.T) {
+	c := qt.New(t)
+
+	for _, test := range []struct {
+		in     string
+		key    string
+		expect any
+	}{
+		{"", "", nil},
+		{"keep:copyright,iptc;strip:gps", "kcopyright.iptc-sgps", nil},
+		{"Keep: exif ; strip: gps", "kexif-sgps", nil},
+		{"keep:all", "kall", nil},
+		{"keep:foo", "", `.*unknown metadata class "foo"`},
+		{"drop:exif", "", `.*unknown directive "drop"`},
+		{"exif", "", `.*expected keep:CLASSES or strip:CLASSES`},
+		{"keep:exif;strip:all", "", `.*all is not supported in strip`},
+		{"keep:gps", "", `.*gps requires exif`},
+	} {
+		p, err := DecodeMetadataPolicy(test.in)
+		if s, ok := test.expect.(string); ok {
+			c.Assert(err, qt.ErrorMatches, s, qt.Commentf(test.in))
+			continue
+		}
+		c.Assert(err, qt.IsNil, qt.Commentf(test.in))
+		c.Assert(p.Key(), qt.Equals, test.key)
+	}
+
+	p, _ := DecodeMetadataPolicy("keep:copyright,iptc;strip:gps")
+	c.Assert(p.Has(MetadataCopyright), qt.IsTrue)
+	c.Assert(p.Has(MetadataIPTC), qt.IsTrue)
+	c.Assert(p.Has(MetadataExif), qt.IsFalse)
+	c.Assert(p.Has(MetadataGPS), qt.IsFalse)
+
+	p, _ = DecodeMetadataPolicy("keep:all;strip:gps")
+	c.Assert(p.Has(MetadataXMP), qt.IsTrue)
+	c.Assert(p.Has(MetadataGPS), qt.IsFalse)
+}
+
+func TestExtractJPEGMetadata(t *testing.T) {
+	c := qt.New(t)
+
+	// metadata.jpg is a 64x48 image with Exif (Artist, Copyright, GPS, pixel
+	// dimensions and a thumbnail in IFD1), XMP with GPS properties and IPTC.
+	src, err := os.ReadFile(filepath.FromSlash("../testdata/metadata.jpg"))
+	c.Assert(err, qt.IsNil)
+
+	extract := func(policy string) map[string][]byte {
+		c.Helper()
+		p, err := DecodeMetadataPolicy(policy)
+		c.Assert(err, qt.IsNil)
+		segments, err := extractJPEGMetadata(bytes.NewReader(src), p, 32, 24)
+		c.Assert(err, qt.IsNil)
+		m := make(map[string][]byte)
+		for _, s := range segments {
+			switch {
+			case bytes.HasPrefix(s.data, exifHeader):
+				m[MetadataExif] = s.data[len(exifHeader):]
+			case bytes.HasPrefix(s.data, xmpHeader):
+				m[MetadataXMP] = s.data
+			case bytes.HasPrefix(s.data, iptcHeader):
+				m[MetadataIPTC] = s.data
+			}
+		}
+		return m
+	}
+
+	ifd0Tags := func(tiff []byte) map[uint16]ifdEntry {
+		t, ok := newTiffReader(tiff)
+		c.Assert(ok, qt.IsTrue)
+		entries, ok := t.ifd0()
+		c.Assert(ok, qt.IsTrue)
+		m := make(map[uint16]ifdEntry)
+		for _, e := range entries {
+			m[e.tag] = e
+		}
+		return m
+	}
+
+	c.Run("Strip GPS", func(c *qt.C) {
+		m := extract("keep:all;strip:gps")
+		c.Assert(m[MetadataIPTC], qt.Not(qt.IsNil))
+
+		xmp := string(m[MetadataXMP])
+		c.Assert(xmp, qt.Not(qt.Contains), "GPS")
+		c.Assert(xmp, qt.Contains, `xmlns:dc="http://purl.org/dc/elements/1.1/"><dc:rights>`)
+		c.Assert(xmp, qt.Contains, "(c) Jane Doe")
+
+		tiff := m[MetadataExif]
+		tr, _ := newTiffReader(tiff)
+		gps, found := ifd0Tags(tiff)[exifTagGPSIFD]
+		c.Assert(found, qt.IsTrue)
+		gpsOffset, _ := tr.u32(gps.offset + 8)
+		n, _ := tr.u16(gpsOffset)
+		c.Assert(n, qt.Equals, uint16(0))
+		c.Assert(bytes.Contains(tiff, []byte("Jane Doe")), qt.IsTrue)
+	})
+
+	c.Run("XMP only", func(c *qt.C) {
+		m := extract("keep:xmp")
+		c.Assert(m, qt.HasLen, 1)
+		c.Assert(string(m[MetadataXMP]), qt.Not(qt.Contains), "GPS")
+	})
+
+	c.Run("Thumbnail and dimensions", func(c *qt.C) {
+		tiff := extract("keep:exif")[MetadataExif]
+		tr, _ := newTiffReader(tiff)
+
+		ifd0Offset, _ := tr.u32(4)
+		n, _ := tr.u16(ifd0Offset)
+		next, _ := tr.u32(ifd0Offset + 2 + uint32(n)*12)
+		c.Assert(next, qt.Equals, uint32(0))
+		// The thumbnail is a small JPEG.
+		c.Assert(bytes.Contains(tiff, []byte{0xff, markerSOI}), qt.IsFalse)
+
+		exifIFD := ifd0Tags(tiff)[exifTagExifIFD]
+		exifOffset, _ := tr.u32(exifIFD.offset + 8)
+		entries, _ := tr.entries(exifOffset)
+		dims := make(map[uint16]uint32)
+		for _, e := range entries {
+			dims[e.tag], _ = tr.uint(e)
+		}
+		c.Assert(dims[exifTagPixelXDimension], qt.Equals, uint32(32))
+		c.Assert(dims[exifTagPixelYDimension], qt.Equals, uint32(24))
+	})
+
+	c.Run("Copyright only", func(c *qt.C) {
+		m := extract("keep:copyright,iptc;strip:gps")
+		c.Assert(m, qt.HasLen, 2)
+		c.Assert(m[MetadataIPTC], qt.Not(qt.IsNil))
+		tags := ifd0Tags(m[MetadataExif])
+		c.Assert(tags, qt.HasLen, 2)
+		_, found := tags[exifTagCopyright]
+		c.Assert(found, qt.IsTrue)
+		_, found = tags[exifTagArtist]
+		c.Assert(found, qt.IsTrue)
+	})
+}
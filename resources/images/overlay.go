@@ -21,7 +21,15 @@ import (
 	"github.com/disintegration/gift"
 )
 
-var _ gift.Filter = (*overlayFilter)(nil)
+var (
+	_ gift.Filter          = (*overlayFilter)(nil)
+	_ ImageSourcesProvider = (*overlayFilter)(nil)
+)
+
+// ImageSourcesProvider is implemented by filters that draw other images.
+type ImageSourcesProvider interface {
+	ImageSources() []ImageSource
+}
 
 type overlayFilter struct {
 	src  ImageSource
@@ -38,6 +46,10 @@ func (f overlayFilter) Draw(dst draw.Image, src image.Image, options *gift.Optio
 	gift.New().DrawAt(dst, overlaySrc, image.Pt(f.x, f.y), gift.OverOperator)
 }
 
+func (f overlayFilter) ImageSources() []ImageSource {
+	return []ImageSource{f.src}
+}
+
 func (f overlayFilter) Bounds(srcBounds image.Rectangle) image.Rectangle {
 	return image.Rect(0, 0, srcBounds.Dx(), srcBounds.Dy())
 }
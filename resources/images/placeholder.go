@@ -0,0 +1,216 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package images
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"math"
+	"strings"
+
+	"github.com/disintegration/gift"
+)
+
+const (
+	// The size of the longest side of the image the BlurHash is computed from.
+	blurHashImageSize = 32
+
+	// The size of the longest side of the LQIP image.
+	lqipImageSize = 16
+)
+
+// BlurHashConfig configures the BlurHash placeholders.
+type BlurHashConfig struct {
+	// The number of horizontal components, 1-9. Default is 4.
+	XComponents int
+
+	// The number of vertical components, 1-9. Default is 3.
+	YComponents int
+}
+
+func (cfg *BlurHashConfig) init() error {
+	if cfg.XComponents == 0 {
+		cfg.XComponents = 4
+	}
+	if cfg.YComponents == 0 {
+		cfg.YComponents = 3
+	}
+	if cfg.XComponents < 1 || cfg.XComponents > 9 || cfg.YComponents < 1 || cfg.YComponents > 9 {
+		return fmt.Errorf("blurHash components must be between 1 and 9, got %dx%d", cfg.XComponents, cfg.YComponents)
+	}
+	return nil
+}
+
+// BlurHash returns the BlurHash, see https://blurha.sh, of a downscaled
+// version of img.
+func BlurHash(img image.Image, cfg BlurHashConfig) string {
+	src := downscale(img, blurHashImageSize)
+	width, height := src.Bounds().Dx(), src.Bounds().Dy()
+
+	var linear [3][]float64
+	for c := range linear {
+		linear[c] = make([]float64, width*height)
+	}
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			o := src.PixOffset(x, y)
+			for c := range linear {
+				linear[c][y*width+x] = sRGBToLinear(src.Pix[o+c])
+			}
+		}
+	}
+
+	factors := make([][3]float64, 0, cfg.XComponents*cfg.YComponents)
+	for j := 0; j < cfg.YComponents; j++ {
+		for i := 0; i < cfg.XComponents; i++ {
+			normalisation := 2.0
+			if i == 0 && j == 0 {
+				normalisation = 1.0
+			}
+			var f [3]float64
+			for y := 0; y < height; y++ {
+				cy := math.Cos(math.Pi * float64(j) * float64(y) / float64(height))
+				for x := 0; x < width; x++ {
+					basis := normalisation * math.Cos(math.Pi*float64(i)*float64(x)/float64(width)) * cy
+					for c := range f {
+						f[c] += basis * linear[c][y*width+x]
+					}
+				}
+			}
+			scale := 1.0 / float64(width*height)
+			for c := range f {
+				f[c] *= scale
+			}
+			factors = append(factors, f)
+		}
+	}
+
+	var sb strings.Builder
+	encode83(&sb, (cfg.XComponents-1)+(cfg.YComponents-1)*9, 1)
+
+	dc, ac := factors[0], factors[1:]
+
+	maxValue := 1.0
+	if len(ac) > 0 {
+		var actualMax float64
+		for _, f := range ac {
+			for _, v := range f {
+				actualMax = math.Max(actualMax, math.Abs(v))
+			}
+		}
+		quantisedMax := clampInt(int(math.Floor(actualMax*166-0.5)), 0, 82)
+		maxValue = float64(quantisedMax+1) / 166
+		encode83(&sb, quantisedMax, 1)
+	} else {
+		encode83(&sb, 0, 1)
+	}
+
+	encode83(&sb, linearToSRGB(dc[0])<<16+linearToSRGB(dc[1])<<8+linearToSRGB(dc[2]), 4)
+
+	for _, f := range ac {
+		var v int
+		for _, c := range f {
+			v = v*19 + clampInt(int(math.Floor(signPow(c/maxValue, 0.5)*9+9.5)), 0, 18)
+		}
+		encode83(&sb, v, 2)
+	}
+
+	return sb.String()
+}
+
+// LQIP returns a base64 encoded data URI of a heavily downscaled and
+// blurred version of img, to be used as a low quality image placeholder.
+func LQIP(img image.Image) (string, error) {
+	src := downscale(img, lqipImageSize)
+	dst := image.NewNRGBA(src.Bounds())
+	gift.New(gift.GaussianBlur(1)).Draw(dst, src)
+
+	var (
+		buf       bytes.Buffer
+		mediaType string
+	)
+	if dst.Opaque() {
+		mediaType = "image/jpeg"
+		if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 50}); err != nil {
+			return "", err
+		}
+	} else {
+		mediaType = "image/png"
+		enc := png.Encoder{CompressionLevel: png.BestCompression}
+		if err := enc.Encode(&buf, dst); err != nil {
+			return "", err
+		}
+	}
+
+	return "data:" + mediaType + ";base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// downscale returns img scaled down so its longest side is at most size.
+func downscale(img image.Image, size int) *image.NRGBA {
+	b := img.Bounds()
+	width, height := size, 0
+	if b.Dy() > b.Dx() {
+		width, height = 0, size
+	}
+	if b.Dx() <= size && b.Dy() <= size {
+		width, height = b.Dx(), b.Dy()
+	}
+	g := gift.New(gift.Resize(width, height, gift.BoxResampling))
+	dst := image.NewNRGBA(g.Bounds(b))
+	g.Draw(dst, img)
+	return dst
+}
+
+const base83Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+func encode83(sb *strings.Builder, value, length int) {
+	for i := 1; i <= length; i++ {
+		digit := (value / int(math.Pow(83, float64(length-i)))) % 83
+		sb.WriteByte(base83Chars[digit])
+	}
+}
+
+func sRGBToLinear(v uint8) float64 {
+	f := float64(v) / 255
+	if f <= 0.04045 {
+		return f / 12.92
+	}
+	return math.Pow((f+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(v float64) int {
+	v = math.Max(0, math.Min(1, v))
+	if v <= 0.0031308 {
+		return int(v*12.92*255 + 0.5)
+	}
+	return int((1.055*math.Pow(v, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func signPow(v, exp float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(v), exp), v)
+}
+
+func clampInt(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
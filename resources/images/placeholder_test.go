@@ -0,0 +1,68 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package images
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestBlurHash(t *testing.T) {
+	c := qt.New(t)
+
+	uniform := func(col color.Color, w, h int) image.Image {
+		img := image.NewNRGBA(image.Rect(0, 0, w, h))
+		draw.Draw(img, img.Bounds(), image.NewUniform(col), image.Point{}, draw.Src)
+		return img
+	}
+
+	cfg := BlurHashConfig{}
+	c.Assert(cfg.init(), qt.IsNil)
+	c.Assert(cfg, qt.Equals, BlurHashConfig{XComponents: 4, YComponents: 3})
+
+	c.Assert(BlurHash(uniform(color.Black, 100, 50), cfg), qt.Equals, "L00000"+strings.Repeat("fQ", 11))
+	c.Assert(BlurHash(uniform(color.NRGBA{R: 255, A: 255}, 50, 100), cfg), qt.Equals, "LKTI:j|cfQ|c,Yo1fQo1fQfQfQfQ")
+	c.Assert(BlurHash(uniform(color.White, 10, 10), BlurHashConfig{XComponents: 1, YComponents: 1}), qt.Equals, "00TSUA")
+
+	gradient := image.NewNRGBA(image.Rect(0, 0, 32, 24))
+	for x := 0; x < 32; x++ {
+		for y := 0; y < 24; y++ {
+			gradient.Set(x, y, color.NRGBA{R: uint8(x * 8), G: uint8(y * 10), B: 128, A: 255})
+		}
+	}
+	c.Assert(BlurHash(gradient, cfg), qt.Equals, "LxH27k2swxX8mHWWjtf7gJfjfQfj")
+	c.Assert(BlurHash(gradient, BlurHashConfig{XComponents: 9, YComponents: 9}), qt.HasLen, 4+2*81)
+
+	c.Assert((&BlurHashConfig{XComponents: 10}).init(), qt.ErrorMatches, "blurHash components must be between 1 and 9, got 10x3")
+}
+
+func TestLQIP(t *testing.T) {
+	c := qt.New(t)
+
+	opaque := image.NewNRGBA(image.Rect(0, 0, 100, 60))
+	draw.Draw(opaque, opaque.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	s, err := LQIP(opaque)
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.Matches, `data:image/jpeg;base64,[A-Za-z0-9+/]+=*`)
+
+	transparent := image.NewNRGBA(image.Rect(0, 0, 100, 60))
+	s, err = LQIP(transparent)
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.Matches, `data:image/png;base64,[A-Za-z0-9+/]+=*`)
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/gohugoio/hugo/markup/converter"
 	"github.com/gohugoio/hugo/markup/tableofcontents"
+	"github.com/gohugoio/hugo/parser/metadecoders"
 
 	"github.com/gohugoio/hugo/config"
 
@@ -81,6 +82,12 @@ type ContentProvider interface {
 	// Plain returns the Page Content stripped of HTML markup.
 	Plain(context.Context) string
 
+	// PlainText returns the Page Content as plain text with the line and
+	// paragraph breaks preserved.
+	// The optional options map supports shortcodes (default true); set it to
+	// false to remove the output of shortcodes called with the {{< >}} notation.
+	PlainText(ctx context.Context, args ...any) (string, error)
+
 	// PlainWords returns a string slice from splitting Plain using https://pkg.go.dev/strings#Fields.
 	PlainWords(context.Context) []string
 
@@ -97,9 +104,12 @@ type ContentProvider interface {
 	// WordCount returns the number of words in the content.
 	WordCount(context.Context) int
 
-	// ReadingTime returns the reading time based on the length of plain text.
+	// ReadingTime returns the reading time in minutes based on the length of plain text.
 	ReadingTime(context.Context) int
 
+	// ReadingTimeSeconds returns the reading time in seconds based on the length of plain text.
+	ReadingTimeSeconds(context.Context) int
+
 	// Len returns the length of the content.
 	// This is for internal use only.
 	Len(context.Context) int
@@ -131,6 +141,14 @@ type GetPageProvider interface {
 	// This will return nil when no page could be found, and will return
 	// an error if the ref is ambiguous.
 	GetPage(ref string) (Page, error)
+
+	// GetPages returns all pages with a logical path matching the given Glob
+	// pattern, sorted by the default sort order. A pattern not starting with a
+	// slash is relative to this page.
+	//    {{ range .GetPages "/blog/**" }}{{ .Title }}{{ end }}
+	//
+	// This will return an empty collection when no page could be found.
+	GetPages(pattern string) (Pages, error)
 }
 
 // GitInfoProvider provides Git info.
@@ -139,22 +157,47 @@ type GitInfoProvider interface {
 	GitInfo() source.GitInfo
 	// CodeOwners returns the code owners for this object.
 	CodeOwners() []string
+	// Contributors returns the authors of the commits to the files in this
+	// object's bundle, ordered by the number of commits.
+	// This is only set if enableGitContributors is enabled.
+	Contributors() []source.GitContributor
 }
 
 // InSectionPositioner provides section navigation.
+// Unlike Next and Prev, which traverse all of the site's regular pages,
+// these traverse the regular pages in the page's current section (i.e.
+// .CurrentSection.RegularPages), not including pages in nested sections.
 type InSectionPositioner interface {
-	// NextInSection returns the next page in the same section.
+	// NextInSection points up to the next regular page in the same section
+	// (sorted by Hugo’s default sort), nil if none.
 	NextInSection() Page
-	// PrevInSection returns the previous page in the same section.
+	// PrevInSection points down to the previous regular page in the same section
+	// (sorted by Hugo’s default sort), nil if none.
 	PrevInSection() Page
 }
 
+// InTermPositioner provides navigation within a taxonomy term, e.g. a series.
+type InTermPositioner interface {
+	// NextInTerm points up to the next regular page in the given taxonomy term
+	// (sorted by the term's weight, then Hugo’s default sort), nil if none.
+	NextInTerm(taxonomy, term string) Page
+	// PrevInTerm points down to the previous regular page in the given taxonomy term
+	// (sorted by the term's weight, then Hugo’s default sort), nil if none.
+	PrevInTerm(taxonomy, term string) Page
+}
+
 // InternalDependencies is considered an internal interface.
 type InternalDependencies interface {
 	// GetRelatedDocsHandler is for internal use only.
 	GetRelatedDocsHandler() *RelatedDocsHandler
 }
 
+// OutputFormatProvider provides the OutputFormat currently being rendered.
+type OutputFormatProvider interface {
+	// OutputFormat returns the OutputFormat currently being rendered for this Page.
+	OutputFormat() OutputFormat
+}
+
 // OutputFormatsProvider provides the OutputFormats of a Page.
 type OutputFormatsProvider interface {
 	// OutputFormats returns the OutputFormats for this Page.
@@ -281,6 +324,7 @@ type PageWithoutContent interface {
 	GitInfoProvider
 
 	// Output formats
+	OutputFormatProvider
 	OutputFormatsProvider
 	AlternativeOutputFormatsProvider
 
@@ -290,6 +334,7 @@ type PageWithoutContent interface {
 
 	// Horizontal navigation
 	InSectionPositioner
+	InTermPositioner
 	PageRenderProvider
 	PaginatorProvider
 	Positioner
@@ -349,6 +394,14 @@ type Positioner interface {
 type RawContentProvider interface {
 	// RawContent returns the raw, unprocessed content of the page excluding any front matter.
 	RawContent() string
+
+	// ContentWithoutShortcode returns RawContent with all top level shortcodes
+	// with the given name removed, including their inner content.
+	ContentWithoutShortcode(name string) string
+
+	// RawFrontMatter returns the front matter of the page as declared in the
+	// source, with the keys in order and the original key casing and value types.
+	RawFrontMatter() (metadecoders.OrderedMap, error)
 }
 
 type RenderShortcodesProvider interface {
@@ -414,6 +467,10 @@ type TranslationsProvider interface {
 
 	// Translations returns the translations excluding the current Page.
 	Translations() Pages
+
+	// Hreflangs returns the hreflang entries for all translations, including the current Page,
+	// and x-default for the translation in the default content language.
+	Hreflangs() Hreflangs
 }
 
 // TreeProvider provides section tree navigation.
@@ -446,6 +503,10 @@ type TreeProvider interface {
 	// Ancestors returns the ancestors of each page
 	Ancestors() Pages
 
+	// Descendants returns all pages below this section, at any depth,
+	// including its subsections. For non-sections this is always empty.
+	Descendants() Pages
+
 	// Sections returns this section's subsections, if any.
 	// Note that for non-sections, this method will always return an empty list.
 	Sections() Pages
@@ -476,6 +537,10 @@ func (p PageWithContext) Plain() string {
 	return p.Page.Plain(p.Ctx)
 }
 
+func (p PageWithContext) PlainText(args ...any) (string, error) {
+	return p.Page.PlainText(p.Ctx, args...)
+}
+
 func (p PageWithContext) PlainWords() []string {
 	return p.Page.PlainWords(p.Ctx)
 }
@@ -500,6 +565,10 @@ func (p PageWithContext) ReadingTime() int {
 	return p.Page.ReadingTime(p.Ctx)
 }
 
+func (p PageWithContext) ReadingTimeSeconds() int {
+	return p.Page.ReadingTimeSeconds(p.Ctx)
+}
+
 func (p PageWithContext) Len() int {
 	return p.Page.Len(p.Ctx)
 }
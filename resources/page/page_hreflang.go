@@ -0,0 +1,70 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package page
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// HreflangDefault is the hreflang value used for the page in the default
+// content language, see https://developers.google.com/search/docs/specialty/international/localized-versions
+const HreflangDefault = "x-default"
+
+// Hreflangs holds the alternate language versions of a page.
+type Hreflangs []Hreflang
+
+// Hreflang represents a language version of a page, typically used to
+// create <link rel="alternate" hreflang="..."> elements.
+type Hreflang struct {
+	// Lang is the hreflang value, e.g. de-DE or x-default.
+	Lang string
+
+	// Permalink is the absolute URL to the page.
+	Permalink string
+
+	// Page is the page in this language.
+	Page Page
+}
+
+// NewHreflangs creates the Hreflangs for the given translations, including p itself,
+// sorted by language. If one of the translations is in defaultContentLanguage, it's
+// also added as x-default. Pages that are not translated return only themselves.
+func NewHreflangs(translations Pages, defaultContentLanguage string) Hreflangs {
+	hreflangs := make(Hreflangs, 0, len(translations)+1)
+	var defaultPage Page
+	for _, p := range translations {
+		lang := p.Language()
+		hreflangs = append(hreflangs, Hreflang{Lang: HreflangCode(lang.LanguageCode()), Permalink: p.Permalink(), Page: p})
+		if lang.Lang == defaultContentLanguage {
+			defaultPage = p
+		}
+	}
+	if len(translations) > 1 && defaultPage != nil {
+		hreflangs = append(hreflangs, Hreflang{Lang: HreflangDefault, Permalink: defaultPage.Permalink(), Page: defaultPage})
+	}
+	return hreflangs
+}
+
+// HreflangCode formats the language code s as a BCP 47 language tag
+// in its canonical casing, e.g. de_de becomes de-DE.
+// The input is returned as is if it's not a valid language tag.
+func HreflangCode(s string) string {
+	tag, err := language.Parse(strings.ReplaceAll(s, "_", "-"))
+	if err != nil {
+		return s
+	}
+	return tag.String()
+}
@@ -168,3 +168,63 @@ RelPermalink: {{ .RelPermalink }}
 
 	b.AssertFileContent("public/a/c/d/index.html", "RelPermalink: /a/c/d/")
 }
+
+func TestHreflangs(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+defaultContentLanguage = "en"
+defaultContentLanguageInSubdir = true
+relativeURLs = true
+[languages]
+[languages.en]
+weight = 1
+[languages.de]
+weight = 2
+languageCode = "de-de"
+[languages.pt]
+weight = 3
+languageCode = "pt_BR"
+-- content/p1.en.md --
+---
+title: p1 en
+---
+-- content/p1.de.md --
+---
+title: p1 de
+---
+-- content/p1.pt.md --
+---
+title: p1 pt
+---
+-- content/p2.de.md --
+---
+title: p2 de
+---
+-- content/p3.de.md --
+---
+title: p3 de
+---
+-- content/p3.pt.md --
+---
+title: p3 pt
+---
+-- layouts/_default/single.html --
+{{ range .Hreflangs }}<link rel="alternate" hreflang="{{ .Lang }}" href="{{ .Permalink }}" title="{{ .Page.Title }}">{{ end }}|
+`
+
+	b := hugolib.Test(t, files)
+
+	p1 := `<link rel="alternate" hreflang="en" href="https://example.org/en/p1/" title="p1 en"><link rel="alternate" hreflang="de-DE" href="https://example.org/de/p1/" title="p1 de"><link rel="alternate" hreflang="pt-BR" href="https://example.org/pt/p1/" title="p1 pt"><link rel="alternate" hreflang="x-default" href="https://example.org/en/p1/" title="p1 en">|`
+	b.AssertFileContent("public/en/p1/index.html", p1)
+	b.AssertFileContent("public/de/p1/index.html", p1)
+
+	// Not translated.
+	b.AssertFileContent("public/de/p2/index.html", `<link rel="alternate" hreflang="de-DE" href="https://example.org/de/p2/" title="p2 de">|`)
+
+	// No translation in the default content language.
+	b.AssertFileContent("public/pt/p3/index.html", `<link rel="alternate" hreflang="de-DE" href="https://example.org/de/p3/" title="p3 de"><link rel="alternate" hreflang="pt-BR" href="https://example.org/pt/p3/" title="p3 pt">|`)
+}
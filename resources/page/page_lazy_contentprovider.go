@@ -94,6 +94,11 @@ func (lcp *LazyContentProvider) Plain(ctx context.Context) string {
 	return lcp.cp.Plain(ctx)
 }
 
+func (lcp *LazyContentProvider) PlainText(ctx context.Context, args ...any) (string, error) {
+	lcp.init.Do(ctx)
+	return lcp.cp.PlainText(ctx, args...)
+}
+
 func (lcp *LazyContentProvider) PlainWords(ctx context.Context) []string {
 	lcp.init.Do(ctx)
 	return lcp.cp.PlainWords(ctx)
@@ -124,6 +129,11 @@ func (lcp *LazyContentProvider) ReadingTime(ctx context.Context) int {
 	return lcp.cp.ReadingTime(ctx)
 }
 
+func (lcp *LazyContentProvider) ReadingTimeSeconds(ctx context.Context) int {
+	lcp.init.Do(ctx)
+	return lcp.cp.ReadingTimeSeconds(ctx)
+}
+
 func (lcp *LazyContentProvider) Len(ctx context.Context) int {
 	lcp.init.Do(ctx)
 	return lcp.cp.Len(ctx)
@@ -172,3 +172,7 @@ func (c testConfig) Running() bool {
 func (c testConfig) WorkingDir() string {
 	return c.workingDir
 }
+
+func (c testConfig) RandomSeed() int64 {
+	return 0
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/gohugoio/hugo/hugofs/files"
 	"github.com/gohugoio/hugo/markup/converter"
 	"github.com/gohugoio/hugo/markup/tableofcontents"
+	"github.com/gohugoio/hugo/parser/metadecoders"
 
 	"github.com/gohugoio/hugo/hugofs"
 
@@ -91,6 +92,10 @@ func (p *nopPage) AllTranslations() Pages {
 	return nil
 }
 
+func (p *nopPage) Hreflangs() Hreflangs {
+	return nil
+}
+
 func (p *nopPage) LanguagePrefix() string {
 	return ""
 }
@@ -187,6 +192,10 @@ func (p *nopPage) GetPage(ref string) (Page, error) {
 	return nil, nil
 }
 
+func (p *nopPage) GetPages(pattern string) (Pages, error) {
+	return nil, nil
+}
+
 func (p *nopPage) GetParam(key string) any {
 	return nil
 }
@@ -203,6 +212,10 @@ func (p *nopPage) CodeOwners() []string {
 	return nil
 }
 
+func (p *nopPage) Contributors() []source.GitContributor {
+	return nil
+}
+
 func (p *nopPage) HasMenuCurrent(menuID string, me *navigation.MenuEntry) bool {
 	return false
 }
@@ -303,6 +316,10 @@ func (p *nopPage) Next() Page {
 	return nil
 }
 
+func (p *nopPage) OutputFormat() OutputFormat {
+	return OutputFormat{}
+}
+
 func (p *nopPage) OutputFormats() OutputFormats {
 	return nil
 }
@@ -347,6 +364,10 @@ func (p *nopPage) Ancestors() Pages {
 	return nil
 }
 
+func (p *nopPage) Descendants() Pages {
+	return nil
+}
+
 func (p *nopPage) Path() string {
 	return ""
 }
@@ -363,6 +384,10 @@ func (p *nopPage) Plain(context.Context) string {
 	return ""
 }
 
+func (p *nopPage) PlainText(context.Context, ...any) (string, error) {
+	return "", nil
+}
+
 func (p *nopPage) PlainWords(context.Context) []string {
 	return nil
 }
@@ -383,6 +408,14 @@ func (p *nopPage) NextInSection() Page {
 	return nil
 }
 
+func (p *nopPage) PrevInTerm(taxonomy, term string) Page {
+	return nil
+}
+
+func (p *nopPage) NextInTerm(taxonomy, term string) Page {
+	return nil
+}
+
 func (p *nopPage) PrevPage() Page {
 	return nil
 }
@@ -391,10 +424,18 @@ func (p *nopPage) NextPage() Page {
 	return nil
 }
 
+func (p *nopPage) ContentWithoutShortcode(name string) string {
+	return ""
+}
+
 func (p *nopPage) RawContent() string {
 	return ""
 }
 
+func (p *nopPage) RawFrontMatter() (metadecoders.OrderedMap, error) {
+	return nil, nil
+}
+
 func (p *nopPage) RenderShortcodes(ctx context.Context) (template.HTML, error) {
 	return "", nil
 }
@@ -403,6 +444,10 @@ func (p *nopPage) ReadingTime(context.Context) int {
 	return 0
 }
 
+func (p *nopPage) ReadingTimeSeconds(context.Context) int {
+	return 0
+}
+
 func (p *nopPage) Ref(argsm map[string]any) (string, error) {
 	return "", nil
 }
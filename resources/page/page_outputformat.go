@@ -43,6 +43,9 @@ type OutputFormat struct {
 
 	relPermalink string
 	permalink    string
+
+	// Whether this is the output format currently being rendered.
+	current bool
 }
 
 // Name returns this OutputFormat's name, i.e. HTML, AMP, JSON etc.
@@ -55,6 +58,19 @@ func (o OutputFormat) MediaType() media.Type {
 	return o.Format.MediaType
 }
 
+// IsCurrent reports whether this is the output format currently being
+// rendered, e.g. true for RSS when rendering the RSS feed of the page.
+func (o OutputFormat) IsCurrent() bool {
+	return o.current
+}
+
+// WithCurrent returns a copy of o marked as the output format currently being rendered.
+// For internal use.
+func (o OutputFormat) WithCurrent() OutputFormat {
+	o.current = true
+	return o
+}
+
 // Permalink returns the absolute permalink to this output format.
 func (o OutputFormat) Permalink() string {
 	return o.permalink
@@ -93,3 +109,23 @@ func (o OutputFormats) Get(name string) *OutputFormat {
 	}
 	return nil
 }
+
+// MediaTypes returns the media types of the output formats, without duplicates,
+// in the order of the output formats.
+func (o OutputFormats) MediaTypes() media.Types {
+	var types media.Types
+	for _, f := range o {
+		mt := f.MediaType()
+		var found bool
+		for _, t := range types {
+			if t.Type == mt.Type {
+				found = true
+				break
+			}
+		}
+		if !found {
+			types = append(types, mt)
+		}
+	}
+	return types
+}
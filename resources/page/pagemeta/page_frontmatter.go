@@ -79,6 +79,12 @@ type PageConfig struct {
 	Aliases        []string // The aliases for this page.
 	Outputs        []string // The output formats to render this page in. If not set, the site's configured output formats for this page kind will be used.
 
+	HeadingIDPrefix string // A prefix prepended to the generated heading IDs in the content.
+	UglyURLs        *bool  // Whether to use ugly URLs for this page. If not set, the site's uglyURLs setting is used.
+	HardWraps       *bool  // Whether to render newlines in the Markdown content as hard line breaks. If not set, the site's hardWraps setting is used.
+	Unsafe          *bool  // Whether to render raw HTML in the Markdown content. If not set, the site's unsafe setting is used.
+	Sanitize        *bool  // Whether to sanitize raw HTML in the Markdown content, when not unsafe. If not set, the site's sanitizer setting is used.
+
 	// These build options are set in the front matter,
 	// but not passed on to .Params.
 	Resources []map[string]any
@@ -170,6 +176,30 @@ func (f FrontMatterHandler) IsDateKey(key string) bool {
 	return f.allDateKeys[key]
 }
 
+// HandleTranslationKey sets d.PageConfig.TranslationKey to the first non-empty
+// value of the front matter fields listed in the translationKey configuration,
+// tried in order. The special identifier :filename maps to the content base name.
+// If none of them is set, the translation key is left as is.
+// Note that this requires all lower-case keys in the params map.
+func (f FrontMatterHandler) HandleTranslationKey(d *FrontMatterDescriptor) {
+	if d.PageConfig == nil {
+		panic("missing pageConfig")
+	}
+
+	for _, identifier := range f.fmConfig.TranslationKey {
+		var key string
+		if identifier == fmFilename {
+			key = d.BaseFilename
+		} else {
+			key = cast.ToString(d.PageConfig.Params[identifier])
+		}
+		if key != "" {
+			d.PageConfig.TranslationKey = key
+			return
+		}
+	}
+}
+
 // A Zero date is a signal that the name can not be parsed.
 // This follows the format as outlined in Jekyll, https://jekyllrb.com/docs/posts/:
 // "Where YEAR is a four-digit number, MONTH and DAY are both two-digit numbers"
@@ -218,6 +248,8 @@ type FrontmatterConfig struct {
 	PublishDate []string
 	// Controls how the ExpiryDate is set from front matter.
 	ExpiryDate []string
+	// Controls how the TranslationKey is set from front matter.
+	TranslationKey []string
 }
 
 const (
@@ -228,6 +260,8 @@ const (
 	fmLastmod    = "lastmod"
 	fmExpiryDate = "expirydate"
 
+	fmTranslationKey = "translationkey"
+
 	// Gets date from filename, e.g 218-02-22-mypage.md
 	fmFilename = ":filename"
 
@@ -245,6 +279,8 @@ func newDefaultFrontmatterConfig() FrontmatterConfig {
 		Lastmod:     []string{fmGitAuthorDate, fmLastmod, fmDate, fmPubDate},
 		PublishDate: []string{fmPubDate, fmDate},
 		ExpiryDate:  []string{fmExpiryDate},
+
+		TranslationKey: []string{fmTranslationKey},
 	}
 }
 
@@ -265,6 +301,8 @@ func DecodeFrontMatterConfig(cfg config.Provider) (FrontmatterConfig, error) {
 				c.Lastmod = toLowerSlice(v)
 			case fmExpiryDate:
 				c.ExpiryDate = toLowerSlice(v)
+			case fmTranslationKey:
+				c.TranslationKey = toLowerSlice(v)
 			}
 		}
 	}
@@ -279,6 +317,7 @@ func DecodeFrontMatterConfig(cfg config.Provider) (FrontmatterConfig, error) {
 	c.PublishDate = expander(c.PublishDate, defaultConfig.PublishDate)
 	c.Lastmod = expander(c.Lastmod, defaultConfig.Lastmod)
 	c.ExpiryDate = expander(c.ExpiryDate, defaultConfig.ExpiryDate)
+	c.TranslationKey = expandDefaultValues(c.TranslationKey, defaultConfig.TranslationKey)
 
 	return c, nil
 }
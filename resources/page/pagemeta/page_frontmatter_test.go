@@ -148,3 +148,40 @@ func TestFrontMatterDatesDefaultKeyword(t *testing.T) {
 	c.Assert(d.PageConfig.Dates.PublishDate.Day(), qt.Equals, 4)
 	c.Assert(d.PageConfig.Dates.ExpiryDate.IsZero(), qt.Equals, true)
 }
+
+func TestFrontMatterTranslationKey(t *testing.T) {
+	t.Parallel()
+
+	c := qt.New(t)
+
+	cfg := config.New()
+
+	cfg.Set("frontmatter", map[string]any{
+		"translationKey": []string{":default", "Slug", ":filename"},
+	})
+
+	conf := testconfig.GetTestConfig(nil, cfg)
+	fc := conf.GetConfigSection("frontmatter").(pagemeta.FrontmatterConfig)
+	c.Assert(fc.TranslationKey, qt.DeepEquals, []string{"translationkey", "slug", ":filename"})
+
+	handler, err := pagemeta.NewFrontmatterHandler(nil, fc)
+	c.Assert(err, qt.IsNil)
+
+	d := newTestFd()
+	d.BaseFilename = "mypage"
+	d.PageConfig.Params["translationkey"] = "mykey"
+	d.PageConfig.Params["slug"] = "myslug"
+	handler.HandleTranslationKey(d)
+	c.Assert(d.PageConfig.TranslationKey, qt.Equals, "mykey")
+
+	d = newTestFd()
+	d.BaseFilename = "mypage"
+	d.PageConfig.Params["slug"] = "myslug"
+	handler.HandleTranslationKey(d)
+	c.Assert(d.PageConfig.TranslationKey, qt.Equals, "myslug")
+
+	d = newTestFd()
+	d.BaseFilename = "mypage"
+	handler.HandleTranslationKey(d)
+	c.Assert(d.PageConfig.TranslationKey, qt.Equals, "mypage")
+}
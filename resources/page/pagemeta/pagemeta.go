@@ -26,6 +26,7 @@ const (
 
 var defaultBuildConfig = BuildConfig{
 	List:             Always,
+	ListInTerms:      true,
 	Render:           Always,
 	PublishResources: true,
 	set:              true,
@@ -42,6 +43,12 @@ type BuildConfig struct {
 	// Note: before 0.57.2 this was a bool, so we accept those too.
 	List string
 
+	// Whether to include it in the page collections of the taxonomy terms
+	// it is assigned to, e.g. the .Pages of /tags/foo.
+	// The term assignment is kept, so the page is still available in
+	// .Site.Taxonomies, .GetTerms and related content.
+	ListInTerms bool
+
 	// Whether to render it.
 	// Valid values: never, always, link.
 	// The value link means it will not be rendered, but it will get a RelPermalink/Permalink.
@@ -59,6 +66,7 @@ type BuildConfig struct {
 // Disable sets all options to their off value.
 func (b *BuildConfig) Disable() {
 	b.List = Never
+	b.ListInTerms = false
 	b.Render = Never
 	b.PublishResources = false
 	b.set = true
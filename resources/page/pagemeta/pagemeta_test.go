@@ -45,6 +45,7 @@ publishResources = true`
 			BuildConfig{
 				Render:           Always,
 				List:             Always,
+				ListInTerms:      true,
 				PublishResources: true,
 				set:              true,
 			},
@@ -52,30 +53,35 @@ publishResources = true`
 		{[]any{"true", "false"}, BuildConfig{
 			Render:           Always,
 			List:             Never,
+			ListInTerms:      true,
 			PublishResources: true,
 			set:              true,
 		}},
 		{[]any{`"always"`, `"always"`}, BuildConfig{
 			Render:           Always,
 			List:             Always,
+			ListInTerms:      true,
 			PublishResources: true,
 			set:              true,
 		}},
 		{[]any{`"never"`, `"never"`}, BuildConfig{
 			Render:           Never,
 			List:             Never,
+			ListInTerms:      true,
 			PublishResources: true,
 			set:              true,
 		}},
 		{[]any{`"link"`, `"local"`}, BuildConfig{
 			Render:           Link,
 			List:             ListLocally,
+			ListInTerms:      true,
 			PublishResources: true,
 			set:              true,
 		}},
 		{[]any{`"always"`, `"asdfadf"`}, BuildConfig{
 			Render:           Always,
 			List:             Always,
+			ListInTerms:      true,
 			PublishResources: true,
 			set:              true,
 		}},
@@ -94,10 +94,7 @@ var (
 			if p1.Date().Unix() == p2.Date().Unix() {
 				c := collatorStringCompare(func(p Page) string { return p.LinkTitle() }, p1, p2)
 				if c == 0 {
-					if p1.File() == nil || p2.File() == nil {
-						return p1.File() == nil
-					}
-					return compare.LessStrings(p1.File().Filename(), p2.File().Filename())
+					return lessPageFilename(p1, p2)
 				}
 				return c < 0
 			}
@@ -120,9 +117,7 @@ var (
 			if p1.Date().Unix() == p2.Date().Unix() {
 				c := compare.Strings(p1.LinkTitle(), p2.LinkTitle())
 				if c == 0 {
-					if p1.File() != nil && p2.File() != nil {
-						return compare.LessStrings(p1.File().Filename(), p2.File().Filename())
-					}
+					return lessPageFilename(p1, p2)
 				}
 				return c < 0
 			}
@@ -140,6 +135,44 @@ var (
 		return p1.Language().Weight < p2.Language().Weight
 	}
 
+	// lessPageFilename sorts by the full file path, falling back to lessPagePath
+	// for pages without a file.
+	lessPageFilename = func(p1, p2 Page) bool {
+		if p1.File() != nil && p2.File() != nil && p1.File().Filename() != p2.File().Filename() {
+			return compare.LessStrings(p1.File().Filename(), p2.File().Filename())
+		}
+		return lessPagePath(p1, p2)
+	}
+
+	// lessPagePath is the final tie-break in the page sorts, so pages with
+	// equal sort keys are always ordered the same way.
+	lessPagePath = func(p1, p2 Page) bool {
+		if p1.Path() != p2.Path() {
+			return compare.LessStrings(p1.Path(), p2.Path())
+		}
+		if p1.Lang() != p2.Lang() {
+			return compare.LessStrings(p1.Lang(), p2.Lang())
+		}
+		if p1.File() == nil || p2.File() == nil {
+			return p1.File() == nil && p2.File() != nil
+		}
+		return compare.LessStrings(p1.File().Filename(), p2.File().Filename())
+	}
+
+	// lessPageWeight sorts pages with a zero weight last.
+	lessPageWeight = func(p1, p2 Page) bool {
+		if p1.Weight() == p2.Weight() {
+			return false
+		}
+		if p2.Weight() == 0 {
+			return true
+		}
+		if p1.Weight() == 0 {
+			return false
+		}
+		return p1.Weight() < p2.Weight()
+	}
+
 	lessPageTitle = func(p1, p2 Page) bool {
 		return collatorStringCompare(func(p Page) string { return p.Title() }, p1, p2) < 0
 	}
@@ -157,6 +190,22 @@ var (
 	}
 )
 
+// thenBy returns a pageBy that tries each of the given funcs in turn until the
+// pages are not equal, using the page path as the final tie-break.
+func thenBy(bys ...pageBy) pageBy {
+	return func(p1, p2 Page) bool {
+		for _, by := range bys {
+			if by(p1, p2) {
+				return true
+			}
+			if by(p2, p1) {
+				return false
+			}
+		}
+		return lessPagePath(p1, p2)
+	}
+}
+
 func (ps *pageSorter) Len() int      { return len(ps.pages) }
 func (ps *pageSorter) Swap(i, j int) { ps.pages[i], ps.pages[j] = ps.pages[j], ps.pages[i] }
 
@@ -184,7 +233,10 @@ var collatorStringSort = func(getString func(Page) string) func(p Pages) {
 		defer coll.Unlock()
 
 		sort.SliceStable(p, func(i, j int) bool {
-			return coll.CompareStrings(getString(p[i]), getString(p[j])) < 0
+			if c := coll.CompareStrings(getString(p[i]), getString(p[j])); c != 0 {
+				return c < 0
+			}
+			return lessPagePath(p[i], p[j])
 		})
 	}
 }
@@ -205,7 +257,7 @@ var collatorStringLess = func(p Page) (less func(s1, s2 string) bool, close func
 	coll := langs.GetCollator2(currentSite.Language())
 	coll.Lock()
 	return func(s1, s2 string) bool {
-			return coll.CompareStrings(s1, s2) < 1
+			return coll.CompareStrings(s1, s2) < 0
 		},
 		func() {
 			coll.Unlock()
@@ -262,7 +314,48 @@ func (p Pages) ByLinkTitle() Pages {
 func (p Pages) ByDate() Pages {
 	const key = "pageSort.ByDate"
 
-	pages, _ := spc.get(key, pageBy(lessPageDate).Sort, p)
+	pages, _ := spc.get(key, thenBy(lessPageDate).Sort, p)
+
+	return pages
+}
+
+// ByWeightThenDate sorts the Pages by weight, with the zero weights last,
+// and then by date, and returns a copy.
+//
+// Adjacent invocations on the same receiver will return a cached result.
+//
+// This may safely be executed  in parallel.
+func (p Pages) ByWeightThenDate() Pages {
+	const key = "pageSort.ByWeightThenDate"
+
+	pages, _ := spc.get(key, thenBy(lessPageWeight, lessPageDate).Sort, p)
+
+	return pages
+}
+
+// ByWeightThenTitle sorts the Pages by weight, with the zero weights last,
+// and then by title, and returns a copy.
+//
+// Adjacent invocations on the same receiver will return a cached result.
+//
+// This may safely be executed  in parallel.
+func (p Pages) ByWeightThenTitle() Pages {
+	const key = "pageSort.ByWeightThenTitle"
+
+	pages, _ := spc.get(key, thenBy(lessPageWeight, lessPageTitle).Sort, p)
+
+	return pages
+}
+
+// ByDateThenTitle sorts the Pages by date and then by title and returns a copy.
+//
+// Adjacent invocations on the same receiver will return a cached result.
+//
+// This may safely be executed  in parallel.
+func (p Pages) ByDateThenTitle() Pages {
+	const key = "pageSort.ByDateThenTitle"
+
+	pages, _ := spc.get(key, thenBy(lessPageDate, lessPageTitle).Sort, p)
 
 	return pages
 }
@@ -275,7 +368,7 @@ func (p Pages) ByDate() Pages {
 func (p Pages) ByPublishDate() Pages {
 	const key = "pageSort.ByPublishDate"
 
-	pages, _ := spc.get(key, pageBy(lessPagePubDate).Sort, p)
+	pages, _ := spc.get(key, thenBy(lessPagePubDate).Sort, p)
 
 	return pages
 }
@@ -292,7 +385,7 @@ func (p Pages) ByExpiryDate() Pages {
 		return p1.ExpiryDate().Unix() < p2.ExpiryDate().Unix()
 	}
 
-	pages, _ := spc.get(key, pageBy(expDate).Sort, p)
+	pages, _ := spc.get(key, thenBy(expDate).Sort, p)
 
 	return pages
 }
@@ -309,7 +402,7 @@ func (p Pages) ByLastmod() Pages {
 		return p1.Lastmod().Unix() < p2.Lastmod().Unix()
 	}
 
-	pages, _ := spc.get(key, pageBy(date).Sort, p)
+	pages, _ := spc.get(key, thenBy(date).Sort, p)
 
 	return pages
 }
@@ -327,7 +420,7 @@ func (p Pages) ByLength(ctx context.Context) Pages {
 		p2l, ok2 := p2.(resource.LengthProvider)
 
 		if !ok1 {
-			return true
+			return ok2
 		}
 
 		if !ok2 {
@@ -337,7 +430,7 @@ func (p Pages) ByLength(ctx context.Context) Pages {
 		return p1l.Len(ctx) < p2l.Len(ctx)
 	}
 
-	pages, _ := spc.get(key, pageBy(length).Sort, p)
+	pages, _ := spc.get(key, thenBy(length).Sort, p)
 
 	return pages
 }
@@ -425,7 +518,7 @@ func (p Pages) ByParam(paramsKey any) Pages {
 		return stringLess(s1, s2)
 	}
 
-	pages, _ := spc.get(key, pageBy(paramsKeyComparator).Sort, p)
+	pages, _ := spc.get(key, thenBy(paramsKeyComparator).Sort, p)
 
 	return pages
 }
@@ -16,6 +16,7 @@ package page
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"testing"
 	"time"
 
@@ -133,6 +134,108 @@ func TestSortByN(t *testing.T) {
 	}
 }
 
+func TestPageSortStable(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	d := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Pages with equal sort keys in random order.
+	pages := make(Pages, 100)
+	for i := range pages {
+		p := newTestPage()
+		p.path = fmt.Sprintf("/x/p%03d", i)
+		p.title = "Title"
+		p.linkTitle = "Title"
+		p.weight = 5
+		p.date = d
+		p.pubDate = d
+		p.lastMod = d
+		p.params = map[string]any{"foo": "bar"}
+		pages[i] = p
+	}
+	r := rand.New(rand.NewSource(32))
+
+	paths := func(pages Pages) []string {
+		var s []string
+		for _, p := range pages {
+			s = append(s, p.Path())
+		}
+		return s
+	}
+
+	for _, test := range []struct {
+		name string
+		sort func(p Pages) Pages
+	}{
+		{"ByWeight", Pages.ByWeight},
+		{"ByDate", Pages.ByDate},
+		{"ByPublishDate", Pages.ByPublishDate},
+		{"ByLastmod", Pages.ByLastmod},
+		{"ByTitle", Pages.ByTitle},
+		{"ByLinkTitle", Pages.ByLinkTitle},
+		{"ByWeightThenDate", Pages.ByWeightThenDate},
+		{"ByWeightThenTitle", Pages.ByWeightThenTitle},
+		{"ByDateThenTitle", Pages.ByDateThenTitle},
+		{"ByParam", func(p Pages) Pages { return p.ByParam("foo") }},
+	} {
+		c.Run(test.name, func(c *qt.C) {
+			var expect []string
+			for i := 0; i < 5; i++ {
+				shuffled := make(Pages, len(pages))
+				copy(shuffled, pages)
+				r.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+				got := paths(test.sort(shuffled))
+				if expect == nil {
+					expect = got
+					c.Assert(got[0], qt.Equals, "/x/p000")
+					c.Assert(got[99], qt.Equals, "/x/p099")
+					continue
+				}
+				c.Assert(got, qt.DeepEquals, expect)
+			}
+		})
+	}
+}
+
+func TestPageSortComposite(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	d1 := time.Now()
+	d2 := d1.Add(-1 * time.Hour)
+	d3 := d1.Add(-2 * time.Hour)
+	d4 := d1.Add(-3 * time.Hour)
+
+	p := createSortTestPages(4)
+	setSortVals([4]time.Time{d1, d2, d3, d4}, [4]string{"b", "a", "d", "c"}, [4]int{2, 0, 2, 1}, p)
+
+	weights := func(pages Pages) []int {
+		var s []int
+		for _, p := range pages {
+			s = append(s, p.Weight())
+		}
+		return s
+	}
+
+	sorted := p.ByWeightThenDate()
+	c.Assert(weights(sorted), qt.DeepEquals, []int{1, 2, 2, 0})
+	c.Assert(sorted[1].Date(), qt.Equals, d3)
+	c.Assert(sorted[2].Date(), qt.Equals, d1)
+
+	sorted = p.ByWeightThenTitle()
+	c.Assert(weights(sorted), qt.DeepEquals, []int{1, 2, 2, 0})
+	c.Assert(sorted[1].Title(), qt.Equals, "b")
+	c.Assert(sorted[2].Title(), qt.Equals, "d")
+
+	setSortVals([4]time.Time{d1, d1, d2, d2}, [4]string{"b", "a", "d", "c"}, [4]int{1, 1, 1, 1}, p)
+	sorted = p.ByDateThenTitle()
+	c.Assert(sorted[0].Title(), qt.Equals, "c")
+	c.Assert(sorted[1].Title(), qt.Equals, "d")
+	c.Assert(sorted[2].Title(), qt.Equals, "a")
+	c.Assert(sorted[3].Title(), qt.Equals, "b")
+}
+
 func TestLimit(t *testing.T) {
 	t.Parallel()
 	c := qt.New(t)
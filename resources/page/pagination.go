@@ -19,6 +19,7 @@ import (
 	"math"
 	"reflect"
 
+	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/config"
 
 	"github.com/spf13/cast"
@@ -188,6 +189,58 @@ func (p *Pager) Last() *Pager {
 	return p.pagers[len(p.pagers)-1]
 }
 
+// PageNumbers returns the page numbers to show in a pagination menu: the first
+// and the last page and a window of pages around the current page, with a 0
+// marking a gap, e.g. [1 0 4 5 6 0 20]. A gap of a single page is filled in
+// with that page number.
+//
+// You can optionally provide an options map as the last argument; set window
+// to the number of pages to show on each side of the current page, default 2.
+func (p *Pager) PageNumbers(options ...any) ([]int, error) {
+	window := 2
+	if len(options) > 1 {
+		return nil, errors.New("too many arguments to PageNumbers")
+	}
+	if len(options) == 1 {
+		m, err := maps.ToStringMapE(options[0])
+		if err != nil {
+			return nil, fmt.Errorf("options must be a map: %w", err)
+		}
+		if v, found := maps.LookupEqualFold(m, "window"); found {
+			window, err = cast.ToIntE(v)
+			if err != nil {
+				return nil, fmt.Errorf("window must be an integer: %w", err)
+			}
+			if window < 0 {
+				return nil, errors.New("window must not be negative")
+			}
+		}
+	}
+
+	return pageNumbers(p.PageNumber(), len(p.pagers), window), nil
+}
+
+func pageNumbers(current, total, window int) []int {
+	include := func(n int) bool {
+		return n == 1 || n == total || (n >= current-window && n <= current+window)
+	}
+
+	var numbers []int
+	for n := 1; n <= total; n++ {
+		switch {
+		case include(n):
+			numbers = append(numbers, n)
+		case include(n-1) && include(n+1):
+			// Don't replace a single page with a gap.
+			numbers = append(numbers, n)
+		case include(n - 1):
+			numbers = append(numbers, 0)
+		}
+	}
+
+	return numbers
+}
+
 // Pagers returns a list of pagers that can be used to build a pagination menu.
 func (p *Paginator) Pagers() pagers {
 	return p.pagers
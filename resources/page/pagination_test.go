@@ -142,6 +142,43 @@ func doTestPages(t *testing.T, paginator *Paginator) {
 	c.Assert(last.PageNumber(), qt.Equals, 5)
 }
 
+func TestPageNumbers(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	for _, test := range []struct {
+		current, total, window int
+		expect                 []int
+	}{
+		{1, 1, 2, []int{1}},
+		{1, 5, 2, []int{1, 2, 3, 4, 5}},
+		{4, 7, 2, []int{1, 2, 3, 4, 5, 6, 7}},
+		{1, 7, 2, []int{1, 2, 3, 0, 7}},
+		{5, 20, 2, []int{1, 2, 3, 4, 5, 6, 7, 0, 20}},
+		{6, 20, 2, []int{1, 0, 4, 5, 6, 7, 8, 0, 20}},
+		{20, 20, 2, []int{1, 0, 18, 19, 20}},
+		{6, 20, 0, []int{1, 0, 6, 0, 20}},
+		{10, 20, 1, []int{1, 0, 9, 10, 11, 0, 20}},
+	} {
+		c.Assert(pageNumbers(test.current, test.total, test.window), qt.DeepEquals, test.expect, qt.Commentf("%+v", test))
+	}
+
+	pag, err := newPaginatorFromPages(createTestPages(21), 2, func(page int) string { return "" })
+	c.Assert(err, qt.IsNil)
+	pager := pag.Pagers()[5]
+
+	numbers, err := pager.PageNumbers()
+	c.Assert(err, qt.IsNil)
+	c.Assert(numbers, qt.DeepEquals, []int{1, 0, 4, 5, 6, 7, 8, 0, 11})
+
+	numbers, err = pager.PageNumbers(map[string]any{"window": 1})
+	c.Assert(err, qt.IsNil)
+	c.Assert(numbers, qt.DeepEquals, []int{1, 0, 5, 6, 7, 0, 11})
+
+	_, err = pager.PageNumbers(map[string]any{"window": -1})
+	c.Assert(err, qt.Not(qt.IsNil))
+}
+
 func TestPagerNoPages(t *testing.T) {
 	t.Parallel()
 	c := qt.New(t)
@@ -56,7 +56,7 @@ func (p PermalinkExpander) callback(attr string) (pageToPermaAttribute, bool) {
 	if strings.HasPrefix(attr, "sections[") {
 		fn := p.toSliceFunc(strings.TrimPrefix(attr, "sections"))
 		return func(p Page, s string) (string, error) {
-			return path.Join(fn(p.CurrentSection().SectionsEntries())...), nil
+			return path.Join(fn(p.SectionsEntries())...), nil
 		}, true
 	}
 
@@ -297,7 +297,7 @@ func (l PermalinkExpander) pageToPermalinkSection(p Page, _ string) (string, err
 }
 
 func (l PermalinkExpander) pageToPermalinkSections(p Page, _ string) (string, error) {
-	return p.CurrentSection().SectionsPath(), nil
+	return p.SectionsPath(), nil
 }
 
 func (l PermalinkExpander) translationBaseName(p Page) string {
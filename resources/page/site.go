@@ -14,6 +14,7 @@
 package page
 
 import (
+	"context"
 	"html/template"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/gohugoio/hugo/common/hugo"
 	"github.com/gohugoio/hugo/langs"
 	"github.com/gohugoio/hugo/navigation"
+	"github.com/gohugoio/hugo/resources/resource"
 )
 
 // Site represents a site. There can be multople sites in a multilingual setup.
@@ -39,6 +41,9 @@ type Site interface {
 
 	GetPage(ref ...string) (Page, error)
 
+	// GetPages returns all pages with a logical path matching the given Glob pattern.
+	GetPages(pattern string) (Pages, error)
+
 	// AllPages returns all pages for all languages.
 	AllPages() Pages
 
@@ -135,6 +140,9 @@ type Site interface {
 	// LanguagePrefix returns the language prefix for this site.
 	LanguagePrefix() string
 
+	// SearchIndex returns a JSON resource with a search document for each page.
+	SearchIndex(ctx context.Context, options ...any) (resource.Resource, error)
+
 	// Deprecated. Use site.Home.OutputFormats.Get "rss" instead.
 	RSSLink() template.URL
 }
@@ -189,6 +197,10 @@ func (s *siteWrapper) GetPage(ref ...string) (Page, error) {
 	return s.s.GetPage(ref...)
 }
 
+func (s *siteWrapper) GetPages(pattern string) (Pages, error) {
+	return s.s.GetPages(pattern)
+}
+
 func (s *siteWrapper) Language() *langs.Language {
 	return s.s.Language()
 }
@@ -311,6 +323,10 @@ func (s *siteWrapper) RSSLink() template.URL {
 	return s.s.RSSLink()
 }
 
+func (s *siteWrapper) SearchIndex(ctx context.Context, options ...any) (resource.Resource, error) {
+	return s.s.SearchIndex(ctx, options...)
+}
+
 // For internal use only.
 func (s *siteWrapper) ForEeachIdentityByName(name string, f func(identity.Identity) bool) {
 	s.s.(identity.ForEeachIdentityByNameProvider).ForEeachIdentityByName(name, f)
@@ -373,6 +389,10 @@ func (t testSite) GetPage(ref ...string) (Page, error) {
 	return nil, nil
 }
 
+func (t testSite) GetPages(pattern string) (Pages, error) {
+	return nil, nil
+}
+
 func (t testSite) Current() Site {
 	return t
 }
@@ -464,6 +484,10 @@ func (s testSite) RSSLink() template.URL {
 	return ""
 }
 
+func (s testSite) SearchIndex(ctx context.Context, options ...any) (resource.Resource, error) {
+	return nil, nil
+}
+
 // NewDummyHugoSite creates a new minimal test site.
 func NewDummyHugoSite(conf config.AllProvider) Site {
 	return testSite{
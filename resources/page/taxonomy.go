@@ -64,6 +64,10 @@ func (i Taxonomy) Get(key string) WeightedPages {
 // Count the weighted pages for the given key.
 func (i Taxonomy) Count(key string) int { return len(i[key]) }
 
+// CountListed counts the weighted pages for the given key that are listed
+// in the term's page collections, see the listInTerms build option.
+func (i Taxonomy) CountListed(key string) int { return i[key].CountListed() }
+
 // TaxonomyArray returns an ordered taxonomy with a non defined order.
 func (i Taxonomy) TaxonomyArray() OrderedTaxonomy {
 	ies := make([]OrderedTaxonomyEntry, len(i))
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/gohugoio/hugo/markup/tableofcontents"
+	"github.com/gohugoio/hugo/parser/metadecoders"
 
 	"github.com/gohugoio/hugo/resources/resource"
 
@@ -67,9 +68,10 @@ func newTestPageWithFile(filename string) *testPage {
 	}
 
 	return &testPage{
-		params: make(map[string]any),
-		data:   make(map[string]any),
-		file:   file,
+		params:         make(map[string]any),
+		data:           make(map[string]any),
+		file:           file,
+		sectionEntries: []string{"a", "b", "c"},
 		currentSection: &testPage{
 			sectionEntries: []string{"a", "b", "c"},
 		},
@@ -123,6 +125,10 @@ func (p *testPage) AllTranslations() Pages {
 	panic("testpage: not implemented")
 }
 
+func (p *testPage) Hreflangs() Hreflangs {
+	panic("testpage: not implemented")
+}
+
 func (p *testPage) AlternativeOutputFormats() OutputFormats {
 	panic("testpage: not implemented")
 }
@@ -223,6 +229,10 @@ func (p *testPage) GetPage(ref string) (Page, error) {
 	panic("testpage: not implemented")
 }
 
+func (p *testPage) GetPages(pattern string) (Pages, error) {
+	panic("testpage: not implemented")
+}
+
 func (p *testPage) GetParam(key string) any {
 	panic("testpage: not implemented")
 }
@@ -239,6 +249,10 @@ func (p *testPage) GitInfo() source.GitInfo {
 	return source.GitInfo{}
 }
 
+func (p *testPage) Contributors() []source.GitContributor {
+	return nil
+}
+
 func (p *testPage) CodeOwners() []string {
 	return nil
 }
@@ -299,6 +313,10 @@ func (p *testPage) Ancestors() Pages {
 	panic("testpage: not implemented")
 }
 
+func (p *testPage) Descendants() Pages {
+	panic("testpage: not implemented")
+}
+
 func (p *testPage) Keywords() []string {
 	return nil
 }
@@ -369,10 +387,18 @@ func (p *testPage) NextInSection() Page {
 	return nil
 }
 
+func (p *testPage) NextInTerm(taxonomy, term string) Page {
+	return nil
+}
+
 func (p *testPage) NextPage() Page {
 	return nil
 }
 
+func (p *testPage) OutputFormat() OutputFormat {
+	panic("testpage: not implemented")
+}
+
 func (p *testPage) OutputFormats() OutputFormats {
 	panic("testpage: not implemented")
 }
@@ -429,6 +455,10 @@ func (p *testPage) Plain(context.Context) string {
 	panic("testpage: not implemented")
 }
 
+func (p *testPage) PlainText(context.Context, ...any) (string, error) {
+	panic("testpage: not implemented")
+}
+
 func (p *testPage) PlainWords(context.Context) []string {
 	panic("testpage: not implemented")
 }
@@ -441,6 +471,10 @@ func (p *testPage) PrevInSection() Page {
 	return nil
 }
 
+func (p *testPage) PrevInTerm(taxonomy, term string) Page {
+	return nil
+}
+
 func (p *testPage) PrevPage() Page {
 	return nil
 }
@@ -453,10 +487,18 @@ func (p *testPage) RSSLink() template.URL {
 	return ""
 }
 
+func (p *testPage) ContentWithoutShortcode(name string) string {
+	panic("testpage: not implemented")
+}
+
 func (p *testPage) RawContent() string {
 	panic("testpage: not implemented")
 }
 
+func (p *testPage) RawFrontMatter() (metadecoders.OrderedMap, error) {
+	panic("testpage: not implemented")
+}
+
 func (p *testPage) RenderShortcodes(context.Context) (template.HTML, error) {
 	panic("testpage: not implemented")
 }
@@ -465,6 +507,10 @@ func (p *testPage) ReadingTime(context.Context) int {
 	panic("testpage: not implemented")
 }
 
+func (p *testPage) ReadingTimeSeconds(context.Context) int {
+	panic("testpage: not implemented")
+}
+
 func (p *testPage) Ref(argsm map[string]any) (string, error) {
 	panic("testpage: not implemented")
 }
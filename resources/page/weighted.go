@@ -53,12 +53,22 @@ type WeightedPage struct {
 	// because we cannot add additional state to the WeightedPages slice
 	// without breaking lots of templates in the wild.
 	owner Page
+
+	// Whether this page is excluded from the owning term's page collections,
+	// see the listInTerms build option.
+	unlisted bool
 }
 
 func NewWeightedPage(weight int, p Page, owner Page) WeightedPage {
 	return WeightedPage{Weight: weight, Page: p, owner: owner}
 }
 
+// NewUnlistedWeightedPage creates a WeightedPage for a page that is assigned
+// to the owning term, but not listed in its page collections.
+func NewUnlistedWeightedPage(weight int, p Page, owner Page) WeightedPage {
+	return WeightedPage{Weight: weight, Page: p, owner: owner, unlisted: true}
+}
+
 func (w WeightedPage) String() string {
 	return fmt.Sprintf("WeightedPage(%d,%q)", w.Weight, w.Page.Title())
 }
@@ -130,6 +140,32 @@ func (wp WeightedPages) Sort() { sort.Stable(wp) }
 // Count returns the number of pages in this weighted page set.
 func (wp WeightedPages) Count() int { return len(wp) }
 
+// Listed returns the pages in this weighted page set that are listed in
+// the owning term's page collections, see the listInTerms build option.
+func (wp WeightedPages) Listed() WeightedPages {
+	if wp.CountListed() == len(wp) {
+		return wp
+	}
+	var listed WeightedPages
+	for _, w := range wp {
+		if !w.unlisted {
+			listed = append(listed, w)
+		}
+	}
+	return listed
+}
+
+// CountListed returns the number of listed pages in this weighted page set.
+func (wp WeightedPages) CountListed() int {
+	var n int
+	for _, w := range wp {
+		if !w.unlisted {
+			n++
+		}
+	}
+	return n
+}
+
 func (wp WeightedPages) Less(i, j int) bool {
 	if wp[i].Weight == wp[j].Weight {
 		return DefaultPageSort(wp[i].Page, wp[j].Page)
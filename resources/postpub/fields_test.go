@@ -41,5 +41,6 @@ func TestCreatePlaceholders(t *testing.T) {
 		"Type":        "pre_foo.Type_post",
 		"MainType":    "pre_foo.MainType_post",
 		"SubType":     "pre_foo.SubType_post",
+		"MimeSuffix":  "pre_foo.MimeSuffix_post",
 	})
 }
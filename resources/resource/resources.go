@@ -52,6 +52,23 @@ func (r Resources) ByType(typ any) Resources {
 	return filtered
 }
 
+// ByMediaType returns resources with a media type matching the given pattern,
+// e.g. "image/svg+xml" or "image/*".
+func (r Resources) ByMediaType(pattern any) Resources {
+	patternstr, err := cast.ToStringE(pattern)
+	if err != nil {
+		panic(err)
+	}
+	var filtered Resources
+
+	for _, resource := range r {
+		if resource.MediaType().Match(patternstr) {
+			filtered = append(filtered, resource)
+		}
+	}
+	return filtered
+}
+
 // Get locates the name given in Resources.
 // The search is case insensitive.
 func (r Resources) Get(name any) Resource {
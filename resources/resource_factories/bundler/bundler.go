@@ -15,14 +15,24 @@
 package bundler
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"path"
+	"path/filepath"
+	"strings"
+	"sync"
 
+	"github.com/gohugoio/hugo/common/herrors"
 	"github.com/gohugoio/hugo/common/hugio"
+	"github.com/gohugoio/hugo/helpers"
 	"github.com/gohugoio/hugo/media"
 	"github.com/gohugoio/hugo/resources"
 	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/afero"
 )
 
 // Client contains methods perform concatenation and other bundling related
@@ -78,8 +88,38 @@ func (r *multiReadSeekCloser) Close() error {
 	return nil
 }
 
+const (
+	sourceMapExternal = "external"
+	sourceMapInline   = "inline"
+)
+
+// Options configures Concat.
+type Options struct {
+	// Whether to merge the source maps of concatenated JavaScript resources.
+	// One of "external" (published next to the target path) or "inline".
+	// Default is no source map.
+	SourceMap string
+}
+
+// DecodeOptions decodes options for Concat from the given map.
+func DecodeOptions(m map[string]any) (opts Options, err error) {
+	if m == nil {
+		return
+	}
+	if err = mapstructure.WeakDecode(m, &opts); err != nil {
+		return
+	}
+	opts.SourceMap = strings.ToLower(opts.SourceMap)
+	switch opts.SourceMap {
+	case "", sourceMapExternal, sourceMapInline:
+	default:
+		err = fmt.Errorf("unsupported sourceMap type: %q", opts.SourceMap)
+	}
+	return
+}
+
 // Concat concatenates the list of Resource objects.
-func (c *Client) Concat(targetPath string, r resource.Resources) (resource.Resource, error) {
+func (c *Client) Concat(targetPath string, r resource.Resources, opts Options) (resource.Resource, error) {
 	targetPath = path.Clean(targetPath)
 	return c.rs.ResourceCache.GetOrCreate(targetPath, func() (resource.Resource, error) {
 		var resolvedm media.Type
@@ -93,6 +133,16 @@ func (c *Client) Concat(targetPath string, r resource.Resources) (resource.Resou
 			resolvedm = r.MediaType()
 		}
 
+		// The combined source map, if any, is published once.
+		var publishSourceMapOnce sync.Once
+		publishSourceMap := func(content []byte) error {
+			var err error
+			publishSourceMapOnce.Do(func() {
+				err = c.publishSourceMap(targetPath+".map", content)
+			})
+			return err
+		}
+
 		concatr := func() (hugio.ReadSeekCloser, error) {
 			var rcsources []hugio.ReadSeekCloser
 			for _, s := range r {
@@ -115,11 +165,14 @@ func (c *Client) Concat(targetPath string, r resource.Resources) (resource.Resou
 			// Arbitrary JavaScript files require a barrier between them to be safely concatenated together.
 			// Without this, the last line of one file can affect the first line of the next file and change how both files are interpreted.
 			if resolvedm.MainType == media.Builtin.JavascriptType.MainType && resolvedm.SubType == media.Builtin.JavascriptType.SubType {
+				if opts.SourceMap != "" {
+					return c.concatJS(targetPath, r, rcsources, opts, publishSourceMap)
+				}
 				readers := make([]hugio.ReadSeekCloser, 2*len(rcsources)-1)
 				j := 0
 				for i := 0; i < len(rcsources); i++ {
 					if i > 0 {
-						readers[j] = hugio.NewReadSeekerNoOpCloserFromString("\n;\n")
+						readers[j] = hugio.NewReadSeekerNoOpCloserFromString(jsSeparator)
 						j++
 					}
 					readers[j] = rcsources[i]
@@ -144,3 +197,146 @@ func (c *Client) Concat(targetPath string, r resource.Resources) (resource.Resou
 		return composite, nil
 	})
 }
+
+// jsSeparator is the barrier between concatenated JavaScript files.
+const jsSeparator = "\n;\n"
+
+// concatJS concatenates the JavaScript sources. If any of the sources has a
+// source map, the source maps are merged into a combined source map that is
+// either inlined or published next to targetPath, as set in opts.
+func (c *Client) concatJS(targetPath string, r resource.Resources, sources []hugio.ReadSeekCloser, opts Options, publishSourceMap func([]byte) error) (hugio.ReadSeekCloser, error) {
+	defer func() {
+		for _, rc := range sources {
+			rc.Close()
+		}
+	}()
+
+	contents := make([]string, len(sources))
+	maps := make([]*sourceMap, len(sources))
+	mapDirs := make([]string, len(sources))
+	var hasSourceMap bool
+
+	for i, rc := range sources {
+		b, err := io.ReadAll(rc)
+		if err != nil {
+			return nil, err
+		}
+		contents[i] = string(b)
+
+		url, content := extractSourceMappingURL(contents[i])
+		if url == "" {
+			continue
+		}
+		sm, mapDir, err := c.resolveSourceMap(r[i], url)
+		if err != nil {
+			c.rs.Logger.Warnf("resources.Concat: failed to read source map %q for %q: %s", url, r[i].Name(), err)
+			continue
+		}
+		if sm == nil {
+			continue
+		}
+		contents[i] = content
+		maps[i], mapDirs[i] = sm, mapDir
+		hasSourceMap = true
+	}
+
+	if !hasSourceMap {
+		return hugio.NewReadSeekerNoOpCloserFromString(strings.Join(contents, jsSeparator)), nil
+	}
+
+	merger := &sourceMapMerger{dir: path.Dir(strings.TrimPrefix(targetPath, "/"))}
+
+	var (
+		sb         strings.Builder
+		lineOffset int
+	)
+	for i, content := range contents {
+		if i > 0 {
+			sb.WriteString(jsSeparator)
+			lineOffset += strings.Count(jsSeparator, "\n")
+		}
+		if err := merger.add(maps[i], mapDirs[i], lineOffset); err != nil {
+			return nil, fmt.Errorf("resources.Concat: invalid source map for %q: %w", r[i].Name(), err)
+		}
+		sb.WriteString(content)
+		lineOffset += strings.Count(content, "\n")
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(merger.sourceMap(path.Base(targetPath))); err != nil {
+		return nil, err
+	}
+
+	var url string
+	if opts.SourceMap == sourceMapInline {
+		url = "data:application/json;base64," + base64.StdEncoding.EncodeToString(bytes.TrimSpace(buf.Bytes()))
+	} else {
+		if err := publishSourceMap(buf.Bytes()); err != nil {
+			return nil, err
+		}
+		url = path.Base(targetPath) + ".map"
+	}
+
+	sb.WriteString("\n//# sourceMappingURL=" + url + "\n")
+
+	return hugio.NewReadSeekerNoOpCloserFromString(sb.String()), nil
+}
+
+// resolveSourceMap resolves the source map with the given URL for the
+// JavaScript resource r, either inlined as a data URL or a file relative to r
+// in the assets or the publish directory. It returns the source map and its
+// directory relative to the publish root, or nil if no source map was found.
+func (c *Client) resolveSourceMap(r resource.Resource, url string) (*sourceMap, string, error) {
+	dir := path.Dir(strings.TrimPrefix(r.Name(), "/"))
+
+	b, isDataURL, err := decodeDataURL(url)
+	if err != nil {
+		return nil, "", err
+	}
+	if isDataURL {
+		sm, err := parseSourceMap(b)
+		return sm, dir, err
+	}
+
+	if strings.Contains(url, ":") || strings.HasPrefix(url, "/") {
+		// Remote or absolute URLs are not supported.
+		return nil, "", nil
+	}
+
+	filename := path.Join(dir, url)
+	for _, fs := range []afero.Fs{c.rs.BaseFs.Assets.Fs, c.rs.BaseFs.PublishFs} {
+		b, err := afero.ReadFile(fs, filepath.FromSlash(filename))
+		if err != nil {
+			if herrors.IsNotExist(err) {
+				continue
+			}
+			return nil, "", err
+		}
+		sm, err := parseSourceMap(b)
+		return sm, path.Dir(filename), err
+	}
+
+	return nil, "", nil
+}
+
+// publishSourceMap publishes the source map content to targetPath.
+func (c *Client) publishSourceMap(targetPath string, content []byte) error {
+	basePaths := c.rs.MultihostTargetBasePaths
+	if len(basePaths) == 0 {
+		basePaths = []string{""}
+	}
+	filenames := make([]string, len(basePaths))
+	for i, basePath := range basePaths {
+		filenames[i] = filepath.FromSlash(path.Join(basePath, targetPath))
+	}
+
+	f, err := helpers.OpenFilesForWriting(c.rs.BaseFs.PublishFs, filenames...)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(content)
+	return err
+}
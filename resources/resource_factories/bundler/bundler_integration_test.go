@@ -0,0 +1,92 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestConcatJSSourceMaps(t *testing.T) {
+	t.Parallel()
+
+	inlineMap := base64.StdEncoding.EncodeToString([]byte(`{"version":3,"sources":["a.ts"],"sourcesContent":["let a = 1;"],"names":[],"mappings":"AAAA;AACA"}`))
+
+	files := `
+-- hugo.toml --
+disableKinds = ["page", "section", "taxonomy", "term", "sitemap", "robotsTXT", "404", "rss"]
+-- assets/js/a.js --
+var a = 1;
+console.log(a);
+//# sourceMappingURL=data:application/json;base64,` + inlineMap + `
+-- assets/js/vendor/b.min.js --
+function foo(){}
+//# sourceMappingURL=b.min.js.map
+-- assets/js/vendor/b.min.js.map --
+{"version":3,"sources":["b.js"],"names":["foo"],"mappings":"AAAAA"}
+-- assets/js/c.js --
+var c = 3;
+-- layouts/index.html --
+{{ $a := resources.Get "js/a.js" }}
+{{ $b := resources.Get "js/vendor/b.min.js" }}
+{{ $c := resources.Get "js/c.js" }}
+{{ $nomaps := slice $c $c | resources.Concat "js/nomaps.js" (dict "sourceMap" "external") }}
+{{ $bundle := slice $a $b $c | resources.Concat "js/bundle.js" (dict "sourceMap" "external") }}
+{{ $inline := slice $b $c | resources.Concat "js/inline.js" (dict "sourceMap" "inline") }}
+{{ $plain := slice $b $c | resources.Concat "js/plain.js" }}
+{{ $content := slice $b $c | resources.Concat "js/content.js" }}
+Bundle: {{ $bundle.RelPermalink }}|No maps: {{ $nomaps.RelPermalink }}|Inline: {{ $inline.RelPermalink }}|Plain: {{ $plain.RelPermalink }}|
+Content: {{ $content.Content | safeJS }}|
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/index.html", "Bundle: /js/bundle.js|No maps: /js/nomaps.js|Inline: /js/inline.js|Plain: /js/plain.js|")
+	b.AssertFileContentExact("public/js/bundle.js", "var a = 1;\nconsole.log(a);\n\n;\nfunction foo(){}\n\n;\nvar c = 3;\n//# sourceMappingURL=bundle.js.map\n")
+	b.AssertFileContentExact("public/js/bundle.js.map", `{"version":3,"file":"bundle.js","sources":["a.ts","vendor/b.js"],"sourcesContent":["let a = 1;",null],"names":["foo"],"mappings":"AAAA;AACA;;;ACDAA"}`)
+	b.AssertFileContentExact("public/js/nomaps.js", "var c = 3;\n;\nvar c = 3;")
+	b.AssertFileExists("public/js/nomaps.js.map", false)
+
+	inlined := base64.StdEncoding.EncodeToString([]byte(`{"version":3,"file":"inline.js","sources":["vendor/b.js"],"names":["foo"],"mappings":"AAAAA"}`))
+	b.AssertFileContentExact("public/js/inline.js", "function foo(){}\n\n;\nvar c = 3;\n//# sourceMappingURL=data:application/json;base64,"+inlined+"\n")
+	b.AssertFileExists("public/js/inline.js.map", false)
+
+	// No source map unless requested.
+	b.AssertFileContentExact("public/js/plain.js", "function foo(){}\n//# sourceMappingURL=b.min.js.map\n;\nvar c = 3;")
+	b.AssertFileExists("public/js/plain.js.map", false)
+	b.AssertFileContent("public/index.html", "Content: function foo(){}")
+	b.AssertFileExists("public/js/content.js", false)
+	b.AssertFileExists("public/js/content.js.map", false)
+}
+
+func TestConcatOptionsInvalid(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["page", "section", "taxonomy", "term", "sitemap", "robotsTXT", "404", "rss"]
+-- assets/js/a.js --
+var a = 1;
+-- layouts/index.html --
+{{ $a := resources.Get "js/a.js" }}
+{{ $js := slice $a | resources.Concat "js/bundle.js" (dict "sourceMap" "linked") }}
+`
+
+	b, err := hugolib.TestE(t, files)
+	b.Assert(err, qt.Not(qt.IsNil))
+	b.Assert(err.Error(), qt.Contains, `unsupported sourceMap type: "linked"`)
+}
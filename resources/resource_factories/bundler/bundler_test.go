@@ -17,6 +17,7 @@ import (
 	"testing"
 
 	"github.com/gohugoio/hugo/helpers"
+	"github.com/gohugoio/hugo/htesting/hqt"
 
 	qt "github.com/frankban/quicktest"
 	"github.com/gohugoio/hugo/common/hugio"
@@ -38,3 +39,55 @@ func TestMultiReadSeekCloser(t *testing.T) {
 		c.Assert(err, qt.IsNil)
 	}
 }
+
+func TestSourceMapMappings(t *testing.T) {
+	c := qt.New(t)
+
+	for _, mappings := range []string{
+		"",
+		"AAAA",
+		"AAAA;AACA;;;ACDAA",
+		"AAAAA,SAASC,cAAc;;AAGhB,gCAAgC",
+		";;gBAAgB,CAAC",
+	} {
+		lines, err := decodeMappings(mappings)
+		c.Assert(err, qt.IsNil)
+		c.Assert(encodeMappings(lines), qt.Equals, mappings)
+	}
+
+	lines, err := decodeMappings("AAAA,EAAE;ACDAA")
+	c.Assert(err, qt.IsNil)
+	c.Assert(lines, qt.CmpEquals(hqt.DeepAllowUnexported(sourceMapSegment{})), [][]sourceMapSegment{
+		{{fields: 4}, {fields: 4, generatedCol: 2, originalColumn: 2}},
+		{{fields: 5, sourceIdx: 1, originalLine: -1, originalColumn: 2}},
+	})
+
+	_, err = decodeMappings("AA")
+	c.Assert(err, qt.Not(qt.IsNil))
+	_, err = decodeMappings("A!AA")
+	c.Assert(err, qt.Not(qt.IsNil))
+	_, err = decodeMappings("g")
+	c.Assert(err, qt.Not(qt.IsNil))
+}
+
+func TestSourceMapRelPath(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(relPath("js", "js/a.ts"), qt.Equals, "a.ts")
+	c.Assert(relPath("js", "js/vendor/b.js"), qt.Equals, "vendor/b.js")
+	c.Assert(relPath("js/vendor", "js/a.ts"), qt.Equals, "../a.ts")
+	c.Assert(relPath(".", "src/a.ts"), qt.Equals, "src/a.ts")
+	c.Assert(relPath("js", "../src/a.ts"), qt.Equals, "../../src/a.ts")
+}
+
+func TestExtractSourceMappingURL(t *testing.T) {
+	c := qt.New(t)
+
+	url, content := extractSourceMappingURL("var a;\n//# sourceMappingURL=a.js.map\n")
+	c.Assert(url, qt.Equals, "a.js.map")
+	c.Assert(content, qt.Equals, "var a;\n\n")
+
+	url, content = extractSourceMappingURL("var a = '//# sourceMappingURL=a.js.map';\n")
+	c.Assert(url, qt.Equals, "")
+	c.Assert(content, qt.Equals, "var a = '//# sourceMappingURL=a.js.map';\n")
+}
@@ -0,0 +1,331 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// sourceMappingURLRe matches a source map comment in JavaScript, e.g.
+// "//# sourceMappingURL=main.js.map".
+var sourceMappingURLRe = regexp.MustCompile(`(?m)^[ \t]*//[#@][ \t]+sourceMappingURL=([^\s'"]+)[ \t]*\r?$`)
+
+// sourceMap is a source map, see https://sourcemaps.info/spec.html.
+type sourceMap struct {
+	Version        int       `json:"version"`
+	File           string    `json:"file,omitempty"`
+	SourceRoot     string    `json:"sourceRoot,omitempty"`
+	Sources        []string  `json:"sources"`
+	SourcesContent []*string `json:"sourcesContent,omitempty"`
+	Names          []string  `json:"names"`
+	Mappings       string    `json:"mappings"`
+
+	// Index maps are not supported.
+	Sections []any `json:"sections,omitempty"`
+}
+
+func parseSourceMap(b []byte) (*sourceMap, error) {
+	var m sourceMap
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	if m.Version != 3 {
+		return nil, fmt.Errorf("unsupported source map version %d", m.Version)
+	}
+	if m.Sections != nil {
+		return nil, errors.New("index source maps are not supported")
+	}
+	return &m, nil
+}
+
+// extractSourceMappingURL returns the source map URL in the last source map
+// comment in content, and content with all source map comments blanked out.
+// The line count of content is preserved.
+func extractSourceMappingURL(content string) (string, string) {
+	var url string
+	content = sourceMappingURLRe.ReplaceAllStringFunc(content, func(s string) string {
+		url = sourceMappingURLRe.FindStringSubmatch(s)[1]
+		return ""
+	})
+	return url, content
+}
+
+// decodeDataURL decodes a base64 encoded JSON data URL, as used for inline
+// source maps.
+func decodeDataURL(url string) ([]byte, bool, error) {
+	if !strings.HasPrefix(url, "data:") {
+		return nil, false, nil
+	}
+	header, data, found := strings.Cut(strings.TrimPrefix(url, "data:"), ",")
+	if !found || !strings.HasSuffix(header, ";base64") {
+		return nil, true, errors.New("source map data URL must be base64 encoded")
+	}
+	b, err := base64.StdEncoding.DecodeString(data)
+	return b, true, err
+}
+
+// sourceMapSegment is a decoded mapping segment with absolute values.
+// Fields is the number of fields set, 1, 4 or 5.
+type sourceMapSegment struct {
+	fields         int
+	generatedCol   int
+	sourceIdx      int
+	originalLine   int
+	originalColumn int
+	nameIdx        int
+}
+
+// decodeMappings decodes the mappings of a source map into segments per
+// generated line.
+func decodeMappings(mappings string) ([][]sourceMapSegment, error) {
+	var (
+		lines [][]sourceMapSegment
+		state [5]int
+	)
+
+	for _, line := range strings.Split(mappings, ";") {
+		var segments []sourceMapSegment
+		state[0] = 0
+		for _, s := range strings.Split(line, ",") {
+			if s == "" {
+				continue
+			}
+			values, err := decodeVLQ(s)
+			if err != nil {
+				return nil, err
+			}
+			if n := len(values); n != 1 && n != 4 && n != 5 {
+				return nil, fmt.Errorf("invalid mapping segment %q", s)
+			}
+			for i, v := range values {
+				state[i] += v
+			}
+			segments = append(segments, sourceMapSegment{
+				fields:         len(values),
+				generatedCol:   state[0],
+				sourceIdx:      state[1],
+				originalLine:   state[2],
+				originalColumn: state[3],
+				nameIdx:        state[4],
+			})
+		}
+		lines = append(lines, segments)
+	}
+
+	return lines, nil
+}
+
+// encodeMappings is the inverse of decodeMappings.
+func encodeMappings(lines [][]sourceMapSegment) string {
+	var (
+		sb    strings.Builder
+		state [5]int
+	)
+
+	for i, segments := range lines {
+		if i > 0 {
+			sb.WriteByte(';')
+		}
+		state[0] = 0
+		for j, seg := range segments {
+			if j > 0 {
+				sb.WriteByte(',')
+			}
+			values := [5]int{seg.generatedCol, seg.sourceIdx, seg.originalLine, seg.originalColumn, seg.nameIdx}
+			for k := 0; k < seg.fields; k++ {
+				encodeVLQ(&sb, values[k]-state[k])
+				state[k] = values[k]
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+const base64Chars = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+func decodeVLQ(s string) ([]int, error) {
+	var (
+		values []int
+		value  int
+		shift  uint
+	)
+	for i := 0; i < len(s); i++ {
+		digit := strings.IndexByte(base64Chars, s[i])
+		if digit == -1 {
+			return nil, fmt.Errorf("invalid base64 VLQ character %q", s[i])
+		}
+		value += (digit & 31) << shift
+		if digit&32 != 0 {
+			shift += 5
+			continue
+		}
+		if value&1 != 0 {
+			value = -(value >> 1)
+		} else {
+			value >>= 1
+		}
+		values = append(values, value)
+		value, shift = 0, 0
+	}
+	if shift != 0 {
+		return nil, fmt.Errorf("incomplete base64 VLQ %q", s)
+	}
+	return values, nil
+}
+
+func encodeVLQ(sb *strings.Builder, value int) {
+	if value < 0 {
+		value = (-value << 1) | 1
+	} else {
+		value <<= 1
+	}
+	for {
+		digit := value & 31
+		value >>= 5
+		if value > 0 {
+			digit |= 32
+		}
+		sb.WriteByte(base64Chars[digit])
+		if value == 0 {
+			break
+		}
+	}
+}
+
+// sourceMapMerger merges the source maps of concatenated files into one.
+type sourceMapMerger struct {
+	// The directory of the combined source map, relative to the publish root.
+	dir string
+
+	sources        []string
+	sourcesContent []*string
+	names          []string
+	lines          [][]sourceMapSegment
+
+	hasSourcesContent bool
+}
+
+// add adds the source map sm, which may be nil, for a file starting at the
+// given generated line. mapDir is the directory of sm relative to the
+// publish root, used to resolve relative sources.
+func (m *sourceMapMerger) add(sm *sourceMap, mapDir string, lineOffset int) error {
+	if sm == nil {
+		return nil
+	}
+
+	lines, err := decodeMappings(sm.Mappings)
+	if err != nil {
+		return err
+	}
+
+	sourcesOffset, namesOffset := len(m.sources), len(m.names)
+
+	for i, source := range sm.Sources {
+		m.sources = append(m.sources, m.resolveSource(sm.SourceRoot, source, mapDir))
+		var content *string
+		if i < len(sm.SourcesContent) {
+			content = sm.SourcesContent[i]
+		}
+		if content != nil {
+			m.hasSourcesContent = true
+		}
+		m.sourcesContent = append(m.sourcesContent, content)
+	}
+	m.names = append(m.names, sm.Names...)
+
+	for len(m.lines) < lineOffset+len(lines) {
+		m.lines = append(m.lines, nil)
+	}
+
+	for i, segments := range lines {
+		for _, seg := range segments {
+			if seg.fields > 1 {
+				seg.sourceIdx += sourcesOffset
+			}
+			if seg.fields > 4 {
+				seg.nameIdx += namesOffset
+			}
+			m.lines[lineOffset+i] = append(m.lines[lineOffset+i], seg)
+		}
+	}
+
+	return nil
+}
+
+// resolveSource makes source relative to the combined source map.
+func (m *sourceMapMerger) resolveSource(sourceRoot, source, mapDir string) string {
+	if sourceRoot != "" {
+		source = strings.TrimSuffix(sourceRoot, "/") + "/" + source
+	}
+	if strings.Contains(source, ":") || strings.HasPrefix(source, "/") {
+		// An URL or absolute path.
+		return source
+	}
+	return relPath(m.dir, path.Join(mapDir, source))
+}
+
+func (m *sourceMapMerger) sourceMap(file string) *sourceMap {
+	sm := &sourceMap{
+		Version:  3,
+		File:     file,
+		Sources:  m.sources,
+		Names:    m.names,
+		Mappings: encodeMappings(m.lines),
+	}
+	if sm.Sources == nil {
+		sm.Sources = []string{}
+	}
+	if sm.Names == nil {
+		sm.Names = []string{}
+	}
+	if m.hasSourcesContent {
+		sm.SourcesContent = m.sourcesContent
+	}
+	return sm
+}
+
+// relPath returns target relative to the directory dir. Both are slash
+// separated and relative to the same root.
+func relPath(dir, target string) string {
+	dirParts := splitPath(dir)
+	targetParts := splitPath(target)
+
+	i := 0
+	for i < len(dirParts) && i < len(targetParts) && dirParts[i] == targetParts[i] {
+		i++
+	}
+
+	var parts []string
+	for j := i; j < len(dirParts); j++ {
+		parts = append(parts, "..")
+	}
+	parts = append(parts, targetParts[i:]...)
+
+	return strings.Join(parts, "/")
+}
+
+func splitPath(p string) []string {
+	p = strings.Trim(path.Clean(p), "/")
+	if p == "" || p == "." {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
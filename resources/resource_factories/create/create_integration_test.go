@@ -15,6 +15,7 @@ package create_test
 
 import (
 	"fmt"
+	"io"
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
@@ -139,3 +140,55 @@ mediaTypes = ['text/plain']
 		}
 	})
 }
+
+func TestGetRemotePOSTJSONBody(t *testing.T) {
+	t.Parallel()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"method": %q, "contentType": %q, "body": %q}`, r.Method, r.Header.Get("Content-Type"), b)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(handler))
+	t.Cleanup(func() { srv.Close() })
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term"]
+[security]
+[security.http]
+urls = ['.*']
+methods = ['(?i)GET|POST']
+mediaTypes = ['application/json']
+-- layouts/index.html --
+{{ $query := dict "query" "{ books { title } }" }}
+{{ with resources.GetRemote "URL" (dict "method" "post" "body" $query) }}
+  {{ $data := . | transform.Unmarshal }}
+  MediaType: {{ .MediaType }}|Method: {{ $data.method }}|ContentType: {{ $data.contentType }}|Body: {{ $data.body }}|
+{{ end }}
+{{ with resources.GetRemote "URL" (dict "method" "post" "body" "a") }}
+  {{ $data := . | transform.Unmarshal }}
+  Body a: {{ $data.body }}|
+{{ end }}
+{{ with resources.GetRemote "URL" (dict "method" "post" "body" "b") }}
+  {{ $data := . | transform.Unmarshal }}
+  Body b: {{ $data.body }}|
+{{ end }}
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.ReplaceAll(files, "URL", srv.URL),
+		},
+	)
+
+	b.Build()
+
+	b.AssertFileContent("public/index.html",
+		`MediaType: application/json|Method: POST|ContentType: application/json|Body: {&#34;query&#34;:&#34;{ books { title } }&#34;}|`,
+		"Body a: a|",
+		"Body b: b|",
+	)
+}
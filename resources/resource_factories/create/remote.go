@@ -16,6 +16,7 @@ package create
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math/rand"
@@ -24,6 +25,7 @@ import (
 	"net/http/httputil"
 	"net/url"
 	"path"
+	"reflect"
 	"strings"
 	"time"
 
@@ -342,11 +344,55 @@ func decodeRemoteOptions(optionsm map[string]any) (fromRemoteOptions, error) {
 		Method: "GET",
 	}
 
-	err := mapstructure.WeakDecode(optionsm, &options)
+	optionsm, jsonBody, err := encodeJSONBody(optionsm)
+	if err != nil {
+		return options, err
+	}
+
+	err = mapstructure.WeakDecode(optionsm, &options)
 	if err != nil {
 		return options, err
 	}
 	options.Method = strings.ToUpper(options.Method)
 
+	if jsonBody {
+		if options.Headers == nil {
+			options.Headers = make(map[string]any)
+		}
+		if _, found := maps.LookupEqualFold(options.Headers, "Content-Type"); !found {
+			options.Headers["Content-Type"] = "application/json"
+		}
+	}
+
 	return options, nil
 }
+
+// encodeJSONBody encodes a body provided as a map or a slice, e.g. a GraphQL
+// query created with dict, as JSON.
+func encodeJSONBody(optionsm map[string]any) (map[string]any, bool, error) {
+	for k, v := range optionsm {
+		if !strings.EqualFold(k, "body") || v == nil {
+			continue
+		}
+		switch rv := reflect.ValueOf(v); rv.Kind() {
+		case reflect.Map:
+		case reflect.Slice:
+			if rv.Type().Elem().Kind() == reflect.Uint8 {
+				return optionsm, false, nil
+			}
+		default:
+			return optionsm, false, nil
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to encode body as JSON: %w", err)
+		}
+		m := make(map[string]any, len(optionsm))
+		for kk, vv := range optionsm {
+			m[kk] = vv
+		}
+		m[k] = b
+		return m, true, nil
+	}
+	return optionsm, false, nil
+}
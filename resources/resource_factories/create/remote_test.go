@@ -70,6 +70,43 @@ func TestDecodeRemoteOptions(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"Body, map",
+			map[string]any{
+				"method": "POST",
+				"body": map[string]any{
+					"query": "{ books { title } }",
+				},
+			},
+			fromRemoteOptions{
+				Method: "POST",
+				Body:   []byte(`{"query":"{ books { title } }"}`),
+				Headers: map[string]any{
+					"Content-Type": "application/json",
+				},
+			},
+			false,
+		},
+		{
+			"Body, map, Content-Type set",
+			map[string]any{
+				"method": "POST",
+				"body": map[string]any{
+					"query": "{ books { title } }",
+				},
+				"headers": map[string]any{
+					"content-type": "application/graphql+json",
+				},
+			},
+			fromRemoteOptions{
+				Method: "POST",
+				Body:   []byte(`{"query":"{ books { title } }"}`),
+				Headers: map[string]any{
+					"content-type": "application/graphql+json",
+				},
+			},
+			false,
+		},
 	} {
 		c.Run(test.name, func(c *qt.C) {
 			got, err := decodeRemoteOptions(test.args)
@@ -126,4 +163,11 @@ func TestCalculateResourceID(t *testing.T) {
 	c.Assert(calculateResourceID("foo", map[string]any{"key": "1234", "bar": "baz"}), qt.Equals, "14904296279238663669")
 	c.Assert(calculateResourceID("asdf", map[string]any{"key": "1234", "bar": "asdf"}), qt.Equals, "14904296279238663669")
 	c.Assert(calculateResourceID("asdf", map[string]any{"key": "12345", "bar": "asdf"}), qt.Equals, "12191037851845371770")
+
+	// The method, body and headers are all part of the ID.
+	post := map[string]any{"method": "POST", "body": "a", "headers": map[string]any{"X-Foo": "a"}}
+	id := calculateResourceID("foo", post)
+	c.Assert(calculateResourceID("foo", map[string]any{"method": "PUT", "body": "a", "headers": map[string]any{"X-Foo": "a"}}), qt.Not(qt.Equals), id)
+	c.Assert(calculateResourceID("foo", map[string]any{"method": "POST", "body": "b", "headers": map[string]any{"X-Foo": "a"}}), qt.Not(qt.Equals), id)
+	c.Assert(calculateResourceID("foo", map[string]any{"method": "POST", "body": "a", "headers": map[string]any{"X-Foo": "b"}}), qt.Not(qt.Equals), id)
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/gohugoio/hugo/output"
 	"github.com/gohugoio/hugo/resources/internal"
 	"github.com/gohugoio/hugo/resources/jsconfig"
+	"github.com/gohugoio/hugo/resources/sri"
 
 	"github.com/gohugoio/hugo/common/herrors"
 	"github.com/gohugoio/hugo/common/hexec"
@@ -80,6 +81,7 @@ func NewSpec(
 			PostBuildAssets: &PostBuildAssets{
 				PostProcessResources: make(map[string]postpub.PostPublishedResource),
 				JSConfigBuilder:      jsconfig.NewBuilder(),
+				IntegrityCollector:   sri.NewCollector(),
 			},
 		}
 	}
@@ -141,6 +143,9 @@ type PostBuildAssets struct {
 	postProcessMu        sync.RWMutex
 	PostProcessResources map[string]postpub.PostPublishedResource
 	JSConfigBuilder      *jsconfig.Builder
+
+	// Collects the integrity hashes of fingerprinted resources.
+	IntegrityCollector *sri.Collector
 }
 
 // NewResource creates a new Resource from the given ResourceSourceDescriptor.
@@ -45,6 +45,7 @@ func New(rs *resources.Spec) *Client {
 
 type fingerprintTransformation struct {
 	algo string
+	rs   *resources.Spec
 }
 
 func (t *fingerprintTransformation) Key() internal.ResourceTransformationKey {
@@ -69,14 +70,15 @@ func (t *fingerprintTransformation) Transform(ctx *resources.ResourceTransformat
 		w = io.MultiWriter(h, ctx.To)
 	}
 
-	io.Copy(w, ctx.From)
-	d, err := digest(h)
+	d, err := hashFrom(h, w, ctx.From)
 	if err != nil {
 		return err
 	}
 
-	ctx.Data["Integrity"] = integrity(t.algo, d)
+	sri := integrity(t.algo, d)
+	ctx.Data["Integrity"] = sri
 	ctx.AddOutPathIdentifier("." + hex.EncodeToString(d[:]))
+	t.rs.IntegrityCollector.Add(ctx.OutPath, sri)
 	return nil
 }
 
@@ -106,7 +108,42 @@ func (c *Client) Fingerprint(res resources.ResourceTransformer, algo string) (re
 		algo = defaultHashAlgo
 	}
 
-	return res.Transform(&fingerprintTransformation{algo: algo})
+	return res.Transform(&fingerprintTransformation{algo: algo, rs: c.rs})
+}
+
+// Hash returns the hex encoded hash of the content of the given resource using
+// the given hash algorithm, defaulting to sha256. Unlike Fingerprint, the
+// resource and its target path are left unchanged.
+func (c *Client) Hash(r resource.ReadSeekCloserResource, algo string) (string, error) {
+	if algo == "" {
+		algo = defaultHashAlgo
+	}
+
+	h, err := newHash(algo)
+	if err != nil {
+		return "", err
+	}
+
+	rc, err := r.ReadSeekCloser()
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	d, err := hashFrom(h, h, rc)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(d), nil
+}
+
+// hashFrom copies r into w, which must include h, and returns the digest of h.
+func hashFrom(h hash.Hash, w io.Writer, r io.Reader) ([]byte, error) {
+	if _, err := io.Copy(w, r); err != nil {
+		return nil, err
+	}
+	return digest(h)
 }
 
 func integrity(algo string, sum []byte) string {
@@ -0,0 +1,103 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integrity_test
+
+import (
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestBuildIntegrity(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "page"]
+[build]
+buildIntegrity = true
+-- assets/a.css --
+body { color: red; }
+-- assets/b.js --
+console.log("b");
+-- assets/unused.txt --
+Unused.
+-- layouts/index.html --
+{{ $a := resources.Get "a.css" | fingerprint "sha384" }}
+{{ $b := resources.Get "b.js" | fingerprint }}
+{{ $unused := resources.Get "unused.txt" | fingerprint }}
+{{ $a.RelPermalink }}|{{ $a.Data.Integrity }}
+{{ $b.RelPermalink }}|{{ $b.Data.Integrity }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/integrity.json", `{
+  "a.04df2c898b09aa53de36c445b36a586d35b4ba2501cbdbfa25554aa47692f8a36a0f4645a2d0f938528c908ebfb3ab1b.css": "sha384-BN8siYsJqlPeNsRFs2pYbTW0uiUBy9v6JVVKpHaS+KNqD0ZFotD5OFKMkI6/s6sb",
+  "b.ec1d91b7b5f74259370abd64de61d5a772a86e378b019217ea6fe2438e79f1ea.js": "sha256-7B2Rt7X3Qlk3Cr1k3mHVp3KobjeLAZIX6m/iQ4558eo="
+}`)
+	b.AssertFileContent("public/index.html", "/b.ec1d91b7b5f74259370abd64de61d5a772a86e378b019217ea6fe2438e79f1ea.js|sha256-7B2Rt7X3Qlk3Cr1k3mHVp3KobjeLAZIX6m/iQ4558eo=")
+	b.AssertFileContent("public/integrity.json", "! unused")
+}
+
+func TestBuildIntegrityDisabled(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "page"]
+-- assets/a.css --
+body { color: red; }
+-- layouts/index.html --
+{{ (resources.Get "a.css" | fingerprint).RelPermalink }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileExists("public/integrity.json", false)
+}
+
+func TestHash(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "page"]
+-- assets/b.js --
+console.log("b");
+-- layouts/index.html --
+{{ $b := resources.Get "b.js" }}
+Default: {{ resources.Hash $b }}|
+SHA384: {{ $b | resources.Hash "sha384" }}|
+MD5: {{ resources.Hash "md5" $b }}|
+Fingerprint: {{ ($b | fingerprint).RelPermalink }}|
+RelPermalink: {{ $b.RelPermalink }}|
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		"Default: ec1d91b7b5f74259370abd64de61d5a772a86e378b019217ea6fe2438e79f1ea|",
+		"SHA384: 562d6f567c2e99253ec06c73d6516ac035575256907590d29693ac26036b7e324c183aa0d265f61dd13077e4baba7d79|",
+		"MD5: 421a35699fac4e430d2d48631e3d09bf|",
+		"Fingerprint: /b.ec1d91b7b5f74259370abd64de61d5a772a86e378b019217ea6fe2438e79f1ea.js|",
+		"RelPermalink: /b.js|",
+	)
+	b.AssertFileExists("public/b.js", true)
+
+	b, err := hugolib.TestE(t, strings.ReplaceAll(files, `"md5"`, `"sha1"`))
+	b.Assert(err, qt.ErrorMatches, `(?s).*unsupported hash algorithm: "sha1".*`)
+}
@@ -131,7 +131,9 @@ iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAA
 				WorkingDir:  workingDir,
 			}).Build()
 
-		b.AssertFileCount("resources/_gen/images", 6)
+		// Only the final image is written to the file cache, the
+		// intermediate steps are processed in memory.
+		b.AssertFileCount("resources/_gen/images", 1)
 		b.AssertFileCount("public/images", 1)
 		b.Build()
 	}
@@ -227,3 +229,148 @@ eventDate: 2023-11-01T07:00:00-08:00
 
 	b.AssertFileContent("public/index.html", "2023-11|p9|p8|p7|2023-10|p6|p5|p4|2023-09|p3|p2|p1|")
 }
+
+func TestResourcesByMediaType(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "home", "section"]
+-- content/gallery/index.md --
+---
+title: "Gallery"
+---
+-- content/gallery/a.svg --
+<svg xmlns="http://www.w3.org/2000/svg"></svg>
+-- content/gallery/b.png --
+iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg==
+-- content/gallery/c.txt --
+c
+-- layouts/_default/single.html --
+SVG: {{ range .Resources.ByMediaType "image/svg+xml" }}{{ .Name }}|{{ end }}
+Images: {{ range .Resources.ByMediaType "image/*" }}{{ .Name }}|{{ end }}
+Raster: {{ range (.Resources.ByType "image") }}{{ if not (eq .MediaType.SubType "svg") }}{{ .Name }}|{{ end }}{{ end }}
+All: {{ range .Resources.ByMediaType "*/*" }}{{ .Name }}|{{ end }}
+MediaType: {{ with .Resources.GetMatch "a.svg" }}{{ .MediaType.MainType }}|{{ .MediaType.SubType }}|{{ .MediaType.MimeSuffix }}|{{ .MediaType.Suffixes }}{{ end }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/gallery/index.html",
+		"SVG: a.svg|\n",
+		"Images: a.svg|b.png|\n",
+		"Raster: b.png|\n",
+		"All: a.svg|b.png|c.txt|\n",
+		"MediaType: image|svg|xml|[svg]",
+	)
+}
+
+func TestImagePlaceholders(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org"
+[imaging.blurHash]
+xComponents = 3
+yComponents = 3
+-- content/mybundle/index.md --
+---
+title: "My Bundle"
+---
+-- content/mybundle/sunset.jpg --
+sourcefilename: testdata/sunset.jpg
+-- content/mybundle/circle.svg --
+<svg height="100" width="100"><circle cx="50" cy="50" r="40" fill="red" /></svg>
+-- layouts/index.html --
+{{ $p := site.GetPage "mybundle"}}
+{{ $img := $p.Resources.Get "sunset.jpg" }}
+{{ $small := $img.Resize "100x" }}
+{{ $svg := $p.Resources.Get "circle.svg" }}
+BlurHash: {{ $img.BlurHash }}|{{ len $img.BlurHash }}|
+BlurHash small: {{ eq $small.BlurHash $img.BlurHash }}|
+LQIP: {{ $img.LQIP | safeURL }}|
+SVG: {{ $svg.BlurHash }}|{{ $svg.LQIP }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		}).Build()
+
+	b.AssertFileContent("public/index.html",
+		"BlurHash: K",
+		"|22|",
+		"BlurHash small: false|",
+		"LQIP: data:image/jpeg;base64,",
+		"SVG: ||",
+	)
+
+	// The placeholders are deterministic.
+	first := b.FileContent("public/index.html")
+	b.Build()
+	b.Assert(b.FileContent("public/index.html"), qt.Equals, first)
+}
+
+func TestImageChainIntermediatesNotWrittenToDisk(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- assets/images/pixel.png --
+iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg==
+-- layouts/index.html --
+{{ $image := resources.Get "images/pixel.png" }}
+{{ $resized := $image.Resize "20x" }}
+{{ $filtered := $resized.Filter images.Grayscale }}
+{{ $filled := $filtered.Fill "10x10" }}
+Filled: {{ ($filled | fingerprint).RelPermalink }}|{{ $filled.Width }}|
+{{ $thumb := $image.Resize "30x" }}
+{{ $thumbFiltered := $thumb.Filter images.Grayscale }}
+Thumb: {{ $thumb.RelPermalink }}|{{ $thumbFiltered.RelPermalink }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+			WorkingDir:  t.TempDir(),
+		}).Build()
+
+	b.AssertFileContent("public/index.html", "Filled: /images/pixel_hu", "|10|")
+	// $filled, $thumb and $thumbFiltered.
+	b.AssertFileCount("resources/_gen/images", 3)
+	b.AssertFileCount("public/images", 3)
+}
+
+func TestImageFormat(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- assets/images/pixel.png --
+iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg==
+-- layouts/index.html --
+{{ $image := resources.Get "images/pixel.png" }}
+{{ $webp := $image.Resize "20x webp" }}
+{{ $jpg := $webp.Process "jpg" }}
+{{ $gif := $image.Filter (images.Process "gif") images.Grayscale }}
+{{ $filled := $webp.Fill "10x10" }}
+Original: {{ $image.Format }}|{{ $image.MediaType }}|
+Webp: {{ $webp.Format }}|{{ $webp.MediaType }}|{{ $webp.MediaType.SubType }}|
+Jpg: {{ $jpg.Format }}|{{ $jpg.MediaType }}|{{ $jpg.Format.MediaType.Type }}|
+Gif: {{ $gif.Format }}|{{ $gif.MediaType }}|
+Filled: {{ $filled.Format }}|{{ $filled.MediaType }}|
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		"Original: png|image/png|",
+		"Webp: webp|image/webp|webp|",
+		"Jpg: jpeg|image/jpeg|image/jpeg|",
+		"Gif: gif|image/gif|",
+		"Filled: webp|image/webp|",
+	)
+}
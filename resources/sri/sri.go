@@ -0,0 +1,58 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sri collects the Subresource Integrity hashes of fingerprinted
+// resources.
+package sri
+
+import (
+	"strings"
+	"sync"
+)
+
+// Collector collects the integrity hashes of fingerprinted resources keyed
+// by their target path.
+type Collector struct {
+	mu      sync.RWMutex
+	entries map[string]string
+}
+
+// NewCollector creates a new Collector.
+func NewCollector() *Collector {
+	return &Collector{entries: make(map[string]string)}
+}
+
+// Add records the integrity hash of the resource published to targetPath.
+// This method is thread safe.
+func (c *Collector) Add(targetPath, integrity string) {
+	targetPath = strings.TrimPrefix(targetPath, "/")
+	c.mu.Lock()
+	c.entries[targetPath] = integrity
+	c.mu.Unlock()
+}
+
+// Entries returns a copy of the collected integrity hashes keyed by target
+// path, without a leading slash, filtered by include if set.
+// This method is thread safe.
+func (c *Collector) Entries(include func(targetPath string) bool) map[string]string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	m := make(map[string]string, len(c.entries))
+	for k, v := range c.entries {
+		if include == nil || include(k) {
+			m[k] = v
+		}
+	}
+	return m
+}
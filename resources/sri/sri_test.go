@@ -0,0 +1,38 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sri
+
+import (
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestCollector(t *testing.T) {
+	c := qt.New(t)
+
+	col := NewCollector()
+	col.Add("/css/main.123.css", "sha256-a")
+	col.Add("js/app.456.js", "sha256-b")
+	col.Add("css/main.123.css", "sha256-c")
+
+	c.Assert(col.Entries(nil), qt.DeepEquals, map[string]string{
+		"css/main.123.css": "sha256-c",
+		"js/app.456.js":    "sha256-b",
+	})
+	c.Assert(col.Entries(func(s string) bool { return strings.HasPrefix(s, "js/") }), qt.DeepEquals, map[string]string{
+		"js/app.456.js": "sha256-b",
+	})
+}
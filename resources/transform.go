@@ -267,6 +267,32 @@ func (r *resourceAdapter) Colors() ([]string, error) {
 	return r.getImageOps().Colors()
 }
 
+func (r *resourceAdapter) DominantColor() (string, error) {
+	return r.getImageOps().DominantColor()
+}
+
+func (r *resourceAdapter) AverageColor() (string, error) {
+	return r.getImageOps().AverageColor()
+}
+
+// BlurHash returns an empty string for non-image resources.
+func (r *resourceAdapter) BlurHash() (string, error) {
+	img, ok := r.imageOps()
+	if !ok {
+		return "", nil
+	}
+	return img.BlurHash()
+}
+
+// LQIP returns an empty string for non-image resources.
+func (r *resourceAdapter) LQIP() (string, error) {
+	img, ok := r.imageOps()
+	if !ok {
+		return "", nil
+	}
+	return img.LQIP()
+}
+
 func (r *resourceAdapter) Key() string {
 	r.init(false, false)
 	return r.target.(resource.Identifier).Key()
@@ -351,6 +377,10 @@ func (r resourceAdapter) TransformWithContext(ctx context.Context, t ...Resource
 	return &r, nil
 }
 
+func (r *resourceAdapter) Format() images.Format {
+	return r.getImageOps().Format()
+}
+
 func (r *resourceAdapter) Width() int {
 	return r.getImageOps().Width()
 }
@@ -364,6 +394,15 @@ func (r resourceAdapter) WithResourceMeta(mp resource.ResourceMetaProvider) reso
 	return &r
 }
 
+func (r *resourceAdapter) imageOps() (images.ImageResourceOps, bool) {
+	img, ok := r.target.(images.ImageResourceOps)
+	if !ok {
+		return nil, false
+	}
+	r.init(false, false)
+	return img, true
+}
+
 func (r *resourceAdapter) getImageOps() images.ImageResourceOps {
 	img, ok := r.target.(images.ImageResourceOps)
 	if !ok {
@@ -15,6 +15,7 @@ package source
 
 import (
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/gohugoio/hugo/common/hugio"
 
 	"github.com/gohugoio/hugo/hugofs"
+	"github.com/gohugoio/hugo/identity"
 
 	"github.com/gohugoio/hugo/helpers"
 )
@@ -35,6 +37,29 @@ type File struct {
 
 	uniqueID string
 	lazyInit sync.Once
+
+	// The bundle this file is the content file of, if any.
+	bundle Bundle
+
+	// The memoized ContentHash, cleared by ResetContentHash.
+	contentHashMu sync.Mutex
+	contentHash   *contentHashResult
+}
+
+// Bundle provides the files bundled with a page bundle's content file.
+type Bundle interface {
+	// BundledContentHashes returns the content hashes of the files bundled
+	// with the content file, prefixed with their names and sorted.
+	BundledContentHashes() ([]string, error)
+
+	// ForEeachBundledIdentity calls cb for the identity of each file bundled
+	// with the content file. If cb returns true, the iteration is terminated.
+	ForEeachBundledIdentity(cb func(id identity.Identity) bool)
+}
+
+type contentHashResult struct {
+	hash string
+	err  error
 }
 
 // Filename returns a file's absolute path and filename on disk.
@@ -92,12 +117,75 @@ func (fi *File) Section() string {
 	return fi.p().Section()
 }
 
+// IsBundleHeader returns true if this is the content file of a leaf or
+// branch bundle, i.e. index.md or _index.md.
+func (fi *File) IsBundleHeader() bool {
+	return fi.p().IsBundle()
+}
+
 // UniqueID returns a file's unique, MD5 hash identifier.
 func (fi *File) UniqueID() string {
 	fi.init()
 	return fi.uniqueID
 }
 
+// ContentHash returns a hex encoded MD5 hash of the file's content.
+// For page bundles, this is a combined hash of the content file and the
+// files bundled with it.
+// The hash is calculated once and kept until ResetContentHash is called.
+func (fi *File) ContentHash() (string, error) {
+	fi.contentHashMu.Lock()
+	defer fi.contentHashMu.Unlock()
+
+	if fi.contentHash == nil {
+		h, err := fi.calculateContentHash()
+		fi.contentHash = &contentHashResult{hash: h, err: err}
+	}
+
+	return fi.contentHash.hash, fi.contentHash.err
+}
+
+func (fi *File) calculateContentHash() (string, error) {
+	f, err := fi.Open()
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h, err := helpers.MD5FromReader(f)
+	if err != nil || fi.bundle == nil {
+		return h, err
+	}
+
+	bundled, err := fi.bundle.BundledContentHashes()
+	if err != nil || len(bundled) == 0 {
+		return h, err
+	}
+
+	return helpers.MD5String(h + "\n" + strings.Join(bundled, "\n")), nil
+}
+
+// ResetContentHash clears the memoized ContentHash, e.g. on rebuilds.
+func (fi *File) ResetContentHash() {
+	fi.contentHashMu.Lock()
+	fi.contentHash = nil
+	fi.contentHashMu.Unlock()
+}
+
+// SetBundle sets the bundle this file is the content file of, used in ContentHash.
+func (fi *File) SetBundle(b Bundle) {
+	fi.bundle = b
+}
+
+// ForEeachIdentityByName calls cb for the identities of the bundled files
+// when name is ContentHash, so the dependent templates get re-rendered when
+// a bundled file changes.
+func (fi *File) ForEeachIdentityByName(name string, cb func(id identity.Identity) bool) {
+	if name == "ContentHash" && fi.bundle != nil {
+		fi.bundle.ForEeachBundledIdentity(cb)
+	}
+}
+
 // FileInfo returns a file's underlying os.FileInfo.
 func (fi *File) FileInfo() hugofs.FileMetaInfo { return fi.fim }
 
@@ -149,7 +237,15 @@ func NewFileInfo(fi hugofs.FileMetaInfo) *File {
 }
 
 func NewGitInfo(info gitmap.GitInfo) GitInfo {
-	return GitInfo(info)
+	return GitInfo{
+		Hash:            info.Hash,
+		AbbreviatedHash: info.AbbreviatedHash,
+		Subject:         info.Subject,
+		AuthorName:      info.AuthorName,
+		AuthorEmail:     info.AuthorEmail,
+		AuthorDate:      info.AuthorDate,
+		CommitDate:      info.CommitDate,
+	}
 }
 
 // GitInfo provides information about a version controlled source file.
@@ -160,12 +256,18 @@ type GitInfo struct {
 	AbbreviatedHash string `json:"abbreviatedHash"`
 	// The commit message's subject/title line.
 	Subject string `json:"subject"`
+	// The commit message's body, without the subject line.
+	Body string `json:"body"`
 	// The author name, respecting .mailmap.
 	AuthorName string `json:"authorName"`
 	// The author email address, respecting .mailmap.
 	AuthorEmail string `json:"authorEmail"`
 	// The author date.
 	AuthorDate time.Time `json:"authorDate"`
+	// The committer name, respecting .mailmap.
+	CommitterName string `json:"committerName"`
+	// The committer email address, respecting .mailmap.
+	CommitterEmail string `json:"committerEmail"`
 	// The commit date.
 	CommitDate time.Time `json:"commitDate"`
 }
@@ -175,3 +277,13 @@ type GitInfo struct {
 func (g GitInfo) IsZero() bool {
 	return g.Hash == ""
 }
+
+// GitContributor is an author of one or more commits.
+type GitContributor struct {
+	// The author name, respecting .mailmap.
+	Name string `json:"name"`
+	// The author email address, respecting .mailmap.
+	Email string `json:"email"`
+	// The number of commits.
+	Commits int `json:"commits"`
+}
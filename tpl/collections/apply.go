@@ -25,6 +25,8 @@ import (
 )
 
 // Apply takes an array or slice c and returns a new slice with the function fname applied over it.
+// If fname starts with a period, e.g. ".Resize", the method with that name is
+// called on each element with args as arguments.
 func (ns *Namespace) Apply(ctx context.Context, c any, fname string, args ...any) (any, error) {
 	if c == nil {
 		return make([]any, 0), nil
@@ -40,9 +42,15 @@ func (ns *Namespace) Apply(ctx context.Context, c any, fname string, args ...any
 		return nil, errors.New("can't iterate over a nil value")
 	}
 
-	fnv, found := ns.lookupFunc(ctx, fname)
-	if !found {
-		return nil, errors.New("can't find function " + fname)
+	methodName, isMethod := strings.CutPrefix(fname, ".")
+
+	var fnv reflect.Value
+	if !isMethod {
+		var found bool
+		fnv, found = ns.lookupFunc(ctx, fname)
+		if !found {
+			return nil, errors.New("can't find function " + fname)
+		}
 	}
 
 	switch seqv.Kind() {
@@ -51,9 +59,25 @@ func (ns *Namespace) Apply(ctx context.Context, c any, fname string, args ...any
 		for i := 0; i < seqv.Len(); i++ {
 			vv := seqv.Index(i)
 
+			fnv := fnv
+			if isMethod {
+				var isNil bool
+				vv, isNil = indirectInterface(vv)
+				if isNil {
+					return nil, fmt.Errorf("element %d: can't call method %s on a nil value", i, methodName)
+				}
+				fnv = hreflect.GetMethodByName(vv, methodName)
+				if !fnv.IsValid() {
+					return nil, fmt.Errorf("element %d: can't find method %s on %s", i, methodName, vv.Type())
+				}
+				if err := checkNumArgs(fnv.Type(), len(args)); err != nil {
+					return nil, fmt.Errorf("element %d: method %s: %w", i, methodName, err)
+				}
+			}
+
 			vvv, err := applyFnToThis(ctx, fnv, vv, args...)
 			if err != nil {
-				return nil, err
+				return nil, fmt.Errorf("element %d: %w", i, err)
 			}
 
 			r[i] = vvv.Interface()
@@ -106,6 +130,25 @@ func applyFnToThis(ctx context.Context, fn, this reflect.Value, args ...any) (re
 	return reflect.ValueOf(nil), res[1].Interface().(error)
 }
 
+// checkNumArgs checks that a function of type fnt can be called with numArgs arguments,
+// not counting any context.Context argument.
+func checkNumArgs(fnt reflect.Type, numArgs int) error {
+	num := fnt.NumIn()
+	if num > 0 && hreflect.IsContextType(fnt.In(0)) {
+		num--
+	}
+	if fnt.IsVariadic() {
+		if numArgs < num-1 {
+			return fmt.Errorf("wrong number of args: got %d want at least %d", numArgs, num-1)
+		}
+		return nil
+	}
+	if numArgs != num {
+		return fmt.Errorf("wrong number of args: got %d want %d", numArgs, num)
+	}
+	return nil
+}
+
 func (ns *Namespace) lookupFunc(ctx context.Context, fname string) (reflect.Value, bool) {
 	namespace, methodName, ok := strings.Cut(fname, ".")
 	if !ok {
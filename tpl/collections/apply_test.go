@@ -15,9 +15,11 @@ package collections
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"reflect"
+	"strings"
 	"testing"
 
 	qt "github.com/frankban/quicktest"
@@ -102,3 +104,53 @@ func TestApply(t *testing.T) {
 		t.Errorf("apply with unknown func should fail")
 	}
 }
+
+type tstApplyElem struct {
+	s string
+}
+
+func (e tstApplyElem) Repeat(n int) string {
+	return strings.Repeat(e.s, n)
+}
+
+func (e *tstApplyElem) Upper(ctx context.Context) (string, error) {
+	if e.s == "" {
+		return "", errors.New("empty")
+	}
+	return strings.ToUpper(e.s), nil
+}
+
+func TestApplyMethod(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+	d := testconfig.GetTestDeps(nil, nil)
+	d.SetTempl(&tpl.TemplateHandlers{
+		Tmpl: new(templateFinder),
+	})
+	ns := New(d)
+
+	ctx := context.Background()
+
+	result, err := ns.Apply(ctx, []tstApplyElem{{"a"}, {"b"}}, ".Repeat", 3)
+	c.Assert(err, qt.IsNil)
+	c.Assert(result, qt.DeepEquals, []any{"aaa", "bbb"})
+
+	result, err = ns.Apply(ctx, []any{&tstApplyElem{"a"}, &tstApplyElem{"b"}}, ".Upper")
+	c.Assert(err, qt.IsNil)
+	c.Assert(result, qt.DeepEquals, []any{"A", "B"})
+
+	_, err = ns.Apply(ctx, []any{&tstApplyElem{"a"}, &tstApplyElem{""}}, ".Upper")
+	c.Assert(err, qt.ErrorMatches, "element 1: empty")
+
+	_, err = ns.Apply(ctx, []tstApplyElem{{"a"}}, ".Repeat")
+	c.Assert(err, qt.ErrorMatches, "element 0: method Repeat: wrong number of args: got 0 want 1")
+
+	_, err = ns.Apply(ctx, []tstApplyElem{{"a"}}, ".Repeat", "3")
+	c.Assert(err, qt.ErrorMatches, "element 0: called apply using string as type int")
+
+	_, err = ns.Apply(ctx, []any{tstApplyElem{"a"}, "b"}, ".Repeat", 3)
+	c.Assert(err, qt.ErrorMatches, "element 1: can't find method Repeat on string")
+
+	_, err = ns.Apply(ctx, []any{tstApplyElem{"a"}, nil}, ".Repeat", 3)
+	c.Assert(err, qt.ErrorMatches, "element 1: can't call method Repeat on a nil value")
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collections
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/spf13/cast"
+)
+
+const (
+	chunkModeContiguous = "contiguous"
+	chunkModeRoundRobin = "roundrobin"
+)
+
+// ChunkInto splits l into n groups with balanced counts, e.g. for a layout
+// with n columns.
+//
+// The optional mode is either "contiguous" (default), where each group holds
+// a run of consecutive elements and the first groups get one element more if
+// the length of l is not divisible by n, or "roundrobin", where element i goes
+// into group i % n.
+//
+// If n is larger than the length of l, the trailing groups are empty.
+func (ns *Namespace) ChunkInto(l any, n any, mode ...string) ([]any, error) {
+	if l == nil {
+		return nil, errors.New("can't chunk a nil value")
+	}
+
+	nv, err := cast.ToIntE(n)
+	if err != nil {
+		return nil, err
+	}
+	if nv < 1 {
+		return nil, errors.New("number of groups must be positive")
+	}
+
+	m := chunkModeContiguous
+	if len(mode) > 0 {
+		m = mode[0]
+	}
+	if m != chunkModeContiguous && m != chunkModeRoundRobin {
+		return nil, fmt.Errorf("invalid chunk mode %q, must be one of %q or %q", m, chunkModeContiguous, chunkModeRoundRobin)
+	}
+
+	lv, isNil := indirect(reflect.ValueOf(l))
+	if isNil {
+		return nil, errors.New("can't chunk a nil value")
+	}
+
+	var sliceType reflect.Type
+	switch lv.Kind() {
+	case reflect.Slice:
+		sliceType = lv.Type()
+	case reflect.Array:
+		sliceType = reflect.SliceOf(lv.Type().Elem())
+	default:
+		return nil, fmt.Errorf("can't chunk %T", l)
+	}
+
+	length := lv.Len()
+	groups := make([]any, nv)
+
+	if m == chunkModeRoundRobin {
+		for i := 0; i < nv; i++ {
+			g := reflect.MakeSlice(sliceType, 0, (length+nv-1-i)/nv)
+			for j := i; j < length; j += nv {
+				g = reflect.Append(g, lv.Index(j))
+			}
+			groups[i] = g.Interface()
+		}
+		return groups, nil
+	}
+
+	size, rest := length/nv, length%nv
+	start := 0
+	for i := 0; i < nv; i++ {
+		end := start + size
+		if i < rest {
+			end++
+		}
+		g := reflect.MakeSlice(sliceType, 0, end-start)
+		for j := start; j < end; j++ {
+			g = reflect.Append(g, lv.Index(j))
+		}
+		groups[i] = g.Interface()
+		start = end
+	}
+
+	return groups, nil
+}
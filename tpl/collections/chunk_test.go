@@ -0,0 +1,63 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collections
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestChunkInto(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	ns := newNs()
+
+	for i, test := range []struct {
+		l        any
+		n        any
+		mode     []string
+		expected any
+	}{
+		{[]int{1, 2, 3, 4, 5, 6, 7}, 3, nil, []any{[]int{1, 2, 3}, []int{4, 5}, []int{6, 7}}},
+		{[]int{1, 2, 3, 4, 5, 6, 7}, "3", []string{"contiguous"}, []any{[]int{1, 2, 3}, []int{4, 5}, []int{6, 7}}},
+		{[]int{1, 2, 3, 4, 5, 6, 7}, 3, []string{"roundrobin"}, []any{[]int{1, 4, 7}, []int{2, 5}, []int{3, 6}}},
+		{[]string{"a", "b", "c", "d"}, 2, nil, []any{[]string{"a", "b"}, []string{"c", "d"}}},
+		{[3]string{"a", "b", "c"}, 1, nil, []any{[]string{"a", "b", "c"}}},
+		{[]any{"a", 1}, 2, nil, []any{[]any{"a"}, []any{1}}},
+		// More groups than elements.
+		{[]int{1, 2}, 4, nil, []any{[]int{1}, []int{2}, []int{}, []int{}}},
+		{[]int{1, 2}, 4, []string{"roundrobin"}, []any{[]int{1}, []int{2}, []int{}, []int{}}},
+		{[]int{}, 2, nil, []any{[]int{}, []int{}}},
+		// Errors.
+		{nil, 2, nil, false},
+		{[]int{1, 2}, 0, nil, false},
+		{[]int{1, 2}, "a", nil, false},
+		{[]int{1, 2}, 2, []string{"foo"}, false},
+		{"abc", 2, nil, false},
+	} {
+		errMsg := qt.Commentf("[%d] %v", i, test)
+
+		result, err := ns.ChunkInto(test.l, test.n, test.mode...)
+
+		if b, ok := test.expected.(bool); ok && !b {
+			c.Assert(err, qt.Not(qt.IsNil), errMsg)
+			continue
+		}
+
+		c.Assert(err, qt.IsNil, errMsg)
+		c.Assert(result, qt.DeepEquals, test.expected, errMsg)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collections
+
+import (
+	"github.com/gohugoio/hugo/common/hreflect"
+)
+
+// Coalesce returns the first non-empty value in args, or nil if all are
+// empty. Empty values are false, 0, nil, the empty string and any empty
+// array, slice or map.
+//
+// When called as coalesce in a template, the arguments are evaluated lazily,
+// from left to right, stopping at the first non-empty value.
+func (ns *Namespace) Coalesce(args ...any) any {
+	for _, v := range args {
+		if hreflect.IsTruthful(v) {
+			return v
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collections
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestCoalesce(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	ns := newNs()
+
+	for _, test := range []struct {
+		args   []any
+		expect any
+	}{
+		{nil, nil},
+		{[]any{nil, "", 0, false}, nil},
+		{[]any{nil, "a", "b"}, "a"},
+		{[]any{0, 1.5}, 1.5},
+		{[]any{[]string{}, map[string]int{}, []string{"a"}}, []string{"a"}},
+		{[]any{false, true}, true},
+	} {
+		c.Assert(ns.Coalesce(test.args...), qt.DeepEquals, test.expect, qt.Commentf("%v", test.args))
+	}
+}
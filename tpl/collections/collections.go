@@ -19,9 +19,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math/rand"
+	"math"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -572,8 +573,98 @@ func (ns *Namespace) Seq(args ...any) ([]int, error) {
 	return seq, nil
 }
 
+// SeqFloat creates a sequence of floats from args, with the same arguments as Seq.
+// Each element is rounded to the number of decimals in FIRST and INCREMENT,
+// so e.g. 0 0.1 0.3 creates 0, 0.1, 0.2, 0.3 and not 0.30000000000000004.
+//
+// Examples:
+//
+//	3 => 1, 2, 3
+//	0 0.5 2 => 0, 0.5, 1, 1.5, 2
+//	1.5 -0.5 0 => 1.5, 1, 0.5, 0
+//	0.5 3 => 0.5, 1.5, 2.5
+func (ns *Namespace) SeqFloat(args ...any) ([]float64, error) {
+	if len(args) < 1 || len(args) > 3 {
+		return nil, errors.New("invalid number of arguments to SeqFloat")
+	}
+
+	floatArgs := make([]float64, len(args))
+	for i, arg := range args {
+		f, err := cast.ToFloat64E(arg)
+		if err != nil {
+			return nil, errors.New("invalid arguments to SeqFloat")
+		}
+		floatArgs[i] = f
+	}
+
+	inc := 1.0
+	first := floatArgs[0]
+	last := floatArgs[len(floatArgs)-1]
+
+	if len(floatArgs) == 1 {
+		if last == 0 {
+			return []float64{}, nil
+		} else if last > 0 {
+			first = 1
+		} else {
+			first = -1
+			inc = -1
+		}
+	} else if len(floatArgs) == 2 {
+		if last < first {
+			inc = -1
+		}
+	} else {
+		inc = floatArgs[1]
+		if inc == 0 {
+			return nil, errors.New("'increment' must not be 0")
+		}
+		if first < last && inc < 0 {
+			return nil, errors.New("'increment' must be > 0")
+		}
+		if first > last && inc > 0 {
+			return nil, errors.New("'increment' must be < 0")
+		}
+	}
+
+	// Allow for some rounding error so e.g. 0 0.1 0.3 includes 0.3.
+	n := math.Floor((last-first)/inc+1e-9) + 1
+
+	// sanity check
+	if math.IsNaN(n) || n <= 0 || n > 2000 {
+		return nil, errors.New("size of result exceeds limit")
+	}
+
+	decimals := numDecimals(first)
+	if d := numDecimals(inc); d > decimals {
+		decimals = d
+	}
+	pow := math.Pow10(decimals)
+
+	// Compute each element from first to avoid accumulating rounding errors.
+	seq := make([]float64, int(n))
+	for i := range seq {
+		v := first + float64(i)*inc
+		if decimals <= 15 {
+			v = math.Round(v*pow) / pow
+		}
+		seq[i] = v
+	}
+
+	return seq, nil
+}
+
+// numDecimals returns the number of decimals in the shortest representation of f.
+func numDecimals(f float64) int {
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		return len(s) - i - 1
+	}
+	return 0
+}
+
 // Shuffle returns list l in a randomised order.
-func (ns *Namespace) Shuffle(l any) (any, error) {
+func (ns *Namespace) Shuffle(ctx context.Context, l any) (any, error) {
 	if l == nil {
 		return nil, errors.New("both count and seq must be provided")
 	}
@@ -593,7 +684,7 @@ func (ns *Namespace) Shuffle(l any) (any, error) {
 
 	shuffled := reflect.MakeSlice(reflect.TypeOf(l), lv.Len(), lv.Len())
 
-	randomIndices := rand.Perm(lv.Len())
+	randomIndices := ns.deps.Rand(ctx).Perm(lv.Len())
 
 	for index, value := range randomIndices {
 		shuffled.Index(value).Set(lv.Index(index))
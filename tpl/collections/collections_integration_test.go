@@ -14,8 +14,11 @@
 package collections_test
 
 import (
+	"strings"
 	"testing"
 
+	qt "github.com/frankban/quicktest"
+
 	"github.com/gohugoio/hugo/hugolib"
 )
 
@@ -230,3 +233,160 @@ boolf = false
 		"false",
 	)
 }
+
+func TestWherePartialPredicate(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section"]
+-- layouts/index.html --
+Pages: {{ range where site.RegularPages "partial:is-featured.html" }}{{ .Title }}|{{ end }}
+Maps: {{ range $k, $v := where (dict "a" 1 "b" 5 "c" 10) "partial:gt-three.html" }}{{ $k }}={{ $v }}|{{ end }}
+Slice: {{ where (slice 1 5 10) "partial:gt-three.html" }}
+Empty: {{ len (where site.RegularPages "partial:none.html") }}
+-- layouts/partials/is-featured.html --
+{{ return and (eq .Params.featured true) (gt .Params.rating 3) }}
+-- layouts/partials/gt-three.html --
+{{ return gt . 3 }}
+-- layouts/partials/none.html --
+{{ return false }}
+-- content/p1.md --
+---
+title: "p1"
+params:
+  featured: true
+  rating: 5
+---
+-- content/p2.md --
+---
+title: "p2"
+params:
+  featured: true
+  rating: 2
+---
+-- content/p3.md --
+---
+title: "p3"
+params:
+  rating: 5
+---
+-- content/p4.md --
+---
+title: "p4"
+params:
+  featured: true
+  rating: 4
+---
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		"Pages: p1|p4|",
+		"Maps: b=5|c=10|",
+		"Slice: [5 10]",
+		"Empty: 0",
+	)
+
+	b, err := hugolib.TestE(t, strings.Replace(files, "partial:is-featured.html", "partial:does-not-exist.html", 1))
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `failed to execute partial "does-not-exist.html": partial "does-not-exist.html" not found`)
+
+	// The partial must return a bool.
+	b, err = hugolib.TestE(t, strings.Replace(files, "{{ return false }}", "false", 1))
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `partial "none.html" must return a bool, got template.HTML`)
+}
+
+func TestShuffleRandomSeed(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+randomSeed = 42
+-- content/p1.md --
+---
+title: "p1"
+---
+-- content/p2.md --
+---
+title: "p2"
+---
+-- layouts/_default/single.html --
+Seed: {{ hugo.RandomSeed }}|
+Shuffle: {{ shuffle (seq 1 20) }}|
+Rand: {{ math.Rand }}|
+`
+
+	b1 := hugolib.Test(t, files)
+	b1.AssertFileContent("public/p1/index.html", "Seed: 42|")
+
+	b2 := hugolib.Test(t, files)
+	for _, filename := range []string{"public/p1/index.html", "public/p2/index.html"} {
+		b1.Assert(b1.FileContent(filename), qt.Equals, b2.FileContent(filename))
+	}
+
+	// Each page gets its own random numbers.
+	b1.Assert(b1.FileContent("public/p1/index.html"), qt.Not(qt.Equals), b1.FileContent("public/p2/index.html"))
+
+	// Rebuilding gives the same result.
+	p1 := b1.FileContent("public/p1/index.html")
+	b1.Build()
+	b1.Assert(b1.FileContent("public/p1/index.html"), qt.Equals, p1)
+
+	b3 := hugolib.Test(t, strings.Replace(files, "randomSeed = 42", "", 1), hugolib.TestOptWithConfig(func(c *hugolib.IntegrationTestConfig) {
+		c.Environ = []string{"HUGO_RANDOMSEED=42"}
+	}))
+	b3.Assert(b3.FileContent("public/p1/index.html"), qt.Equals, p1)
+
+	// 0 is a valid seed.
+	files = strings.Replace(files, "randomSeed = 42", "randomSeed = 0", 1)
+	b4 := hugolib.Test(t, files)
+	b4.AssertFileContent("public/p1/index.html", "Seed: 0|")
+	b5 := hugolib.Test(t, files)
+	b4.Assert(b4.FileContent("public/p1/index.html"), qt.Equals, b5.FileContent("public/p1/index.html"))
+}
+
+func TestApplyMethodAndPartial(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section"]
+-- content/p1.md --
+---
+title: "p1"
+weight: 1
+params:
+  color: red
+---
+-- content/p2.md --
+---
+title: "p2"
+weight: 2
+params:
+  color: blue
+---
+-- layouts/partials/title.html --
+{{- return (printf "Title: %s" .Title) -}}
+-- layouts/index.html --
+Colors: {{ apply site.RegularPages ".Param" "color" }}|
+Titles: {{ apply site.RegularPages "partial" "title.html" "." }}|
+-- layouts/_default/single.html --
+{{ .Title }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		"Colors: [red blue]|",
+		"Titles: [Title: p1 Title: p2]|",
+	)
+
+	files = strings.Replace(files, `".Param" "color"`, `".Param"`, 1)
+	b, err := hugolib.TestE(t, files)
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `element 0: method Param: wrong number of args: got 0 want 1`)
+}
@@ -650,6 +650,49 @@ func TestSeq(t *testing.T) {
 	}
 }
 
+func TestSeqFloat(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+	ns := newNs()
+
+	for i, test := range []struct {
+		args   []any
+		expect any
+	}{
+		{[]any{3}, []float64{1, 2, 3}},
+		{[]any{-2}, []float64{-1, -2}},
+		{[]any{0}, []float64{}},
+		{[]any{0, 0.5, 2}, []float64{0, 0.5, 1, 1.5, 2}},
+		{[]any{0, 0.5, 2.2}, []float64{0, 0.5, 1, 1.5, 2}},
+		{[]any{1.5, -0.5, 0}, []float64{1.5, 1, 0.5, 0}},
+		{[]any{0.5, 3}, []float64{0.5, 1.5, 2.5}},
+		{[]any{2.5, 0}, []float64{2.5, 1.5, 0.5}},
+		{[]any{"0", "0.25", "1"}, []float64{0, 0.25, 0.5, 0.75, 1}},
+		{[]any{0, 0.1, 0.3}, []float64{0, 0.1, 0.2, 0.3}},
+		{[]any{1.1, 0.7, 3.2}, []float64{1.1, 1.8, 2.5, 3.2}},
+		// errors
+		{[]any{1, 0, 2}, false},
+		{[]any{0, 0.001, 3}, false},
+		{[]any{0, -0.5, 3}, false},
+		{[]any{3, 0.5, 0}, false},
+		{[]any{0, 0.5, "foo"}, false},
+		{[]any{1, 2, 3, 4}, false},
+		{[]any{}, false},
+	} {
+		errMsg := qt.Commentf("[%d] %v", i, test)
+
+		result, err := ns.SeqFloat(test.args...)
+
+		if b, ok := test.expect.(bool); ok && !b {
+			c.Assert(err, qt.Not(qt.IsNil), errMsg)
+			continue
+		}
+
+		c.Assert(err, qt.IsNil, errMsg)
+		c.Assert(result, qt.DeepEquals, test.expect, errMsg)
+	}
+}
+
 func TestShuffle(t *testing.T) {
 	t.Parallel()
 	c := qt.New(t)
@@ -674,7 +717,7 @@ func TestShuffle(t *testing.T) {
 	} {
 		errMsg := qt.Commentf("[%d] %v", i, test)
 
-		result, err := ns.Shuffle(test.seq)
+		result, err := ns.Shuffle(context.Background(), test.seq)
 
 		if !test.success {
 			c.Assert(err, qt.Not(qt.IsNil), errMsg)
@@ -705,7 +748,7 @@ func TestShuffleRandomising(t *testing.T) {
 	}{
 		{rand.Perm(seqLen)},
 	} {
-		result, err := ns.Shuffle(test.seq)
+		result, err := ns.Shuffle(context.Background(), test.seq)
 		resultv := reflect.ValueOf(result)
 
 		c.Assert(err, qt.IsNil)
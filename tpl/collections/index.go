@@ -17,6 +17,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/spf13/cast"
 
@@ -47,20 +48,7 @@ func (ns *Namespace) doIndex(item any, args ...any) (any, error) {
 		return nil, nil
 	}
 
-	var indices []any
-
-	if len(args) == 1 {
-		v := reflect.ValueOf(args[0])
-		if v.Kind() == reflect.Slice {
-			for i := 0; i < v.Len(); i++ {
-				indices = append(indices, v.Index(i).Interface())
-			}
-		} else {
-			indices = append(indices, args[0])
-		}
-	} else {
-		indices = args
-	}
+	indices := indexArgs(args)
 
 	lowerm, ok := item.(maps.Params)
 	if ok {
@@ -115,6 +103,69 @@ func (ns *Namespace) doIndex(item any, args ...any) (any, error) {
 	return v.Interface(), nil
 }
 
+// IndexDefault returns the result of indexing item by the given keys, or def
+// if any key along the path is missing. Thus "collections.IndexDefault 0 x 1 2"
+// is, in Go syntax, x[1][2], but with def returned if x has no element 1,
+// x[1] has no element 2, or an intermediate value is nil or not a map, slice,
+// or array. An existing nil value is returned as nil.
+func (ns *Namespace) IndexDefault(def any, item any, args ...any) any {
+	v := reflect.ValueOf(item)
+	if !v.IsValid() {
+		return def
+	}
+
+	for _, i := range indexArgs(args) {
+		var isNil bool
+		if v, isNil = indirect(v); isNil {
+			return def
+		}
+		index := reflect.ValueOf(i)
+		switch v.Kind() {
+		case reflect.Array, reflect.Slice:
+			x, err := cast.ToIntE(i)
+			if err != nil || !index.IsValid() || x < 0 || x >= v.Len() {
+				return def
+			}
+			v = v.Index(x)
+		case reflect.Map:
+			if _, ok := v.Interface().(maps.Params); ok {
+				// Params keys are always lower case.
+				index = reflect.ValueOf(strings.ToLower(cast.ToString(i)))
+			}
+			index, err := prepareArg(index, v.Type().Key())
+			if err != nil {
+				return def
+			}
+			x := v.MapIndex(index)
+			if !x.IsValid() {
+				return def
+			}
+			v = x
+		default:
+			return def
+		}
+	}
+
+	return v.Interface()
+}
+
+// indexArgs returns the indices to use for the given arguments, which may be
+// a single slice of indices.
+func indexArgs(args []any) []any {
+	if len(args) != 1 {
+		return args
+	}
+	v := reflect.ValueOf(args[0])
+	if v.Kind() != reflect.Slice {
+		return args
+	}
+	indices := make([]any, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		indices[i] = v.Index(i).Interface()
+	}
+	return indices
+}
+
 // prepareArg checks if value can be used as an argument of type argType, and
 // converts an invalid value to appropriate zero if possible.
 //
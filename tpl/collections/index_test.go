@@ -90,3 +90,46 @@ func TestIndex(t *testing.T) {
 		})
 	}
 }
+
+func TestIndexDefault(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+	ns := newNs()
+
+	const def = "default"
+
+	var nilPointer *int
+
+	for i, test := range []struct {
+		item    any
+		indices []any
+		expect  any
+	}{
+		{[]int{0, 1}, []any{1}, 1},
+		{[]int{0, 1}, []any{9}, def},
+		{[]int{0, 1}, []any{-1}, def},
+		{[]int{0, 1}, []any{nil}, def},
+		{[]int{0, 1}, []any{"1"}, 1},
+		{[]int{0, 1}, []any{"a"}, def},
+		{map[int]int{1: 10, 2: 20}, []any{1}, 10},
+		{map[int]int{1: 10, 2: 20}, []any{0}, def},
+		{map[string]any{"a": nil}, []any{"a"}, nil},
+		{map[string]any{"a": nil}, []any{"a", "b"}, def},
+		{map[string]any{"a": "av"}, []any{"a", "b"}, def},
+		{map[string]any{"a": "av"}, []any{1}, def},
+		{map[string]map[string]any{"a": {"b": []string{"c", "d"}}}, []any{"a", "b", 1}, "d"},
+		{map[string]map[string]any{"a": {"b": []string{"c", "d"}}}, []any{"a", "b", 2}, def},
+		{map[string]map[string]any{"a": {"b": []string{"c", "d"}}}, []any{"a", "c", 0}, def},
+		{maps.Params{"a": maps.Params{"b": "bv"}}, []any{"A", "B"}, "bv"},
+		{maps.Params{"a": maps.Params{"b": "bv"}}, []any{"a", "c"}, def},
+		{maps.Params{"a": maps.Params{"b": false}}, []any{"a", "b"}, false},
+		{nil, []any{0}, def},
+		{nilPointer, []any{0}, def},
+		{tstNoStringer{}, []any{0}, def},
+		{[]int{0, 1}, nil, []int{0, 1}},
+	} {
+		errMsg := qt.Commentf("[%d] %v", i, test)
+		c.Assert(ns.IndexDefault(def, test.item, test.indices...), qt.DeepEquals, test.expect, errMsg)
+		c.Assert(ns.IndexDefault(def, test.item, test.indices), qt.DeepEquals, test.expect, errMsg)
+	}
+}
@@ -41,6 +41,21 @@ func init() {
 			[][2]string{},
 		)
 
+		ns.AddMethodMapping(ctx.ChunkInto,
+			nil,
+			[][2]string{
+				{`{{ collections.ChunkInto (slice 1 2 3 4 5) 2 }}`, `[[1 2 3] [4 5]]`},
+				{`{{ collections.ChunkInto (slice 1 2 3 4 5) 2 "roundrobin" }}`, `[[1 3 5] [2 4]]`},
+			},
+		)
+
+		ns.AddMethodMapping(ctx.Coalesce,
+			[]string{"coalesce"},
+			[][2]string{
+				{`{{ coalesce "" 0 "foo" "bar" }}`, `foo`},
+			},
+		)
+
 		ns.AddMethodMapping(ctx.Complement,
 			[]string{"complement"},
 			[][2]string{
@@ -98,6 +113,15 @@ func init() {
 			[][2]string{},
 		)
 
+		ns.AddMethodMapping(ctx.IndexDefault,
+			nil,
+			[][2]string{
+				{`{{ collections.IndexDefault "n/a" (dict "a" (dict "b" 1)) "a" "b" }}`, `1`},
+				{`{{ collections.IndexDefault "n/a" (dict "a" (dict "b" 1)) "a" "c" "d" }}`, `n/a`},
+				{`{{ collections.IndexDefault "n/a" (slice "a" "b") 2 }}`, `n/a`},
+			},
+		)
+
 		ns.AddMethodMapping(ctx.Intersect,
 			[]string{"intersect"},
 			[][2]string{},
@@ -177,6 +201,13 @@ func init() {
 			},
 		)
 
+		ns.AddMethodMapping(ctx.SeqFloat,
+			nil,
+			[][2]string{
+				{`{{ collections.SeqFloat 0 0.25 1 }}`, `[0 0.25 0.5 0.75 1]`},
+			},
+		)
+
 		ns.AddMethodMapping(ctx.NewScratch,
 			[]string{"newScratch"},
 			[][2]string{
@@ -206,6 +237,18 @@ func init() {
 					`{{ merge (dict "title" "Default Title" "description" "Yes, Hugo Rocks!") (dict "title" "Hugo Rocks!") (dict "extra" "For reals!") | sort }}`,
 					`[Yes, Hugo Rocks! For reals! Hugo Rocks!]`,
 				},
+				{
+					`{{ (merge "deep" (dict "tags" (slice "a" "b")) (dict "tags" (slice "c"))).tags }}`,
+					`[a b c]`,
+				},
+			},
+		)
+
+		ns.AddMethodMapping(ctx.Zip,
+			nil,
+			[][2]string{
+				{`{{ collections.Zip (slice "a" "b" "c") (slice 1 2) }}`, `[[a 1] [b 2]]`},
+				{`{{ range collections.Zip (dict "pad" true) (slice "a" "b") (slice 1) }}{{ index . 0 }}={{ index . 1 | default 0 }};{{ end }}`, `a=1;b=0;`},
 			},
 		)
 
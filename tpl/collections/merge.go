@@ -23,11 +23,42 @@ import (
 	"github.com/gohugoio/hugo/common/maps"
 )
 
+// Merge modes.
+const (
+	// MergeShallow merges the top level keys only.
+	MergeShallow = "shallow"
+
+	// MergeDeep merges nested maps recursively and appends the slices of the
+	// preceding parameters to the slices of the following parameters.
+	MergeDeep = "deep"
+
+	// MergeDeepReplaceSlices merges nested maps recursively; slices are
+	// replaced, not merged. This is the default.
+	MergeDeepReplaceSlices = "deep-replace-slices"
+)
+
 // Merge creates a copy of the final parameter in params and merges the preceding
 // parameters into it in reverse order.
 //
+// The first parameter may be the merge mode, one of shallow, deep or
+// deep-replace-slices (default).
+//
 // Currently only maps are supported. Key handling is case insensitive.
 func (ns *Namespace) Merge(params ...any) (any, error) {
+	mode := MergeDeepReplaceSlices
+	if len(params) > 0 {
+		if s, ok := params[0].(string); ok {
+			switch s {
+			case MergeShallow, MergeDeep, MergeDeepReplaceSlices:
+				mode, params = s, params[1:]
+			default:
+				if len(params) > 2 {
+					return nil, fmt.Errorf("unknown merge mode %q; must be one of %s, %s or %s", s, MergeShallow, MergeDeep, MergeDeepReplaceSlices)
+				}
+			}
+		}
+	}
+
 	if len(params) < 2 {
 		return nil, errors.New("merge requires at least two parameters")
 	}
@@ -36,7 +67,7 @@ func (ns *Namespace) Merge(params ...any) (any, error) {
 	result := params[len(params)-1]
 
 	for i := len(params) - 2; i >= 0; i-- {
-		result, err = ns.merge(params[i], result)
+		result, err = ns.merge(params[i], result, mode)
 		if err != nil {
 			return nil, err
 		}
@@ -46,7 +77,7 @@ func (ns *Namespace) Merge(params ...any) (any, error) {
 }
 
 // merge creates a copy of dst and merges src into it.
-func (ns *Namespace) merge(src, dst any) (any, error) {
+func (ns *Namespace) merge(src, dst any, mode string) (any, error) {
 	vdst, vsrc := reflect.ValueOf(dst), reflect.ValueOf(src)
 
 	if vdst.Kind() != reflect.Map {
@@ -65,7 +96,7 @@ func (ns *Namespace) merge(src, dst any) (any, error) {
 		return nil, fmt.Errorf("incompatible map types, got %T to %T", src, dst)
 	}
 
-	return mergeMap(vdst, vsrc).Interface(), nil
+	return mergeMap(vdst, vsrc, mode).Interface(), nil
 }
 
 func caseInsensitiveLookup(m, k reflect.Value) (reflect.Value, bool) {
@@ -84,7 +115,7 @@ func caseInsensitiveLookup(m, k reflect.Value) (reflect.Value, bool) {
 	return reflect.Value{}, false
 }
 
-func mergeMap(dst, src reflect.Value) reflect.Value {
+func mergeMap(dst, src reflect.Value, mode string) reflect.Value {
 	out := reflect.MakeMap(dst.Type())
 
 	// If the destination is Params, we must lower case all keys.
@@ -97,22 +128,26 @@ func mergeMap(dst, src reflect.Value) reflect.Value {
 	}
 
 	// Add all keys in src not already in destination.
-	// Maps of the same type will be merged.
+	// Maps of the same type will be merged unless in shallow mode.
 	for _, key := range src.MapKeys() {
 		sv := src.MapIndex(key)
 		dv, found := caseInsensitiveLookup(dst, key)
 
 		if found {
-			// If both are the same map key type, merge.
-			dve := dv.Elem()
-			if dve.Kind() == reflect.Map {
-				sve := sv.Elem()
-				if sve.Kind() != reflect.Map {
-					continue
-				}
-
+			if mode == MergeShallow {
+				continue
+			}
+			dve, _ := indirectInterface(dv)
+			sve, _ := indirectInterface(sv)
+			switch {
+			case dve.Kind() == reflect.Map && sve.Kind() == reflect.Map:
+				// If both are the same map key type, merge.
 				if dve.Type().Key() == sve.Type().Key() {
-					out.SetMapIndex(key, mergeMap(dve, sve))
+					out.SetMapIndex(dstKey(out, key), mergeMap(dve, sve, mode))
+				}
+			case mode == MergeDeep && isSlice(dve) && isSlice(sve):
+				if v := appendSlices(sve, dve); v.Type().AssignableTo(out.Type().Elem()) {
+					out.SetMapIndex(dstKey(out, key), v)
 				}
 			}
 		} else {
@@ -125,3 +160,37 @@ func mergeMap(dst, src reflect.Value) reflect.Value {
 
 	return out
 }
+
+// dstKey returns the key in m matching k, which may differ in case.
+func dstKey(m, k reflect.Value) reflect.Value {
+	if m.Type().Key().Kind() != reflect.String || k.Kind() != reflect.String {
+		return k
+	}
+	for _, key := range m.MapKeys() {
+		if strings.EqualFold(k.String(), key.String()) {
+			return key
+		}
+	}
+	return k
+}
+
+func isSlice(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+}
+
+// appendSlices returns a new slice with the elements of a followed by the
+// elements of b. The result is of the same type as a and b if they're
+// slices of the same type, else a []any.
+func appendSlices(a, b reflect.Value) reflect.Value {
+	typ := reflect.TypeOf([]any(nil))
+	if a.Type() == b.Type() && a.Kind() == reflect.Slice {
+		typ = a.Type()
+	}
+	out := reflect.MakeSlice(typ, 0, a.Len()+b.Len())
+	for _, v := range []reflect.Value{a, b} {
+		for i := 0; i < v.Len(); i++ {
+			out = reflect.Append(out, v.Index(i))
+		}
+	}
+	return out
+}
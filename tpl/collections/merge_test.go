@@ -159,6 +159,120 @@ func TestMerge(t *testing.T) {
 	}
 }
 
+func TestMergeModes(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+	ns := newNs()
+
+	defaults := map[string]any{
+		"title": "Default",
+		"tags":  []any{"a", "b"},
+		"menu":  map[string]any{"main": []any{"home"}, "weight": 1, "footer": map[string]any{"show": true, "links": []string{"x"}}},
+		"extra": []any{"e"},
+	}
+	site := map[string]any{
+		"title": "Site",
+		"tags":  []any{"c"},
+		"Menu":  map[string]any{"main": []any{"blog"}, "footer": map[string]any{"links": []string{"y", "z"}}},
+		"extra": "not a slice",
+	}
+
+	for _, test := range []struct {
+		name   string
+		params []any
+		expect any
+	}{
+		{
+			"default",
+			[]any{defaults, site},
+			map[string]any{
+				"title": "Site",
+				"tags":  []any{"c"},
+				"Menu":  map[string]any{"main": []any{"blog"}, "weight": 1, "footer": map[string]any{"show": true, "links": []string{"y", "z"}}},
+				"extra": "not a slice",
+			},
+		},
+		{
+			"deep-replace-slices",
+			[]any{MergeDeepReplaceSlices, defaults, site},
+			map[string]any{
+				"title": "Site",
+				"tags":  []any{"c"},
+				"Menu":  map[string]any{"main": []any{"blog"}, "weight": 1, "footer": map[string]any{"show": true, "links": []string{"y", "z"}}},
+				"extra": "not a slice",
+			},
+		},
+		{
+			"deep",
+			[]any{MergeDeep, defaults, site},
+			map[string]any{
+				"title": "Site",
+				"tags":  []any{"a", "b", "c"},
+				"Menu":  map[string]any{"main": []any{"home", "blog"}, "weight": 1, "footer": map[string]any{"show": true, "links": []string{"x", "y", "z"}}},
+				"extra": "not a slice",
+			},
+		},
+		{
+			"shallow",
+			[]any{MergeShallow, defaults, site},
+			map[string]any{
+				"title": "Site",
+				"tags":  []any{"c"},
+				"Menu":  map[string]any{"main": []any{"blog"}, "footer": map[string]any{"links": []string{"y", "z"}}},
+				"extra": "not a slice",
+			},
+		},
+		{
+			"deep, three layers",
+			[]any{MergeDeep, map[string]any{"a": []any{1}}, map[string]any{"a": []any{2}}, map[string]any{"a": []any{3}}},
+			map[string]any{"a": []any{1, 2, 3}},
+		},
+		{
+			"deep, mixed slice types",
+			[]any{MergeDeep, map[string]any{"a": []string{"x"}}, map[string]any{"a": []int{1}}},
+			map[string]any{"a": []any{"x", 1}},
+		},
+		{
+			"deep, slice over non-slice",
+			[]any{MergeDeep, map[string]any{"a": "x"}, map[string]any{"a": []any{1}}},
+			map[string]any{"a": []any{1}},
+		},
+		{
+			"deep, params dst",
+			[]any{MergeDeep, map[string]any{"A": []any{1}, "B": 2}, maps.Params{"a": []any{2}}},
+			maps.Params{"a": []any{1, 2}, "b": 2},
+		},
+		{
+			"deep, typed map",
+			[]any{MergeDeep, map[string][]string{"a": {"x"}}, map[string][]string{"a": {"y"}}},
+			map[string][]string{"a": {"x", "y"}},
+		},
+		{
+			"mode only",
+			[]any{MergeDeep, defaults},
+			false,
+		},
+		{
+			"unknown mode",
+			[]any{"deeper", defaults, site},
+			false,
+		},
+	} {
+		msg := qt.Commentf(test.name)
+		result, err := ns.Merge(test.params...)
+		if b, ok := test.expect.(bool); ok && !b {
+			c.Assert(err, qt.Not(qt.IsNil), msg)
+			continue
+		}
+		c.Assert(err, qt.IsNil, msg)
+		c.Assert(result, qt.DeepEquals, test.expect, msg)
+	}
+
+	// The inputs are not modified.
+	c.Assert(defaults["tags"], qt.DeepEquals, []any{"a", "b"})
+	c.Assert(site["tags"], qt.DeepEquals, []any{"c"})
+}
+
 func TestMergeDataFormats(t *testing.T) {
 	c := qt.New(t)
 	ns := newNs()
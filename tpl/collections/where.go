@@ -26,12 +26,19 @@ import (
 )
 
 // Where returns a filtered subset of collection c.
+// If key is a string with the prefix "partial:" and no operator or value
+// is given, the rest of key is the name of a partial that is executed for
+// each element, keeping the elements where it returns true.
 func (ns *Namespace) Where(ctx context.Context, c, key any, args ...any) (any, error) {
 	seqv, isNil := indirect(reflect.ValueOf(c))
 	if isNil {
 		return nil, errors.New("can't iterate over a nil value of type " + reflect.ValueOf(c).Type().String())
 	}
 
+	if name, ok := key.(string); ok && len(args) == 0 && strings.HasPrefix(name, wherePartialPrefix) {
+		return ns.whereWithPartial(ctx, seqv, strings.TrimPrefix(name, wherePartialPrefix))
+	}
+
 	mv, op, err := parseWhereArgs(args...)
 	if err != nil {
 		return nil, err
@@ -55,6 +62,61 @@ func (ns *Namespace) Where(ctx context.Context, c, key any, args ...any) (any, e
 	}
 }
 
+// wherePartialPrefix marks a where key as the name of a partial.
+const wherePartialPrefix = "partial:"
+
+// whereWithPartial filters seqv by executing the partial name with each
+// element as its context. The partial must return a bool.
+func (ns *Namespace) whereWithPartial(ctx context.Context, seqv reflect.Value, name string) (any, error) {
+	fnv, found := ns.lookupFunc(ctx, "partial")
+	if !found {
+		return nil, errors.New("can't find function partial")
+	}
+
+	keep := func(v reflect.Value) (bool, error) {
+		res, err := applyFnToThis(ctx, fnv, v, name, ".")
+		if err != nil {
+			return false, fmt.Errorf("failed to execute partial %q: %w", name, err)
+		}
+		b, ok := res.Interface().(bool)
+		if !ok {
+			return false, fmt.Errorf("partial %q must return a bool, got %T", name, res.Interface())
+		}
+		return b, nil
+	}
+
+	switch seqv.Kind() {
+	case reflect.Array, reflect.Slice:
+		rv := reflect.MakeSlice(seqv.Type(), 0, 0)
+		for i := 0; i < seqv.Len(); i++ {
+			elemv := seqv.Index(i)
+			ok, err := keep(elemv)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				rv = reflect.Append(rv, elemv)
+			}
+		}
+		return rv.Interface(), nil
+	case reflect.Map:
+		rv := reflect.MakeMap(seqv.Type())
+		for _, k := range seqv.MapKeys() {
+			elemv := seqv.MapIndex(k)
+			ok, err := keep(elemv)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				rv.SetMapIndex(k, elemv)
+			}
+		}
+		return rv.Interface(), nil
+	default:
+		return nil, fmt.Errorf("can't iterate over %s", seqv.Type())
+	}
+}
+
 func (ns *Namespace) checkCondition(v, mv reflect.Value, op string) (bool, error) {
 	v, vIsNil := indirect(v)
 	if !v.IsValid() {
@@ -0,0 +1,81 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collections
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/spf13/cast"
+)
+
+// Zip returns a slice of tuples pairing the elements in the given collections
+// by index, stopping at the shortest collection.
+//
+// You can optionally provide an options map as the first argument. If pad is
+// set, Zip stops at the longest collection and fills the missing elements with
+// nil.
+//
+//	{{ range collections.Zip $names $ages }}{{ index . 0 }}: {{ index . 1 }}{{ end }}
+func (ns *Namespace) Zip(args ...any) ([][]any, error) {
+	var pad bool
+	if len(args) > 0 {
+		if m, ok := args[0].(map[string]any); ok {
+			if v, found := maps.LookupEqualFold(m, "pad"); found {
+				var err error
+				if pad, err = cast.ToBoolE(v); err != nil {
+					return nil, fmt.Errorf("invalid pad option: %w", err)
+				}
+			}
+			args = args[1:]
+		}
+	}
+
+	if len(args) == 0 {
+		return nil, errors.New("zip needs at least one collection")
+	}
+
+	lvs := make([]reflect.Value, len(args))
+	length := -1
+	for i, arg := range args {
+		lv, isNil := indirect(reflect.ValueOf(arg))
+		if isNil {
+			return nil, errors.New("can't zip a nil value")
+		}
+		switch lv.Kind() {
+		case reflect.Array, reflect.Slice:
+		default:
+			return nil, fmt.Errorf("can't zip %T", arg)
+		}
+		lvs[i] = lv
+		if length == -1 || (pad && lv.Len() > length) || (!pad && lv.Len() < length) {
+			length = lv.Len()
+		}
+	}
+
+	tuples := make([][]any, length)
+	for i := range tuples {
+		tuple := make([]any, len(lvs))
+		for j, lv := range lvs {
+			if i < lv.Len() {
+				tuple[j] = lv.Index(i).Interface()
+			}
+		}
+		tuples[i] = tuple
+	}
+
+	return tuples, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collections
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestZip(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	ns := newNs()
+
+	for i, test := range []struct {
+		args     []any
+		expected any
+	}{
+		{[]any{[]string{"a", "b", "c"}, []int{1, 2, 3}}, [][]any{{"a", 1}, {"b", 2}, {"c", 3}}},
+		{[]any{[]string{"a", "b", "c"}, []int{1, 2}, []any{true, false, nil}}, [][]any{{"a", 1, true}, {"b", 2, false}}},
+		{[]any{[2]string{"a", "b"}}, [][]any{{"a"}, {"b"}}},
+		{[]any{[]string{"a", "b"}, []int{}}, [][]any{}},
+		{[]any{map[string]any{"pad": true}, []string{"a", "b", "c"}, []int{1}}, [][]any{{"a", 1}, {"b", nil}, {"c", nil}}},
+		{[]any{map[string]any{"Pad": "false"}, []string{"a", "b", "c"}, []int{1}}, [][]any{{"a", 1}}},
+		// Errors.
+		{[]any{}, false},
+		{[]any{map[string]any{"pad": true}}, false},
+		{[]any{map[string]any{"pad": "foo"}, []int{1}}, false},
+		{[]any{[]int{1}, nil}, false},
+		{[]any{[]int{1}, "abc"}, false},
+	} {
+		errMsg := qt.Commentf("[%d] %v", i, test.args)
+
+		result, err := ns.Zip(test.args...)
+
+		if b, ok := test.expected.(bool); ok && !b {
+			c.Assert(err, qt.Not(qt.IsNil), errMsg)
+			continue
+		}
+
+		c.Assert(err, qt.IsNil, errMsg)
+		c.Assert(result, qt.DeepEquals, test.expected, errMsg)
+	}
+}
@@ -18,7 +18,10 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"html/template"
+	"reflect"
+	"strings"
 
 	bp "github.com/gohugoio/hugo/bufferpool"
 
@@ -36,24 +39,101 @@ func New() *Namespace {
 type Namespace struct{}
 
 // Base64Decode returns the base64 decoding of the given content.
-func (ns *Namespace) Base64Decode(content any) (string, error) {
-	conv, err := cast.ToStringE(content)
+// An optional map of options may be passed before or after the content, see
+// Base64Encode. Without options, the alphabet and padding are detected from
+// the content.
+func (ns *Namespace) Base64Decode(args ...any) (string, error) {
+	conv, opts, err := base64Args("base64Decode", args)
 	if err != nil {
 		return "", err
 	}
 
-	dec, err := base64.StdEncoding.DecodeString(conv)
+	var enc *base64.Encoding
+	if opts != nil {
+		enc = opts.encoding()
+	} else {
+		conv = strings.TrimRight(conv, "=")
+		if strings.ContainsAny(conv, "-_") {
+			enc = base64.RawURLEncoding
+		} else {
+			enc = base64.RawStdEncoding
+		}
+	}
+
+	dec, err := enc.DecodeString(conv)
 	return string(dec), err
 }
 
 // Base64Encode returns the base64 encoding of the given content.
-func (ns *Namespace) Base64Encode(content any) (string, error) {
-	conv, err := cast.ToStringE(content)
+// An optional map of options may be passed before or after the content.
+// Supported options are "urlSafe" (default false), to use the URL and file
+// name safe alphabet, and "padding" (default true).
+func (ns *Namespace) Base64Encode(args ...any) (string, error) {
+	conv, opts, err := base64Args("base64Encode", args)
 	if err != nil {
 		return "", err
 	}
 
-	return base64.StdEncoding.EncodeToString([]byte(conv)), nil
+	enc := base64.StdEncoding
+	if opts != nil {
+		enc = opts.encoding()
+	}
+
+	return enc.EncodeToString([]byte(conv)), nil
+}
+
+type base64Opts struct {
+	URLSafe bool
+	Padding bool
+}
+
+func (o base64Opts) encoding() *base64.Encoding {
+	enc := base64.StdEncoding
+	if o.URLSafe {
+		enc = base64.URLEncoding
+	}
+	if !o.Padding {
+		enc = enc.WithPadding(base64.NoPadding)
+	}
+	return enc
+}
+
+// base64Args returns the content and the options, if any, from args.
+// The options map may be the first or the last argument, so both
+// base64Encode CONTENT OPTIONS and CONTENT | base64Encode OPTIONS work.
+func base64Args(name string, args []any) (string, *base64Opts, error) {
+	var content any
+	var opts *base64Opts
+
+	switch len(args) {
+	case 1:
+		content = args[0]
+	case 2:
+		content = args[0]
+		optsv := args[1]
+		// Note that we only check the kind here, as maps.ToStringMapE
+		// would also accept content that happens to be a JSON object.
+		if v := reflect.ValueOf(args[0]); v.Kind() == reflect.Map {
+			content, optsv = args[1], args[0]
+		}
+		m, err := maps.ToStringMapE(optsv)
+		if err != nil {
+			return "", nil, fmt.Errorf("%s: options must be a map: %w", name, err)
+		}
+		opts = &base64Opts{Padding: true}
+		if err := mapstructure.WeakDecode(m, opts); err != nil {
+			return "", nil, fmt.Errorf("%s: failed to decode options: %w", name, err)
+		}
+	default:
+		return "", nil, fmt.Errorf("%s: expected 1 or 2 arguments, got %d", name, len(args))
+	}
+
+	conv, err := cast.ToStringE(content)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return conv, opts, nil
 }
 
 // Jsonify encodes a given object to JSON.  To pretty print the JSON, pass a map
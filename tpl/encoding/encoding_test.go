@@ -31,14 +31,30 @@ func TestBase64Decode(t *testing.T) {
 
 	for _, test := range []struct {
 		v      any
+		opts   any
 		expect any
 	}{
-		{"YWJjMTIzIT8kKiYoKSctPUB+", "abc123!?$*&()'-=@~"},
+		{"YWJjMTIzIT8kKiYoKSctPUB+", nil, "abc123!?$*&()'-=@~"},
+		// Auto detected.
+		{"YWJjMTIzIT8kKiYoKSctPUB-", nil, "abc123!?$*&()'-=@~"},
+		{"c3ViamVjdHM/X2Q", nil, "subjects?_d"},
+		{"c3ViamVjdHM_X2Q=", nil, "subjects?_d"},
+		{"c3ViamVjdHM_X2Q", nil, "subjects?_d"},
+		// With options.
+		{"c3ViamVjdHM_X2Q=", map[string]any{"urlSafe": true}, "subjects?_d"},
+		{"c3ViamVjdHM_X2Q", map[string]any{"urlSafe": true, "padding": false}, "subjects?_d"},
 		// errors
-		{t, false},
+		{t, nil, false},
+		{"c3ViamVjdHM_X2Q", map[string]any{"urlSafe": true}, false},
+		{"c3ViamVjdHM_X2Q=", map[string]any{"urlSafe": false}, false},
+		{"c3ViamVjdHM_X2Q=", "foo", false},
 	} {
+		args := []any{test.v}
+		if test.opts != nil {
+			args = append(args, test.opts)
+		}
 
-		result, err := ns.Base64Decode(test.v)
+		result, err := ns.Base64Decode(args...)
 
 		if b, ok := test.expect.(bool); ok && !b {
 			c.Assert(err, qt.Not(qt.IsNil))
@@ -58,14 +74,25 @@ func TestBase64Encode(t *testing.T) {
 
 	for _, test := range []struct {
 		v      any
+		opts   any
 		expect any
 	}{
-		{"YWJjMTIzIT8kKiYoKSctPUB+", "WVdKak1USXpJVDhrS2lZb0tTY3RQVUIr"},
+		{"YWJjMTIzIT8kKiYoKSctPUB+", nil, "WVdKak1USXpJVDhrS2lZb0tTY3RQVUIr"},
+		{"subjects?_d", nil, "c3ViamVjdHM/X2Q="},
+		{"subjects?_d", map[string]any{"urlSafe": true}, "c3ViamVjdHM_X2Q="},
+		{"subjects?_d", map[string]any{"padding": false}, "c3ViamVjdHM/X2Q"},
+		{"subjects?_d", map[string]any{"urlSafe": true, "padding": false}, "c3ViamVjdHM_X2Q"},
+		{`{"alg":"HS256"}`, map[string]any{"urlSafe": true}, "eyJhbGciOiJIUzI1NiJ9"},
 		// errors
-		{t, false},
+		{t, nil, false},
+		{"subjects?_d", "foo", false},
 	} {
+		args := []any{test.v}
+		if test.opts != nil {
+			args = append(args, test.opts)
+		}
 
-		result, err := ns.Base64Encode(test.v)
+		result, err := ns.Base64Encode(args...)
 
 		if b, ok := test.expect.(bool); ok && !b {
 			c.Assert(err, qt.Not(qt.IsNil))
@@ -74,6 +101,13 @@ func TestBase64Encode(t *testing.T) {
 
 		c.Assert(err, qt.IsNil)
 		c.Assert(result, qt.Equals, test.expect)
+
+		if test.opts != nil {
+			// The options may also be passed first.
+			result, err = ns.Base64Encode(test.opts, test.v)
+			c.Assert(err, qt.IsNil)
+			c.Assert(result, qt.Equals, test.expect)
+		}
 	}
 }
 
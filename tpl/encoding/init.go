@@ -36,6 +36,7 @@ func init() {
 			[][2]string{
 				{`{{ "SGVsbG8gd29ybGQ=" | base64Decode }}`, `Hello world`},
 				{`{{ 42 | base64Encode | base64Decode }}`, `42`},
+				{`{{ "c3ViamVjdHM_X2Q" | base64Decode }}`, `subjects?_d`},
 			},
 		)
 
@@ -43,6 +44,8 @@ func init() {
 			[]string{"base64Encode"},
 			[][2]string{
 				{`{{ "Hello world" | base64Encode }}`, `SGVsbG8gd29ybGQ=`},
+				{`{{ base64Encode "subjects?_d" (dict "urlSafe" true "padding" false) }}`, `c3ViamVjdHM_X2Q`},
+				{`{{ "subjects?_d" | base64Encode (dict "urlSafe" true) }}`, `c3ViamVjdHM_X2Q=`},
 			},
 		)
 
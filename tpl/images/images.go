@@ -16,7 +16,10 @@ package images
 
 import (
 	"errors"
+	"fmt"
 	"image"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/bep/overlayfs"
@@ -117,3 +120,96 @@ func (ns *Namespace) Filter(args ...any) (images.ImageResource, error) {
 
 	return img.Filter(filtersv...)
 }
+
+// FilterPipeline creates a filter pipeline from the given filters that
+// can be applied to many images with ApplyFilter.
+func (ns *Namespace) FilterPipeline(filters ...any) (*images.FilterPipeline, error) {
+	if len(filters) == 0 {
+		return nil, errors.New("must provide one or more filters")
+	}
+	return images.NewFilterPipeline(filters...), nil
+}
+
+// ApplyFilter applies the given filter, typically a pipeline created with
+// FilterPipeline, to the given image.
+func (ns *Namespace) ApplyFilter(filter any, img images.ImageResource) (images.ImageResource, error) {
+	return img.Filter(filter)
+}
+
+// Srcset holds a set of resized images, ordered by width, and the value
+// of the corresponding srcset attribute.
+type Srcset struct {
+	// The processed images, ordered by width.
+	Resources []images.ImageResource
+
+	srcset string
+}
+
+// Srcset returns the srcset attribute value, e.g.
+// "/a_hu123.jpg 320w, /a_hu456.jpg 640w".
+func (s Srcset) Srcset() string {
+	return s.srcset
+}
+
+// Srcset resizes img to each of the given widths and returns the processed
+// images and the srcset attribute value using the w descriptor.
+// An optional string with additional processing options, e.g. "webp q75",
+// is applied to all widths. Widths larger than the original are skipped to
+// avoid upscaling.
+func (ns *Namespace) Srcset(img images.ImageResource, widths any, options ...any) (Srcset, error) {
+	ws, err := cast.ToIntSliceE(widths)
+	if err != nil {
+		return Srcset{}, fmt.Errorf("srcset: widths must be a slice of integers: %w", err)
+	}
+	if len(ws) == 0 {
+		return Srcset{}, errors.New("srcset: must provide one or more widths")
+	}
+
+	var extra string
+	if len(options) > 0 {
+		if extra, err = cast.ToStringE(options[0]); err != nil {
+			return Srcset{}, fmt.Errorf("srcset: options must be a string: %w", err)
+		}
+	}
+
+	// Sort and remove duplicates to get a stable result.
+	ws = append([]int(nil), ws...)
+	sort.Ints(ws)
+	var n int
+	for i, w := range ws {
+		if w <= 0 {
+			return Srcset{}, fmt.Errorf("srcset: invalid width %d", w)
+		}
+		if i > 0 && w == ws[n-1] {
+			continue
+		}
+		if w > img.Width() {
+			break
+		}
+		ws[n] = w
+		n++
+	}
+	ws = ws[:n]
+	if len(ws) == 0 {
+		ws = []int{img.Width()}
+	}
+
+	var (
+		s   Srcset
+		buf strings.Builder
+	)
+	for i, w := range ws {
+		r, err := img.Resize(strings.TrimSpace(fmt.Sprintf("%dx %s", w, extra)))
+		if err != nil {
+			return Srcset{}, err
+		}
+		s.Resources = append(s.Resources, r)
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "%s %dw", r.RelPermalink(), w)
+	}
+	s.srcset = buf.String()
+
+	return s, nil
+}
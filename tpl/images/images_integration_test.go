@@ -16,6 +16,8 @@ package images_test
 import (
 	"testing"
 
+	qt "github.com/frankban/quicktest"
+
 	"github.com/gohugoio/hugo/hugolib"
 )
 
@@ -49,3 +51,77 @@ fileExists2 OK: true|
 imageConfig2 OK: 1|
 `)
 }
+
+func TestImageFilterPipeline(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "page"]
+-- assets/a.png --
+iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg==
+-- assets/b.png --
+iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAQAAAC1HAwCAAAAC0lEQVR42mNkYAAAAAYAAjCB0C8AAAAASUVORK5CYII=
+-- layouts/index.html --
+{{ $pipeline := images.FilterPipeline (images.Process "resize 4x jpg") images.Grayscale }}
+{{ range resources.Match "*.png" }}
+{{ $a := . | images.ApplyFilter $pipeline }}
+{{ $c := images.Filter (images.Process "resize 4x jpg") images.Grayscale . }}
+{{ .Name }}: {{ $a.Width }}|{{ path.Ext $a.RelPermalink }}|{{ eq $a.RelPermalink $c.RelPermalink }}|
+{{ end }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		"a.png: 4|.jpg|true|",
+		"b.png: 4|.jpg|true|",
+	)
+}
+
+func TestImageSrcset(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "page"]
+-- assets/a.png --
+iVBORw0KGgoAAAANSUhEUgAAABQAAAAKCAAAAACRPiE6AAAAD0lEQVR4nGJiwAKGtCBgAAnYABUkYFhYAAAAAElFTkSuQmCC
+-- layouts/index.html --
+{{ $img := resources.Get "a.png" }}
+{{ $s := images.Srcset $img (slice 10 5 10 40) }}
+Srcset: {{ $s.Srcset }}|
+Widths: {{ range $s.Resources }}{{ .Width }}x{{ .Height }}|{{ end }}
+{{ $jpg := images.Srcset $img (slice 5 10) "jpg q80" }}
+JPG: {{ range $jpg.Resources }}{{ .MediaType }}|{{ end }}
+{{ $large := images.Srcset $img (slice 30 40) }}
+Large: {{ range $large.Resources }}{{ .Width }}|{{ end }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		"Srcset: /a_hub9812b22463b71ac12b870371839faba_72_5x0_resize_box_3.png 5w, /a_hub9812b22463b71ac12b870371839faba_72_10x0_resize_box_3.png 10w|",
+		"Widths: 5x3|10x5|\n",
+		"JPG: image/jpeg|image/jpeg|\n",
+		"Large: 20|\n",
+	)
+}
+
+func TestImageSrcsetErrors(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "page"]
+-- assets/a.png --
+iVBORw0KGgoAAAANSUhEUgAAABQAAAAKCAAAAACRPiE6AAAAD0lEQVR4nGJiwAKGtCBgAAnYABUkYFhYAAAAAElFTkSuQmCC
+-- layouts/index.html --
+{{ $img := resources.Get "a.png" }}
+{{ $s := images.Srcset $img (slice 0 10) }}
+`
+
+	b, err := hugolib.TestE(t, files)
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, "srcset: invalid width 0")
+}
@@ -15,6 +15,7 @@ package template
 
 import (
 	template "github.com/gohugoio/hugo/tpl/internal/go_templates/texttemplate"
+	"github.com/gohugoio/hugo/tpl/internal/go_templates/texttemplate/parse"
 )
 
 /*
@@ -35,6 +36,42 @@ func (t *Template) Prepare() (*template.Template, error) {
 	return t.text, nil
 }
 
+// ParseTrees parses text as the body for t, but returns the parse trees
+// without adding them to t. See AddParseTrees.
+func (t *Template) ParseTrees(text string) (map[string]*parse.Tree, error) {
+	if err := t.checkCanParse(); err != nil {
+		return nil, err
+	}
+	return t.text.ParseTrees(text)
+}
+
+// AddParseTrees adds trees, e.g. from ParseTrees, to t.
+// ParseTrees followed by AddParseTrees is equivalent to Parse.
+func (t *Template) AddParseTrees(trees map[string]*parse.Tree) (*Template, error) {
+	if err := t.checkCanParse(); err != nil {
+		return nil, err
+	}
+
+	ret, err := t.text.AddParseTrees(trees)
+	if err != nil {
+		return nil, err
+	}
+
+	// Same as in Parse.
+	t.nameSpace.mu.Lock()
+	defer t.nameSpace.mu.Unlock()
+	for _, v := range ret.Templates() {
+		name := v.Name()
+		tmpl := t.set[name]
+		if tmpl == nil {
+			tmpl = t.new(name)
+		}
+		tmpl.text = v
+		tmpl.Tree = v.Tree
+	}
+	return t, nil
+}
+
 // See https://github.com/golang/go/issues/5884
 func StripTags(html string) string {
 	return stripTags(html)
@@ -15,8 +15,10 @@ package template
 
 import (
 	"context"
+	"errors"
 	"io"
 	"reflect"
+	"runtime"
 
 	"github.com/gohugoio/hugo/common/hreflect"
 
@@ -94,6 +96,26 @@ func (t *Template) Prepare() (*Template, error) {
 	return t, nil
 }
 
+// ParseTrees parses text as the body for t, but returns the parse trees
+// without adding them to t. See AddParseTrees.
+func (t *Template) ParseTrees(text string) (map[string]*parse.Tree, error) {
+	t.init()
+	t.muFuncs.RLock()
+	defer t.muFuncs.RUnlock()
+	return parse.Parse(t.name, text, t.leftDelim, t.rightDelim, t.parseFuncs, builtins())
+}
+
+// AddParseTrees adds trees, e.g. from ParseTrees, to t.
+// ParseTrees followed by AddParseTrees is equivalent to Parse.
+func (t *Template) AddParseTrees(trees map[string]*parse.Tree) (*Template, error) {
+	for name, tree := range trees {
+		if _, err := t.AddParseTree(name, tree); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
 func (t *Template) executeWithState(state *state, value reflect.Value) (err error) {
 	defer errRecover(&err)
 	if t.Tree == nil || t.Root == nil {
@@ -129,7 +151,7 @@ func (s *state) evalFunction(dot reflect.Value, node *parse.IdentifierNode, cmd
 	var ok bool
 	var isBuiltin bool
 	if s.helper != nil {
-		isBuiltin = name == "and" || name == "or"
+		isBuiltin = name == "and" || name == "or" || name == "cond" || name == "coalesce"
 		function, first, ok = s.helper.GetFunc(s.ctx, s.prep, name)
 	}
 
@@ -141,11 +163,41 @@ func (s *state) evalFunction(dot reflect.Value, node *parse.IdentifierNode, cmd
 		s.errorf("%q is not a defined function", name)
 	}
 	if first != zero {
+		// Added for Hugo.
+		if ctx, ok := first.Interface().(context.Context); ok {
+			first = reflect.ValueOf(s.withCallSite(ctx))
+		}
 		return s.evalCall(dot, function, isBuiltin, cmd, name, args, final, first)
 	}
 	return s.evalCall(dot, function, isBuiltin, cmd, name, args, final)
 }
 
+type callSiteKey struct{}
+
+// callSite is the position of a function call in a template.
+type callSite struct {
+	tmpl   *Template
+	node   parse.Node
+	parent *callSite
+}
+
+// withCallSite returns a copy of ctx with the current node pushed onto its call stack.
+func (s *state) withCallSite(ctx context.Context) context.Context {
+	parent, _ := ctx.Value(callSiteKey{}).(*callSite)
+	return context.WithValue(ctx, callSiteKey{}, &callSite{tmpl: s.tmpl, node: s.node, parent: parent})
+}
+
+// CallStack returns the locations, e.g. "_default/list.html:42:3", of the
+// function calls (e.g. partial) that led to ctx, innermost first.
+func CallStack(ctx context.Context) []string {
+	var locations []string
+	for c, _ := ctx.Value(callSiteKey{}).(*callSite); c != nil; c = c.parent {
+		location, _ := c.tmpl.ErrorContext(c.node)
+		locations = append(locations, location)
+	}
+	return locations
+}
+
 // evalField evaluates an expression like (.Field) or (.Field arg1 arg2).
 // The 'final' argument represents the return value from the preceding
 // value of the pipeline, if any.
@@ -313,6 +365,21 @@ func (s *state) evalCall(dot, fun reflect.Value, isBuiltin bool, node parse.Node
 		return v
 	}
 
+	// Added for Hugo.
+	if name == "try" {
+		return unwrap(s.evalTry(dot, fun, typ, args, final))
+	}
+
+	// Added for Hugo.
+	if isBuiltin && numFirst == 0 {
+		switch name {
+		case "cond":
+			return unwrap(s.evalCond(dot, fun, typ, args, final))
+		case "coalesce":
+			return unwrap(s.evalCoalesce(dot, fun, typ, args, final))
+		}
+	}
+
 	// Build the arg list.
 	argv := make([]reflect.Value, numIn)
 	// Args must be evaluated. Fixed args first.
@@ -359,6 +426,111 @@ func (s *state) evalCall(dot, fun reflect.Value, isBuiltin bool, node parse.Node
 	return unwrap(v)
 }
 
+// evalTry evaluates the single argument of the try function, capturing any
+// template error, and passes the value and the error on to fun.
+// Added for Hugo.
+func (s *state) evalTry(dot, fun reflect.Value, typ reflect.Type, args []parse.Node, final reflect.Value) reflect.Value {
+	numArgs := len(args)
+	if final != missingVal {
+		numArgs++
+	}
+	if numArgs != 1 {
+		s.errorf("wrong number of args for try: want 1 got %d", numArgs)
+	}
+
+	var (
+		v   reflect.Value
+		err error
+	)
+	if final != missingVal {
+		v = final
+	} else {
+		v, err = s.tryEvalArg(dot, typ.In(0), args[0])
+	}
+
+	argv := []reflect.Value{s.validateType(v, typ.In(0))}
+	if err != nil {
+		argv = append(argv, reflect.ValueOf(err))
+	}
+
+	v, err = safeCall(fun, argv)
+	if err != nil {
+		s.errorf("error calling try: %w", err)
+	}
+	return v
+}
+
+// evalCond evaluates the condition of cond and then only the branch taken.
+// The number of arguments is already checked.
+// Added for Hugo.
+func (s *state) evalCond(dot, fun reflect.Value, typ reflect.Type, args []parse.Node, final reflect.Value) reflect.Value {
+	control := s.evalArg(dot, typ.In(0), args[0])
+
+	i := 2
+	if truth(control) {
+		i = 1
+	}
+
+	var v reflect.Value
+	if i == len(args) {
+		// The last argument is coming from the pipeline.
+		v = s.validateType(final, typ.In(i))
+	} else {
+		v = s.evalArg(dot, typ.In(i), args[i])
+	}
+
+	v, err := safeCall(fun, []reflect.Value{control, v, v})
+	if err != nil {
+		s.errorf("error calling cond: %w", err)
+	}
+	return v
+}
+
+// evalCoalesce evaluates the arguments of coalesce from left to right,
+// stopping at the first non-empty value.
+// Added for Hugo.
+func (s *state) evalCoalesce(dot, fun reflect.Value, typ reflect.Type, args []parse.Node, final reflect.Value) reflect.Value {
+	argType := typ.In(0).Elem()
+
+	call := func(argv ...reflect.Value) reflect.Value {
+		v, err := safeCall(fun, argv)
+		if err != nil {
+			s.errorf("error calling coalesce: %w", err)
+		}
+		return v
+	}
+
+	for _, arg := range args {
+		if v := call(s.evalArg(dot, argType, arg)); truth(v) {
+			return v
+		}
+	}
+	if final != missingVal {
+		return call(s.validateType(final, argType))
+	}
+	return call()
+}
+
+// tryEvalArg evaluates n, returning any template error instead of
+// terminating processing. Runtime errors and other panics are not
+// recovered, as they indicate a bug and not an error in the template.
+// Added for Hugo.
+func (s *state) tryEvalArg(dot reflect.Value, typ reflect.Type, n parse.Node) (v reflect.Value, err error) {
+	mark := s.mark()
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(ExecError)
+			var rerr runtime.Error
+			if !ok || errors.As(e.Err, &rerr) {
+				panic(r)
+			}
+			s.pop(mark)
+			v, err = reflect.Value{}, e
+		}
+	}()
+	return s.evalArg(dot, typ, n), nil
+}
+
 func isTrue(val reflect.Value) (truth, ok bool) {
 	return hreflect.IsTruthfulValue(val), true
 }
@@ -0,0 +1,436 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+/*
+
+This file contains the Hugo related addons. All the other files in this
+package are auto generated.
+
+*/
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// encodingVersion must be bumped whenever the encoding below or the node
+// types change.
+const encodingVersion = 1
+
+// EncodeTrees returns a binary representation of trees, the result of
+// parsing a single template text, which can be decoded with DecodeTrees.
+// This is much faster to decode than parsing the text again.
+func EncodeTrees(trees map[string]*Tree) ([]byte, error) {
+	names := make([]string, 0, len(trees))
+	for name := range trees {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	e := &treeEncoder{}
+	e.uint(encodingVersion)
+	e.uint(uint64(len(names)))
+	for _, name := range names {
+		t := trees[name]
+		e.string(name)
+		e.string(t.Name)
+		e.string(t.ParseName)
+		e.uint(uint64(t.Mode))
+		if err := e.node(t.Root); err != nil {
+			return nil, err
+		}
+	}
+
+	return e.buf, nil
+}
+
+// DecodeTrees decodes trees encoded with EncodeTrees.
+// The text must be the template text the trees were parsed from; it's used
+// for error messages.
+func DecodeTrees(data []byte, text string) (trees map[string]*Tree, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			trees = nil
+			err = fmt.Errorf("failed to decode template trees: %v", r)
+		}
+	}()
+
+	d := &treeDecoder{buf: data}
+	if v := d.uint(); v != encodingVersion {
+		return nil, fmt.Errorf("failed to decode template trees: unsupported version %d", v)
+	}
+	n := int(d.uint())
+	trees = make(map[string]*Tree, n)
+	for i := 0; i < n; i++ {
+		name := d.string()
+		t := &Tree{text: text}
+		t.Name = d.string()
+		t.ParseName = d.string()
+		t.Mode = Mode(d.uint())
+		d.tr = t
+		t.Root = d.list()
+		trees[name] = t
+	}
+	if len(d.buf) != 0 {
+		return nil, errors.New("failed to decode template trees: trailing data")
+	}
+
+	return trees, nil
+}
+
+type treeEncoder struct {
+	buf []byte
+}
+
+func (e *treeEncoder) uint(v uint64) {
+	e.buf = binary.AppendUvarint(e.buf, v)
+}
+
+func (e *treeEncoder) int(v int64) {
+	e.buf = binary.AppendVarint(e.buf, v)
+}
+
+func (e *treeEncoder) bool(v bool) {
+	if v {
+		e.buf = append(e.buf, 1)
+	} else {
+		e.buf = append(e.buf, 0)
+	}
+}
+
+func (e *treeEncoder) string(s string) {
+	e.uint(uint64(len(s)))
+	e.buf = append(e.buf, s...)
+}
+
+func (e *treeEncoder) strings(ss []string) {
+	e.uint(uint64(len(ss)))
+	for _, s := range ss {
+		e.string(s)
+	}
+}
+
+// node writes the node type, offset by one to make room for nil,
+// the position and the node specific fields.
+func (e *treeEncoder) node(n Node) error {
+	if isNilNode(n) {
+		e.uint(0)
+		return nil
+	}
+	e.uint(uint64(n.Type()) + 1)
+	e.int(int64(n.Position()))
+
+	var err error
+	switch n := n.(type) {
+	case *ListNode:
+		e.uint(uint64(len(n.Nodes)))
+		for _, nn := range n.Nodes {
+			if err = e.node(nn); err != nil {
+				return err
+			}
+		}
+	case *TextNode:
+		e.string(string(n.Text))
+	case *CommentNode:
+		e.string(n.Text)
+	case *PipeNode:
+		e.int(int64(n.Line))
+		e.bool(n.IsAssign)
+		e.uint(uint64(len(n.Decl)))
+		for _, v := range n.Decl {
+			e.int(int64(v.Pos))
+			e.strings(v.Ident)
+		}
+		e.uint(uint64(len(n.Cmds)))
+		for _, c := range n.Cmds {
+			if err = e.node(c); err != nil {
+				return err
+			}
+		}
+	case *ActionNode:
+		e.int(int64(n.Line))
+		err = e.node(n.Pipe)
+	case *CommandNode:
+		e.uint(uint64(len(n.Args)))
+		for _, a := range n.Args {
+			if err = e.node(a); err != nil {
+				return err
+			}
+		}
+	case *IdentifierNode:
+		e.string(n.Ident)
+	case *VariableNode:
+		e.strings(n.Ident)
+	case *DotNode, *NilNode:
+	case *FieldNode:
+		e.strings(n.Ident)
+	case *ChainNode:
+		e.strings(n.Field)
+		err = e.node(n.Node)
+	case *BoolNode:
+		e.bool(n.True)
+	case *NumberNode:
+		e.bool(n.IsInt)
+		e.bool(n.IsUint)
+		e.bool(n.IsFloat)
+		e.bool(n.IsComplex)
+		e.int(n.Int64)
+		e.uint(n.Uint64)
+		e.uint(math.Float64bits(n.Float64))
+		e.uint(math.Float64bits(real(n.Complex128)))
+		e.uint(math.Float64bits(imag(n.Complex128)))
+		e.string(n.Text)
+	case *StringNode:
+		e.string(n.Quoted)
+		e.string(n.Text)
+	case *IfNode:
+		err = e.branch(&n.BranchNode)
+	case *RangeNode:
+		err = e.branch(&n.BranchNode)
+	case *WithNode:
+		err = e.branch(&n.BranchNode)
+	case *BreakNode:
+		e.int(int64(n.Line))
+	case *ContinueNode:
+		e.int(int64(n.Line))
+	case *TemplateNode:
+		e.int(int64(n.Line))
+		e.string(n.Name)
+		err = e.node(n.Pipe)
+	default:
+		err = fmt.Errorf("unsupported node type %T", n)
+	}
+
+	return err
+}
+
+func (e *treeEncoder) branch(n *BranchNode) error {
+	e.int(int64(n.Line))
+	if err := e.node(n.Pipe); err != nil {
+		return err
+	}
+	if err := e.node(n.List); err != nil {
+		return err
+	}
+	return e.node(n.ElseList)
+}
+
+// isNilNode reports whether n is nil or a typed nil pointer.
+func isNilNode(n Node) bool {
+	switch n := n.(type) {
+	case nil:
+		return true
+	case *ListNode:
+		return n == nil
+	case *PipeNode:
+		return n == nil
+	}
+	return false
+}
+
+type treeDecoder struct {
+	buf []byte
+	tr  *Tree
+}
+
+func (d *treeDecoder) uint() uint64 {
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		panic("invalid uvarint")
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *treeDecoder) int() int64 {
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		panic("invalid varint")
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *treeDecoder) bool() bool {
+	v := d.buf[0]
+	d.buf = d.buf[1:]
+	return v == 1
+}
+
+func (d *treeDecoder) string() string {
+	n := d.uint()
+	if n > uint64(len(d.buf)) {
+		panic("invalid string length")
+	}
+	s := string(d.buf[:n])
+	d.buf = d.buf[n:]
+	return s
+}
+
+func (d *treeDecoder) strings() []string {
+	n := d.uint()
+	if n > uint64(len(d.buf)) {
+		panic("invalid slice length")
+	}
+	ss := make([]string, n)
+	for i := range ss {
+		ss[i] = d.string()
+	}
+	return ss
+}
+
+func (d *treeDecoder) list() *ListNode {
+	n := d.node()
+	if n == nil {
+		return nil
+	}
+	return n.(*ListNode)
+}
+
+func (d *treeDecoder) pipe() *PipeNode {
+	n := d.node()
+	if n == nil {
+		return nil
+	}
+	return n.(*PipeNode)
+}
+
+func (d *treeDecoder) node() Node {
+	typ := d.uint()
+	if typ == 0 {
+		return nil
+	}
+	t := d.tr
+	pos := Pos(d.int())
+
+	switch NodeType(typ - 1) {
+	case NodeList:
+		n := t.newList(pos)
+		count := d.uint()
+		if count > uint64(len(d.buf)) {
+			panic("invalid list length")
+		}
+		n.Nodes = make([]Node, count)
+		for i := range n.Nodes {
+			n.Nodes[i] = d.node()
+		}
+		return n
+	case NodeText:
+		return t.newText(pos, d.string())
+	case NodeComment:
+		return t.newComment(pos, d.string())
+	case NodePipe:
+		line := int(d.int())
+		isAssign := d.bool()
+		count := d.uint()
+		if count > uint64(len(d.buf)) {
+			panic("invalid decl length")
+		}
+		decl := make([]*VariableNode, count)
+		for i := range decl {
+			vpos := Pos(d.int())
+			decl[i] = &VariableNode{tr: t, NodeType: NodeVariable, Pos: vpos, Ident: d.strings()}
+		}
+		n := t.newPipeline(pos, line, decl)
+		n.IsAssign = isAssign
+		count = d.uint()
+		if count > uint64(len(d.buf)) {
+			panic("invalid command length")
+		}
+		n.Cmds = make([]*CommandNode, count)
+		for i := range n.Cmds {
+			n.Cmds[i] = d.node().(*CommandNode)
+		}
+		return n
+	case NodeAction:
+		line := int(d.int())
+		return t.newAction(pos, line, d.pipe())
+	case NodeCommand:
+		n := t.newCommand(pos)
+		count := d.uint()
+		if count > uint64(len(d.buf)) {
+			panic("invalid argument length")
+		}
+		n.Args = make([]Node, count)
+		for i := range n.Args {
+			n.Args[i] = d.node()
+		}
+		return n
+	case NodeIdentifier:
+		return NewIdentifier(d.string()).SetTree(t).SetPos(pos)
+	case NodeVariable:
+		return &VariableNode{tr: t, NodeType: NodeVariable, Pos: pos, Ident: d.strings()}
+	case NodeDot:
+		return t.newDot(pos)
+	case NodeNil:
+		return t.newNil(pos)
+	case NodeField:
+		return &FieldNode{tr: t, NodeType: NodeField, Pos: pos, Ident: d.strings()}
+	case NodeChain:
+		field := d.strings()
+		n := t.newChain(pos, d.node())
+		n.Field = field
+		return n
+	case NodeBool:
+		return t.newBool(pos, d.bool())
+	case NodeNumber:
+		n := &NumberNode{tr: t, NodeType: NodeNumber, Pos: pos}
+		n.IsInt = d.bool()
+		n.IsUint = d.bool()
+		n.IsFloat = d.bool()
+		n.IsComplex = d.bool()
+		n.Int64 = d.int()
+		n.Uint64 = d.uint()
+		n.Float64 = math.Float64frombits(d.uint())
+		re := math.Float64frombits(d.uint())
+		im := math.Float64frombits(d.uint())
+		n.Complex128 = complex(re, im)
+		n.Text = d.string()
+		return n
+	case NodeString:
+		quoted := d.string()
+		return t.newString(pos, quoted, d.string())
+	case NodeIf:
+		line, pipe, list, elseList := d.branch()
+		return t.newIf(pos, line, pipe, list, elseList)
+	case NodeRange:
+		line, pipe, list, elseList := d.branch()
+		return t.newRange(pos, line, pipe, list, elseList)
+	case NodeWith:
+		line, pipe, list, elseList := d.branch()
+		return t.newWith(pos, line, pipe, list, elseList)
+	case NodeBreak:
+		return t.newBreak(pos, int(d.int()))
+	case NodeContinue:
+		return t.newContinue(pos, int(d.int()))
+	case NodeTemplate:
+		line := int(d.int())
+		name := d.string()
+		return t.newTemplate(pos, line, name, d.pipe())
+	default:
+		panic(fmt.Sprintf("unsupported node type %d", typ-1))
+	}
+}
+
+func (d *treeDecoder) branch() (int, *PipeNode, *ListNode, *ListNode) {
+	line := int(d.int())
+	pipe := d.pipe()
+	list := d.list()
+	elseList := d.list()
+	return line, pipe, list, elseList
+}
@@ -0,0 +1,140 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEncodeDecodeTrees(t *testing.T) {
+	inputs := []string{
+		`{{ define "foo" }}{{ .Foo }}{{ end }}{{ template "foo" . }}`,
+		`{{ $x := 1.5 }}{{ $y := 0x10 }}{{ $z := 1i }}{{ $x = 'a' }}{{ -3 }}`,
+		`{{ range $i, $e := .Items }}{{ if eq $i 0 }}{{ continue }}{{ else if gt $i 5 }}{{ break }}{{ end }}{{ $e.Name }}{{ end }}`,
+		`{{ with $x := .Foo }}{{ (index . "a").Bar.Baz }}{{ else }}{{ print nil .Bar }}{{ end }}`,
+		`{{/* comment */}}{{- "quoted" | printf "%s" -}} {{ true }} {{ false }} {{ . }}`,
+	}
+	for _, test := range parseTests {
+		if test.ok {
+			inputs = append(inputs, test.input)
+		}
+	}
+
+	fn := func() {}
+	funcs := map[string]any{"printf": fn, "eq": fn, "gt": fn, "index": fn, "print": fn}
+
+	for _, input := range inputs {
+		tr := New("main")
+		tr.Mode = ParseComments
+		trees := make(map[string]*Tree)
+		if _, err := tr.Parse(input, "", "", trees, funcs, builtins); err != nil {
+			t.Fatalf("%q: %s", input, err)
+		}
+
+		b, err := EncodeTrees(trees)
+		if err != nil {
+			t.Fatalf("%q: %s", input, err)
+		}
+		decoded, err := DecodeTrees(b, input)
+		if err != nil {
+			t.Fatalf("%q: %s", input, err)
+		}
+
+		if len(decoded) != len(trees) {
+			t.Fatalf("%q: got %d trees, want %d", input, len(decoded), len(trees))
+		}
+		for name, want := range trees {
+			got := decoded[name]
+			if got == nil {
+				t.Fatalf("%q: missing tree %q", input, name)
+			}
+			if got.Name != want.Name || got.ParseName != want.ParseName || got.Mode != want.Mode || got.text != want.text {
+				t.Errorf("%q: tree %q metadata differs", input, name)
+			}
+			if !nodesEqual(reflect.ValueOf(got.Root), reflect.ValueOf(want.Root)) {
+				t.Errorf("%q: tree %q differs:\ngot:  %s\nwant: %s", input, name, got.Root, want.Root)
+			}
+		}
+	}
+}
+
+func TestDecodeTreesInvalid(t *testing.T) {
+	trees, err := Parse("main", `{{ if . }}{{ .Foo }}{{ end }}`, "", "", builtins)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := EncodeTrees(trees)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < len(b); i++ {
+		if _, err := DecodeTrees(b[:i], ""); err == nil {
+			t.Errorf("expected error for truncated data of length %d", i)
+		}
+	}
+
+	if _, err := DecodeTrees(append([]byte{99}, b[1:]...), ""); err == nil {
+		t.Error("expected error for unsupported version")
+	}
+}
+
+// nodesEqual is like reflect.DeepEqual, but ignores the tree pointers.
+func nodesEqual(v1, v2 reflect.Value) bool {
+	if v1.Kind() != v2.Kind() || v1.Type() != v2.Type() {
+		return false
+	}
+	switch v1.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v1.IsNil() || v2.IsNil() {
+			return v1.IsNil() == v2.IsNil()
+		}
+		return nodesEqual(v1.Elem(), v2.Elem())
+	case reflect.Slice:
+		if v1.Len() != v2.Len() {
+			return false
+		}
+		for i := 0; i < v1.Len(); i++ {
+			if !nodesEqual(v1.Index(i), v2.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < v1.NumField(); i++ {
+			if v1.Type().Field(i).Name == "tr" {
+				continue
+			}
+			if !nodesEqual(v1.Field(i), v2.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Bool:
+		return v1.Bool() == v2.Bool()
+	case reflect.Int, reflect.Int64:
+		return v1.Int() == v2.Int()
+	case reflect.Uint8, reflect.Uint64:
+		return v1.Uint() == v2.Uint()
+	case reflect.Float64:
+		return v1.Float() == v2.Float()
+	case reflect.Complex128:
+		return v1.Complex() == v2.Complex()
+	case reflect.String:
+		return v1.String() == v2.String()
+	default:
+		panic("unsupported kind " + v1.Kind().String())
+	}
+}
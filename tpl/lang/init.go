@@ -55,6 +55,7 @@ func init() {
 			nil,
 			[][2]string{
 				{`{{ 512.5032 | lang.FormatCurrency 2 "USD" }}`, `$512.50`},
+				{`{{ 512.5032 | lang.FormatCurrency "JPY" }}`, `¥513`},
 			},
 		)
 
@@ -62,6 +63,7 @@ func init() {
 			nil,
 			[][2]string{
 				{`{{ 512.5032 | lang.FormatAccounting 2 "NOK" }}`, `NOK512.50`},
+				{`{{ -512.5032 | lang.FormatAccounting "USD" }}`, `($512.50)`},
 			},
 		)
 
@@ -77,6 +79,15 @@ func init() {
 			},
 		)
 
+		ns.AddMethodMapping(ctx.FormatNumberPattern,
+			nil,
+			[][2]string{
+				{`{{ lang.FormatNumberPattern "#,##0.00" 1234567.891 }}`, `1,234,567.89`},
+				{`{{ lang.FormatNumberPattern "#,##0.00" 1234567.891 (dict "decimal" "," "group" ".") }}`, `1.234.567,89`},
+				{`{{ lang.FormatNumberPattern "0.00E0" 12345 }}`, `1.23E4`},
+			},
+		)
+
 		return ns
 	}
 
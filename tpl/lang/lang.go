@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/gohugoio/locales"
+	"github.com/gohugoio/locales/currency"
 	translators "github.com/gohugoio/localescompressed"
 
 	"github.com/gohugoio/hugo/common/hreflect"
@@ -86,33 +87,90 @@ func (ns *Namespace) FormatPercent(precision, number any) (string, error) {
 // FormatCurrency returns the currency representation of number for the given currency and precision
 // for the current language.
 //
-// The return value is formatted with at least two decimal places.
-func (ns *Namespace) FormatCurrency(precision, currency, number any) (string, error) {
-	p, n, err := ns.castPrecisionNumber(precision, number)
+// The arguments are [PRECISION] CURRENCY NUMBER, where CURRENCY is an ISO 4217 code.
+// If PRECISION is omitted, the number of decimal places defined for the currency is used,
+// e.g. 2 for USD and 0 for JPY.
+//
+// If PRECISION is set, the return value is formatted with at least two decimal places.
+func (ns *Namespace) FormatCurrency(args ...any) (string, error) {
+	p, c, n, trim, err := ns.castCurrencyArgs(args)
 	if err != nil {
 		return "", err
 	}
-	c := translators.GetCurrency(cast.ToString(currency))
-	if c < 0 {
-		return "", fmt.Errorf("unknown currency code: %q", currency)
-	}
-	return ns.translator.FmtCurrency(n, p, c), nil
+	return ns.trimCurrencyDecimals(ns.translator.FmtCurrency(n, p, c), trim), nil
 }
 
 // FormatAccounting returns the currency representation of number for the given currency and precision
 // for the current language in accounting notation.
 //
-// The return value is formatted with at least two decimal places.
-func (ns *Namespace) FormatAccounting(precision, currency, number any) (string, error) {
-	p, n, err := ns.castPrecisionNumber(precision, number)
+// The arguments are the same as for FormatCurrency.
+func (ns *Namespace) FormatAccounting(args ...any) (string, error) {
+	p, c, n, trim, err := ns.castCurrencyArgs(args)
 	if err != nil {
 		return "", err
 	}
-	c := translators.GetCurrency(cast.ToString(currency))
+	return ns.trimCurrencyDecimals(ns.translator.FmtAccounting(n, p, c), trim), nil
+}
+
+// castCurrencyArgs casts [PRECISION] CURRENCY NUMBER. It reports whether the
+// two decimal places always added by the translator should be trimmed.
+func (ns *Namespace) castCurrencyArgs(args []any) (uint64, currency.Type, float64, bool, error) {
+	var (
+		precision any
+		code      string
+		trim      bool
+	)
+	switch len(args) {
+	case 2:
+		code = cast.ToString(args[0])
+		digits := currencyDigits(strings.ToUpper(code))
+		precision, trim = digits, digits == 0
+	case 3:
+		precision, code = args[0], cast.ToString(args[1])
+	default:
+		return 0, 0, 0, false, fmt.Errorf("wrong number of arguments, expecting 2 or 3, got %d", len(args))
+	}
+	code = strings.ToUpper(code)
+
+	p, n, err := ns.castPrecisionNumber(precision, args[len(args)-1])
+	if err != nil {
+		return 0, 0, 0, false, err
+	}
+	c := translators.GetCurrency(code)
 	if c < 0 {
-		return "", fmt.Errorf("unknown currency code: %q", currency)
+		return 0, 0, 0, false, fmt.Errorf("unknown currency code: %q", code)
+	}
+	return p, c, n, trim, nil
+}
+
+// trimCurrencyDecimals removes the zero decimal places from the currency
+// representation s if trim is set.
+func (ns *Namespace) trimCurrencyDecimals(s string, trim bool) string {
+	if !trim {
+		return s
+	}
+	syms := localeNumberSymbols(ns.translator)
+	zeros := syms.decimal + strings.Repeat(string(syms.digits[0]), 2)
+	if i := strings.LastIndex(s, zeros); i != -1 {
+		s = s[:i] + s[i+len(zeros):]
+	}
+	return s
+}
+
+// currencyDigits returns the number of decimal places (the minor unit) defined
+// in ISO 4217 for the currency code.
+func currencyDigits(code string) int {
+	switch code {
+	case "BIF", "CLP", "DJF", "GNF", "ISK", "JPY", "KMF", "KRW", "PYG", "RWF",
+		"UGX", "UYI", "VND", "VUV", "XAF", "XOF", "XPF":
+		return 0
+	case "BHD", "IQD", "JOD", "KWD", "LYD", "OMR", "TND":
+		return 3
+	case "CLF", "UYW":
+		return 4
+	default:
+		return 2
 	}
-	return ns.translator.FmtAccounting(n, p, c), nil
 }
 
 func (ns *Namespace) castPrecisionNumber(precision, number any) (uint64, float64, error) {
@@ -104,6 +104,59 @@ func TestFormatNumbers(t *testing.T) {
 	})
 }
 
+func TestFormatCurrencyISO(t *testing.T) {
+	c := qt.New(t)
+
+	nsEn := New(&deps.Deps{}, translators.GetTranslator("en"))
+	nsDe := New(&deps.Deps{}, translators.GetTranslator("de"))
+
+	for _, test := range []struct {
+		ns         *Namespace
+		accounting bool
+		args       []any
+		expect     any
+	}{
+		// The precision defaults to the currency's minor unit.
+		{nsEn, false, []any{"USD", 1234.567}, "$1,234.57"},
+		{nsEn, false, []any{"JPY", 1234.567}, "¥1,235"},
+		{nsEn, false, []any{"jpy", -1234}, "-¥1,234"},
+		{nsEn, false, []any{"KWD", 1234.5678}, "KWD1,234.568"},
+		{nsDe, false, []any{"USD", 1234.567}, "1.234,57 $"},
+		{nsDe, false, []any{"JPY", 1234.567}, "1.235 ¥"},
+		{nsDe, false, []any{"EUR", 19.99}, "19,99 €"},
+
+		// An explicit precision is formatted with at least two decimal places.
+		{nsEn, false, []any{0, "JPY", 1234.567}, "¥1,235.00"},
+		{nsEn, false, []any{3, "USD", 1234.5678}, "$1,234.568"},
+
+		// Accounting notation.
+		{nsEn, true, []any{"USD", -1234.567}, "($1,234.57)"},
+		{nsEn, true, []any{"JPY", -1234.567}, "(¥1,235)"},
+		{nsDe, true, []any{"JPY", -1234.567}, "-1.235 ¥"},
+
+		// Errors.
+		{nsEn, false, []any{"FOO", 1}, false},
+		{nsEn, false, []any{1}, false},
+		{nsEn, false, []any{2, "USD", 1, 2}, false},
+		{nsEn, true, []any{"USD", "abc"}, false},
+	} {
+		msg := qt.Commentf("%v", test.args)
+		var got string
+		var err error
+		if test.accounting {
+			got, err = test.ns.FormatAccounting(test.args...)
+		} else {
+			got, err = test.ns.FormatCurrency(test.args...)
+		}
+		if b, ok := test.expect.(bool); ok && !b {
+			c.Assert(err, qt.Not(qt.IsNil), msg)
+			continue
+		}
+		c.Assert(err, qt.IsNil, msg)
+		c.Assert(got, qt.Equals, test.expect, msg)
+	}
+}
+
 // Issue 9446
 func TestLanguageKeyFormat(t *testing.T) {
 	c := qt.New(t)
@@ -133,3 +186,82 @@ func TestLanguageKeyFormat(t *testing.T) {
 		c.Assert(got, qt.Equals, "3,142")
 	})
 }
+
+func TestFormatNumberPattern(t *testing.T) {
+	c := qt.New(t)
+
+	nsEn := New(&deps.Deps{}, translators.GetTranslator("en"))
+	nsDe := New(&deps.Deps{}, translators.GetTranslator("de"))
+	nsFr := New(&deps.Deps{}, translators.GetTranslator("fr"))
+	nsNil := New(&deps.Deps{}, nil)
+
+	for _, test := range []struct {
+		ns      *Namespace
+		pattern string
+		n       any
+		options []any
+		expect  any
+	}{
+		// US vs European grouping.
+		{nsEn, "#,##0.00", 1234567.891, nil, "1,234,567.89"},
+		{nsDe, "#,##0.00", 1234567.891, nil, "1.234.567,89"},
+		{nsFr, "#,##0.00", 1234567.891, nil, "1 234 567,89"},
+		{nsEn, "#,##0.00", 1234567.891, []any{map[string]any{"decimal": ",", "group": "."}}, "1.234.567,89"},
+		{nsDe, "#,##0.00", 1234567.891, []any{map[string]any{"decimal": ".", "group": ","}}, "1,234,567.89"},
+		{nsDe, "#,##0.00", 1234567.891, []any{map[string]any{"group": "'"}}, "1'234'567,89"},
+		{nsNil, "#,##0.00", 1234567.891, nil, "1,234,567.89"},
+
+		// Grouping.
+		{nsEn, "#,##0", 999, nil, "999"},
+		{nsEn, "#,##0", -1234.5, nil, "-1,235"},
+		{nsEn, "#,##,##0", 123456789, nil, "12,34,56,789"},
+		{nsEn, "#,####", 123456789, nil, "1,2345,6789"},
+		{nsEn, "0", 1234567, nil, "1234567"},
+
+		// Fractions.
+		{nsEn, "0.###", 3.14159, nil, "3.142"},
+		{nsEn, "0.###", 3.1, nil, "3.1"},
+		{nsEn, "0.###", 3, nil, "3"},
+		{nsEn, "0.0##", 3, nil, "3.0"},
+		{nsEn, "#.##", 0.5, nil, ".5"},
+		{nsEn, "#.##", 0, nil, "0"},
+		{nsEn, "000.00", 1.5, nil, "001.50"},
+		{nsEn, "0.00", -0.001, nil, "0.00"},
+
+		// Prefixes, suffixes and negative subpatterns.
+		{nsEn, "$#,##0.00", 1234.5, nil, "$1,234.50"},
+		{nsEn, "$#,##0.00", -1234.5, nil, "-$1,234.50"},
+		{nsEn, "#,##0.00 kr", 1234.5, nil, "1,234.50 kr"},
+		{nsEn, "#,##0.00;(#,##0.00)", -1234.5, nil, "(1,234.50)"},
+		{nsEn, "#,##0.00;(#,##0.00)", 1234.5, nil, "1,234.50"},
+		{nsEn, "0", -5, []any{map[string]any{"minus": "−"}}, "−5"},
+
+		// Scientific notation.
+		{nsEn, "0.00E0", 12345, nil, "1.23E4"},
+		{nsEn, "0.00E+00", 12345, nil, "1.23E+04"},
+		{nsEn, "0.###E0", 0.000123, nil, "1.23E-4"},
+		{nsEn, "00.##E0", 12345, nil, "12.35E3"},
+		{nsEn, "0.0E0", 0, nil, "0.0E0"},
+		{nsEn, "0.0E0", 9.99, nil, "1.0E1"},
+		{nsDe, "0.00E0", -12345, nil, "-1,23E4"},
+
+		// Errors.
+		{nsEn, "", 1, nil, false},
+		{nsEn, "abc", 1, nil, false},
+		{nsEn, "0.#0", 1, nil, false},
+		{nsEn, "0#", 1, nil, false},
+		{nsEn, "#,##0.0,0", 1, nil, false},
+		{nsEn, "#,##0E0", 1, nil, false},
+		{nsEn, "0", 1, []any{map[string]any{"foo": "bar"}}, false},
+		{nsEn, "0", "abc", nil, false},
+	} {
+		msg := qt.Commentf("%s %v %v", test.pattern, test.n, test.options)
+		got, err := test.ns.FormatNumberPattern(test.pattern, test.n, test.options...)
+		if b, ok := test.expect.(bool); ok && !b {
+			c.Assert(err, qt.Not(qt.IsNil), msg)
+			continue
+		}
+		c.Assert(err, qt.IsNil, msg)
+		c.Assert(got, qt.Equals, test.expect, msg)
+	}
+}
@@ -0,0 +1,423 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lang
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/locales"
+	"github.com/spf13/cast"
+)
+
+// FormatNumberPattern formats number using a CLDR style decimal pattern,
+// e.g. `#,##0.00`, `0.###`, `#,##,##0` or `0.00E+0`.
+//
+// The decimal point, grouping separator, minus sign and digits are taken
+// from the current language. The optional options map can set the
+// "decimal", "group" and "minus" symbols explicitly.
+func (ns *Namespace) FormatNumberPattern(pattern, number any, options ...any) (string, error) {
+	s, err := cast.ToStringE(pattern)
+	if err != nil {
+		return "", err
+	}
+	n, err := cast.ToFloat64E(number)
+	if err != nil {
+		return "", err
+	}
+	if len(options) > 1 {
+		return "", fmt.Errorf("wrong number of arguments, expecting at most 3, got %d", len(options)+2)
+	}
+
+	p, err := parseNumberPattern(s)
+	if err != nil {
+		return "", err
+	}
+
+	syms := localeNumberSymbols(ns.translator)
+	if len(options) == 1 {
+		m, err := maps.ToStringMapE(options[0])
+		if err != nil {
+			return "", fmt.Errorf("invalid options: %w", err)
+		}
+		for k, v := range m {
+			sv, err := cast.ToStringE(v)
+			if err != nil {
+				return "", fmt.Errorf("invalid value for option %q: %w", k, err)
+			}
+			switch strings.ToLower(k) {
+			case "decimal":
+				syms.decimal = sv
+			case "group":
+				syms.group = sv
+			case "minus":
+				syms.minus = sv
+			default:
+				return "", fmt.Errorf("unknown option %q", k)
+			}
+		}
+	}
+
+	return p.format(n, syms), nil
+}
+
+// numberSymbols holds the locale specific parts of a formatted number.
+type numberSymbols struct {
+	decimal string
+	group   string
+	minus   string
+	digits  [10]rune
+}
+
+var defaultNumberSymbols = numberSymbols{
+	decimal: ".",
+	group:   ",",
+	minus:   "-",
+	digits:  [10]rune{'0', '1', '2', '3', '4', '5', '6', '7', '8', '9'},
+}
+
+// localeNumberSymbols derives the number symbols of t by formatting
+// numbers with known digits.
+func localeNumberSymbols(t locales.Translator) numberSymbols {
+	syms := defaultNumberSymbols
+	if t == nil {
+		return syms
+	}
+
+	// All ten digits, a group separator and a decimal point.
+	const ref = "98765432105"
+	var (
+		digits  []rune
+		between []string
+		sep     strings.Builder
+	)
+	for _, r := range t.FmtNumber(9876543210.5, 1) {
+		if unicode.IsDigit(r) {
+			if len(digits) > 0 {
+				between = append(between, sep.String())
+			}
+			sep.Reset()
+			digits = append(digits, r)
+			continue
+		}
+		sep.WriteRune(r)
+	}
+	if len(digits) != len(ref) {
+		return syms
+	}
+	for i, r := range digits {
+		syms.digits[ref[i]-'0'] = r
+	}
+	syms.decimal = between[len(between)-1]
+	for _, s := range between[:len(between)-1] {
+		if s != "" {
+			syms.group = s
+			break
+		}
+	}
+
+	if minus, _, found := strings.Cut(t.FmtNumber(-1, 0), string(syms.digits[1])); found {
+		syms.minus = minus
+	}
+
+	return syms
+}
+
+// numberPattern is a parsed decimal pattern.
+type numberPattern struct {
+	posPrefix, posSuffix string
+	negPrefix, negSuffix string
+	hasNeg               bool
+
+	minInt            int
+	minFrac, maxFrac  int
+	primaryGrouping   int
+	secondaryGrouping int
+	scientific        bool
+	minExp            int
+	forceExponentPlus bool
+}
+
+// parseNumberPattern parses a pattern on the form
+// POSITIVE[;NEGATIVE], where the negative subpattern only contributes its
+// prefix and suffix.
+func parseNumberPattern(s string) (numberPattern, error) {
+	var p numberPattern
+	if s == "" {
+		return p, fmt.Errorf("empty number pattern")
+	}
+
+	pos, neg, hasNeg := strings.Cut(s, ";")
+
+	prefix, number, suffix := splitNumberPattern(pos)
+	if number == "" {
+		return p, fmt.Errorf("invalid number pattern %q: no digits", s)
+	}
+	p.posPrefix, p.posSuffix = prefix, suffix
+
+	if hasNeg {
+		nprefix, _, nsuffix := splitNumberPattern(neg)
+		p.negPrefix, p.negSuffix, p.hasNeg = nprefix, nsuffix, true
+	}
+
+	mantissa, exp, scientific := strings.Cut(number, "E")
+	if scientific {
+		p.scientific = true
+		if strings.HasPrefix(exp, "+") {
+			p.forceExponentPlus = true
+			exp = exp[1:]
+		}
+		if exp == "" || strings.Trim(exp, "0") != "" {
+			return p, fmt.Errorf("invalid number pattern %q: invalid exponent", s)
+		}
+		p.minExp = len(exp)
+	}
+
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	if strings.ContainsAny(fracPart, ".,") {
+		return p, fmt.Errorf("invalid number pattern %q: unexpected separator in fraction", s)
+	}
+
+	var seenZero bool
+	for _, r := range strings.ReplaceAll(intPart, ",", "") {
+		switch r {
+		case '#':
+			if seenZero {
+				return p, fmt.Errorf("invalid number pattern %q: '#' after '0'", s)
+			}
+		case '0':
+			seenZero = true
+			p.minInt++
+		}
+	}
+
+	if groups := strings.Split(intPart, ","); len(groups) > 1 {
+		if scientific {
+			return p, fmt.Errorf("invalid number pattern %q: grouping is not supported in scientific notation", s)
+		}
+		p.primaryGrouping = len(groups[len(groups)-1])
+		if len(groups) > 2 {
+			p.secondaryGrouping = len(groups[len(groups)-2])
+		}
+		if p.primaryGrouping == 0 || (len(groups) > 2 && p.secondaryGrouping == 0) {
+			return p, fmt.Errorf("invalid number pattern %q: empty group", s)
+		}
+		if p.secondaryGrouping == p.primaryGrouping {
+			p.secondaryGrouping = 0
+		}
+	}
+
+	var seenHash bool
+	for _, r := range fracPart {
+		switch r {
+		case '0':
+			if seenHash {
+				return p, fmt.Errorf("invalid number pattern %q: '0' after '#' in fraction", s)
+			}
+			p.minFrac++
+		case '#':
+			seenHash = true
+		}
+		p.maxFrac++
+	}
+
+	if p.scientific && p.minInt == 0 {
+		p.minInt = 1
+	}
+
+	return p, nil
+}
+
+// splitNumberPattern splits s into its literal prefix, the number part and
+// the literal suffix.
+func splitNumberPattern(s string) (prefix, number, suffix string) {
+	isNumberChar := func(r rune) bool {
+		return r == '#' || r == '0' || r == ',' || r == '.'
+	}
+	start := strings.IndexFunc(s, isNumberChar)
+	if start == -1 {
+		return s, "", ""
+	}
+	end := start
+	for end < len(s) {
+		c := s[end]
+		if isNumberChar(rune(c)) {
+			end++
+			continue
+		}
+		if c == 'E' && end+1 < len(s) && (s[end+1] == '0' || s[end+1] == '+') {
+			end++
+			if s[end] == '+' {
+				end++
+			}
+			for end < len(s) && s[end] == '0' {
+				end++
+			}
+		}
+		break
+	}
+	return s[:start], s[start:end], s[end:]
+}
+
+func (p numberPattern) format(n float64, syms numberSymbols) string {
+	neg := n < 0
+	n = math.Abs(n)
+
+	var intDigits, fracDigits, expDigits string
+	var expNeg bool
+
+	if p.scientific {
+		// Round the shortest decimal representation to the wanted number of
+		// significant digits, half away from zero as in the other number
+		// functions, and move the decimal point to get minInt integer digits.
+		e := strconv.FormatFloat(n, 'e', -1, 64)
+		mant, exps, _ := strings.Cut(e, "e")
+		digits := strings.Replace(mant, ".", "", 1)
+		exp, _ := strconv.Atoi(exps)
+		digits, carry := roundDigits(digits, p.minInt+p.maxFrac)
+		if carry {
+			exp++
+		}
+		if n != 0 {
+			exp -= p.minInt - 1
+		} else {
+			exp = 0
+		}
+		intDigits, fracDigits = digits[:p.minInt], digits[p.minInt:]
+		expNeg = exp < 0
+		if expNeg {
+			exp = -exp
+		}
+		expDigits = strconv.Itoa(exp)
+		for len(expDigits) < p.minExp {
+			expDigits = "0" + expDigits
+		}
+	} else {
+		exp := math.Pow(10, float64(p.maxFrac))
+		r := math.Round(n*exp) / exp
+		s := strconv.FormatFloat(r, 'f', p.maxFrac, 64)
+		intDigits, fracDigits, _ = strings.Cut(s, ".")
+		intDigits = strings.TrimLeft(intDigits, "0")
+		for len(intDigits) < p.minInt {
+			intDigits = "0" + intDigits
+		}
+		if intDigits == "" && strings.Trim(fracDigits, "0") == "" {
+			intDigits = "0"
+		}
+	}
+
+	for len(fracDigits) > p.minFrac && strings.HasSuffix(fracDigits, "0") {
+		fracDigits = fracDigits[:len(fracDigits)-1]
+	}
+
+	if neg && strings.Trim(intDigits+fracDigits, "0") == "" {
+		// Don't render -0.
+		neg = false
+	}
+
+	var b strings.Builder
+
+	if neg {
+		if p.hasNeg {
+			b.WriteString(p.negPrefix)
+		} else {
+			b.WriteString(syms.minus)
+			b.WriteString(p.posPrefix)
+		}
+	} else {
+		b.WriteString(p.posPrefix)
+	}
+
+	writeDigits := func(s string) {
+		for i := 0; i < len(s); i++ {
+			b.WriteRune(syms.digits[s[i]-'0'])
+		}
+	}
+
+	for i := 0; i < len(intDigits); i++ {
+		if i > 0 && p.primaryGrouping > 0 {
+			if pos := len(intDigits) - i; p.isGroupBoundary(pos) {
+				b.WriteString(syms.group)
+			}
+		}
+		writeDigits(intDigits[i : i+1])
+	}
+
+	if fracDigits != "" {
+		b.WriteString(syms.decimal)
+		writeDigits(fracDigits)
+	}
+
+	if p.scientific {
+		b.WriteByte('E')
+		if expNeg {
+			b.WriteString(syms.minus)
+		} else if p.forceExponentPlus {
+			b.WriteByte('+')
+		}
+		writeDigits(expDigits)
+	}
+
+	if neg && p.hasNeg {
+		b.WriteString(p.negSuffix)
+	} else {
+		b.WriteString(p.posSuffix)
+	}
+
+	return b.String()
+}
+
+// isGroupBoundary reports whether a group separator goes before the digit
+// with pos digits remaining in the integer part.
+func (p numberPattern) isGroupBoundary(pos int) bool {
+	if pos == p.primaryGrouping {
+		return true
+	}
+	if pos < p.primaryGrouping {
+		return false
+	}
+	secondary := p.secondaryGrouping
+	if secondary == 0 {
+		secondary = p.primaryGrouping
+	}
+	return (pos-p.primaryGrouping)%secondary == 0
+}
+
+// roundDigits rounds the decimal digits in s to n digits, padding with
+// zeros if needed. It reports whether rounding carried into a new leading
+// digit.
+func roundDigits(s string, n int) (string, bool) {
+	if len(s) <= n {
+		return s + strings.Repeat("0", n-len(s)), false
+	}
+	b := []byte(s[:n])
+	if s[n] >= '5' {
+		i := n - 1
+		for ; i >= 0; i-- {
+			if b[i] < '9' {
+				b[i]++
+				break
+			}
+			b[i] = '0'
+		}
+		if i < 0 {
+			return "1" + string(b[:n-1]), true
+		}
+	}
+	return string(b), false
+}
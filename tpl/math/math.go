@@ -15,14 +15,15 @@
 package math
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
-	"math/rand"
 	"reflect"
 	"sync/atomic"
 
 	_math "github.com/gohugoio/hugo/common/math"
+	"github.com/gohugoio/hugo/deps"
 	"github.com/spf13/cast"
 )
 
@@ -32,12 +33,14 @@ var (
 )
 
 // New returns a new instance of the math-namespaced template functions.
-func New() *Namespace {
-	return &Namespace{}
+func New(d *deps.Deps) *Namespace {
+	return &Namespace{d: d}
 }
 
 // Namespace provides template functions for the "math" namespace.
-type Namespace struct{}
+type Namespace struct {
+	d *deps.Deps
+}
 
 // Abs returns the absolute value of n.
 func (ns *Namespace) Abs(n any) (float64, error) {
@@ -159,8 +162,8 @@ func (ns *Namespace) Pow(n1, n2 any) (float64, error) {
 }
 
 // Rand returns, as a float64, a pseudo-random number in the half-open interval [0.0,1.0).
-func (ns *Namespace) Rand() float64 {
-	return rand.Float64()
+func (ns *Namespace) Rand(ctx context.Context) float64 {
+	return ns.d.Rand(ctx).Float64()
 }
 
 // Round returns the integer nearest to n, rounding half away from zero.
@@ -57,6 +57,13 @@ func init() {
 			},
 		)
 
+		ns.AddMethodMapping(ctx.Stat,
+			nil,
+			[][2]string{
+				{`{{ (os.Stat "files/README.txt").Size }}`, `11`},
+			},
+		)
+
 		return ns
 	}
 
@@ -22,8 +22,12 @@ import (
 	"path/filepath"
 
 	"github.com/bep/overlayfs"
+	"github.com/gobwas/glob"
 	"github.com/gohugoio/hugo/common/herrors"
+	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/deps"
+	hglob "github.com/gohugoio/hugo/hugofs/glob"
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/afero"
 	"github.com/spf13/cast"
 )
@@ -109,14 +113,99 @@ func (ns *Namespace) ReadFile(i any) (string, error) {
 	return s, err
 }
 
-// ReadDir lists the directory contents relative to the configured WorkingDir.
-func (ns *Namespace) ReadDir(i any) ([]_os.FileInfo, error) {
+// fileInfo is a directory entry returned by ReadDir.
+type fileInfo struct {
+	_os.FileInfo
+	path string
+}
+
+// Path returns the slash separated path of the entry relative to the
+// directory passed to ReadDir.
+func (fi fileInfo) Path() string {
+	return fi.path
+}
+
+// readDirOptions configures ReadDir.
+type readDirOptions struct {
+	// Whether to walk all subdirectories.
+	Recursive bool
+
+	// If set, only include entries with a path matching this Glob pattern,
+	// e.g. "**.pdf".
+	Glob string
+}
+
+// ReadDir lists the directory contents relative to the configured WorkingDir
+// sorted by path. The entries also have a Path method returning the path
+// relative to the given directory.
+//
+// You can optionally provide an options map as the last argument; set
+// recursive to true to walk all subdirectories and glob to a Glob pattern to
+// only include entries with a matching path.
+func (ns *Namespace) ReadDir(i any, options ...any) ([]_os.FileInfo, error) {
 	path, err := cast.ToStringE(i)
 	if err != nil {
 		return nil, err
 	}
 
-	list, err := afero.ReadDir(ns.workFs, path)
+	var opts readDirOptions
+	if len(options) > 1 {
+		return nil, errors.New("too many arguments to readDir")
+	}
+	if len(options) == 1 {
+		m, err := maps.ToStringMapE(options[0])
+		if err != nil {
+			return nil, fmt.Errorf("options must be a map: %w", err)
+		}
+		if err := mapstructure.WeakDecode(m, &opts); err != nil {
+			return nil, fmt.Errorf("failed to decode options: %w", err)
+		}
+	}
+
+	var g glob.Glob
+	if opts.Glob != "" {
+		g, err = hglob.GetGlob(opts.Glob)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	include := func(fi fileInfo) bool {
+		return g == nil || g.Match(fi.Path())
+	}
+
+	var list []_os.FileInfo
+
+	if !opts.Recursive {
+		fis, err := afero.ReadDir(ns.workFs, path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read directory %q: %s", path, err)
+		}
+		for _, fi := range fis {
+			if fi := (fileInfo{FileInfo: fi, path: fi.Name()}); include(fi) {
+				list = append(list, fi)
+			}
+		}
+		return list, nil
+	}
+
+	root := filepath.Clean(path)
+	err = afero.Walk(ns.workFs, root, func(filename string, fi _os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if filename == root {
+			return nil
+		}
+		rel, err := filepath.Rel(root, filename)
+		if err != nil {
+			return err
+		}
+		if fi := (fileInfo{FileInfo: fi, path: filepath.ToSlash(rel)}); include(fi) {
+			list = append(list, fi)
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to read directory %q: %s", path, err)
 	}
@@ -144,6 +233,7 @@ func (ns *Namespace) FileExists(i any) (bool, error) {
 }
 
 // Stat returns the os.FileInfo structure describing file.
+// It returns an error if the file does not exist.
 func (ns *Namespace) Stat(i any) (_os.FileInfo, error) {
 	path, err := cast.ToStringE(i)
 	if err != nil {
@@ -151,12 +241,16 @@ func (ns *Namespace) Stat(i any) (_os.FileInfo, error) {
 	}
 
 	if path == "" {
-		return nil, errors.New("fileStat needs a path to a file")
+		return nil, errors.New("stat needs a path to a file")
+	}
+
+	if ns.deps.PathSpec != nil {
+		path = ns.deps.PathSpec.RelPathify(path)
 	}
 
 	r, err := ns.readFileFs.Stat(path)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to stat %q: %w", path, err)
 	}
 
 	return r, nil
@@ -75,3 +75,55 @@ func TestReadFileNotExists(t *testing.T) {
 OK
 `)
 }
+
+func TestReadDirRecursiveGlob(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "page"]
+-- assets/downloads/a.pdf --
+a
+-- assets/downloads/b.txt --
+b
+-- assets/downloads/sub/c.PDF --
+cc
+-- assets/downloads/sub/deeper/d.pdf --
+ddd
+-- layouts/index.html --
+Flat: {{ range readDir "assets/downloads" }}{{ .Path }}|{{ end }}$
+Recursive: {{ range readDir "assets/downloads" (dict "recursive" true) }}{{ .Path }}:{{ .IsDir }}|{{ end }}$
+Glob: {{ range os.ReadDir "/assets/downloads" (dict "recursive" true "glob" "**.pdf") }}{{ .Path }}:{{ .Name }}:{{ .Size }}|{{ end }}$
+GlobFlat: {{ range readDir "assets/downloads" (dict "glob" "*.txt") }}{{ .Path }}|{{ end }}$
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		"Flat: a.pdf|b.txt|sub|$",
+		"Recursive: a.pdf:false|b.txt:false|sub:true|sub/c.PDF:false|sub/deeper:true|sub/deeper/d.pdf:false|$",
+		"Glob: a.pdf:a.pdf:1|sub/c.PDF:c.PDF:2|sub/deeper/d.pdf:d.pdf:3|$",
+		"GlobFlat: b.txt|$",
+	)
+}
+
+func TestStatNotExists(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "page"]
+-- assets/a.txt --
+abc
+-- layouts/index.html --
+{{ with os.Stat "assets/a.txt" }}Name: {{ .Name }}|Size: {{ .Size }}|IsDir: {{ .IsDir }}|ModTime: {{ not .ModTime.IsZero }}|{{ end }}
+{{ with try (os.Stat "assets/doesnotexist.txt") }}{{ with .Err }}Err: {{ . }}{{ end }}{{ end }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		"Name: a.txt|Size: 3|IsDir: false|ModTime: true|",
+		"failed to stat &#34;assets/doesnotexist.txt&#34;: file does not exist",
+	)
+}
@@ -319,3 +319,29 @@ BAR
 
 	b.AssertFileContent("public/index.html", "OO:BAR")
 }
+
+func TestIncludeErrorCallStack(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = 'http://example.com/'
+disableKinds = ["taxonomy", "term", "page", "rss", "sitemap", "robotsTXT", "404"]
+-- layouts/index.html --
+Home.
+{{ partial "list.html" . }}
+-- layouts/partials/list.html --
+List.
+
+{{ partialCached "card.html" . }}
+-- layouts/partials/card.html --
+Card.
+{{ .Foo.Bar }}
+`
+
+	b, err := hugolib.TestE(t, files)
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `"/layouts/partials/card.html:2:7": execute of template failed (called from partials/list.html:3:3 <- index.html:2:3): template: partials/card.html:2:7`)
+	b.Assert(strings.Count(err.Error(), "called from"), qt.Equals, 1)
+}
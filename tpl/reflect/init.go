@@ -46,6 +46,15 @@ func init() {
 			},
 		)
 
+		ns.AddMethodMapping(ctx.KindOf,
+			nil,
+			[][2]string{
+				{`{{ reflect.KindOf (dict "a" 1) }}`, `map`},
+				{`{{ reflect.KindOf (slice 1 2 3) }}`, `slice`},
+				{`{{ reflect.KindOf "foo" }}`, `string`},
+			},
+		)
+
 		return ns
 	}
 
@@ -34,3 +34,9 @@ func (ns *Namespace) IsMap(v any) bool {
 func (ns *Namespace) IsSlice(v any) bool {
 	return reflect.ValueOf(v).Kind() == reflect.Slice
 }
+
+// KindOf returns the kind of v, e.g. "map", "slice", "string", "int", "float64", "bool" or "struct".
+// Pointers are dereferenced, and nil values return "invalid".
+func (ns *Namespace) KindOf(v any) string {
+	return reflect.Indirect(reflect.ValueOf(v)).Kind().String()
+}
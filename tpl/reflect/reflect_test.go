@@ -50,3 +50,25 @@ func TestIsSlice(t *testing.T) {
 		c.Assert(result, qt.Equals, test.expect)
 	}
 }
+
+func TestKindOf(t *testing.T) {
+	c := qt.New(t)
+	s := "foo"
+	for _, test := range []struct {
+		v      any
+		expect any
+	}{
+		{map[string]any{"a": 1}, "map"},
+		{[]int{1, 2}, "slice"},
+		{"foo", "string"},
+		{&s, "string"},
+		{42, "int"},
+		{3.14, "float64"},
+		{true, "bool"},
+		{struct{}{}, "struct"},
+		{nil, "invalid"},
+	} {
+		result := ns.KindOf(test.v)
+		c.Assert(result, qt.Equals, test.expect)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/gohugoio/hugo/cache/dynacache"
 	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/common/paths"
 
@@ -70,6 +71,11 @@ func New(deps *deps.Deps) (*Namespace, error) {
 		postcssClient:     postcss.New(deps.ResourceSpec),
 		templatesClient:   templates.New(deps.ResourceSpec, deps),
 		babelClient:       babel.New(deps.ResourceSpec),
+		hashCache: dynacache.GetOrCreatePartition[string, *resources.StaleValue[string]](
+			deps.MemCache,
+			"/tmpl/resources/hash",
+			dynacache.OptionsPartition{Weight: 10, ClearWhen: dynacache.ClearOnChange},
+		),
 	}, nil
 }
 
@@ -88,6 +94,8 @@ type Namespace struct {
 	babelClient       *babel.Client
 	templatesClient   *templates.Client
 
+	hashCache *dynacache.Partition[string, *resources.StaleValue[string]]
+
 	// The Dart Client requires a os/exec process, so  only
 	// create it if we really need it.
 	// This is mostly to avoid creating one per site build test.
@@ -238,29 +246,47 @@ func (ns *Namespace) Match(pattern any) resource.Resources {
 }
 
 // Concat concatenates a slice of Resource objects. These resources must
-// (currently) be of the same Media Type.
-func (ns *Namespace) Concat(targetPathIn any, r any) (resource.Resource, error) {
+// (currently) be of the same Media Type. The slice may be preceded by an
+// options map, e.g. to merge the source maps of JavaScript resources.
+func (ns *Namespace) Concat(targetPathIn any, args ...any) (resource.Resource, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return nil, errors.New("must provide one or more Resource objects to concat, optionally preceded by an options map")
+	}
+
 	targetPath, err := cast.ToStringE(targetPathIn)
 	if err != nil {
 		return nil, err
 	}
 
+	var m map[string]any
+	if len(args) == 2 {
+		m, err = maps.ToStringMapE(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid options type: %w", err)
+		}
+	}
+
+	opts, err := bundler.DecodeOptions(m)
+	if err != nil {
+		return nil, err
+	}
+
 	var rr resource.Resources
 
-	switch v := r.(type) {
+	switch v := args[len(args)-1].(type) {
 	case resource.Resources:
 		rr = v
 	case resource.ResourcesConverter:
 		rr = v.ToResources()
 	default:
-		return nil, fmt.Errorf("slice %T not supported in concat", r)
+		return nil, fmt.Errorf("slice %T not supported in concat", v)
 	}
 
 	if len(rr) == 0 {
 		return nil, errors.New("must provide one or more Resource objects to concat")
 	}
 
-	return ns.bundlerClient.Concat(targetPath, rr)
+	return ns.bundlerClient.Concat(targetPath, rr, opts)
 }
 
 // FromString creates a Resource from a string published to the relative target path.
@@ -328,6 +354,60 @@ func (ns *Namespace) Fingerprint(args ...any) (resource.Resource, error) {
 	return ns.integrityClient.Fingerprint(r, algo)
 }
 
+// Hash returns the hex encoded hash of the given Resource's content, e.g.
+// for use in a data attribute. The hash algorithm defaults to sha256, the
+// options are md5, sha256, sha384 or sha512. Unlike Fingerprint, this does
+// not change the Resource's RelPermalink and Permalink.
+func (ns *Namespace) Hash(args ...any) (string, error) {
+	if len(args) < 1 {
+		return "", errors.New("must provide a Resource object")
+	}
+
+	if len(args) > 2 {
+		return "", errors.New("must not provide more arguments than Resource and hash algorithm")
+	}
+
+	var algo string
+	resIdx := 0
+
+	if len(args) == 2 {
+		resIdx = 1
+		var err error
+		algo, err = cast.ToStringE(args[0])
+		if err != nil {
+			return "", err
+		}
+	}
+
+	r, ok := args[resIdx].(resource.UnmarshableResource)
+	if !ok {
+		return "", fmt.Errorf("%T can not be hashed", args[resIdx])
+	}
+
+	key := r.Key()
+	if key == "" {
+		return "", errors.New("no Key set in Resource")
+	}
+
+	v, err := ns.hashCache.GetOrCreate(key+"_"+algo, func(string) (*resources.StaleValue[string], error) {
+		h, err := ns.integrityClient.Hash(r, algo)
+		if err != nil {
+			return nil, err
+		}
+		return &resources.StaleValue[string]{
+			Value: h,
+			IsStaleFunc: func() bool {
+				return resource.IsStaleAny(r)
+			},
+		}, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return v.Value, nil
+}
+
 // Minify minifies the given Resource using the MediaType to pick the correct
 // minifier.
 func (ns *Namespace) Minify(r resources.ResourceTransformer) (resource.Resource, error) {
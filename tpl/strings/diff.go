@@ -0,0 +1,171 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strings
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/spf13/cast"
+)
+
+// The segment types returned by Diff.
+const (
+	DiffEqual   = "equal"
+	DiffAdded   = "added"
+	DiffRemoved = "removed"
+)
+
+// DiffSegment is a run of text that is equal in, added to or removed from
+// the new string.
+type DiffSegment struct {
+	// One of "equal", "added" or "removed".
+	Type string
+	Text string
+}
+
+// Words, runs of whitespace and runs of other characters (punctuation).
+var diffWordsRe = regexp.MustCompile(`[\p{L}\p{N}_]+|\s+|[^\p{L}\p{N}_\s]+`)
+
+// Diff returns the difference between the strings old and new as a slice of
+// segments tagged as equal, added or removed, computed with the Myers diff
+// algorithm.
+//
+// The diff is word-level by default. You can optionally provide an options map
+// as the last argument; set granularity to "line" for a line-level diff.
+func (ns *Namespace) Diff(old, new any, options ...any) ([]DiffSegment, error) {
+	olds, err := cast.ToStringE(old)
+	if err != nil {
+		return nil, err
+	}
+	news, err := cast.ToStringE(new)
+	if err != nil {
+		return nil, err
+	}
+
+	granularity := "word"
+	if len(options) > 1 {
+		return nil, errors.New("too many arguments to diff")
+	}
+	if len(options) == 1 {
+		m, err := maps.ToStringMapE(options[0])
+		if err != nil {
+			return nil, fmt.Errorf("options must be a map: %w", err)
+		}
+		if v, found := maps.LookupEqualFold(m, "granularity"); found {
+			granularity = strings.ToLower(cast.ToString(v))
+		}
+	}
+
+	var split func(s string) []string
+	switch granularity {
+	case "word":
+		split = func(s string) []string { return diffWordsRe.FindAllString(s, -1) }
+	case "line":
+		split = func(s string) []string {
+			if s == "" {
+				return nil
+			}
+			return strings.SplitAfter(s, "\n")
+		}
+	default:
+		return nil, fmt.Errorf("invalid diff granularity %q, must be one of \"word\" or \"line\"", granularity)
+	}
+
+	return diffTokens(split(olds), split(news)), nil
+}
+
+// diffTokens returns the shortest edit script between the tokens in a and b
+// as described in "An O(ND) Difference Algorithm and Its Variations" by
+// Eugene W. Myers, with adjacent tokens of the same type merged.
+func diffTokens(a, b []string) []DiffSegment {
+	n, m := len(a), len(b)
+	max := n + m
+	offset := max + 1
+
+	// v holds the furthest x reached on each diagonal k, indexed by k+offset.
+	v := make([]int, 2*max+2)
+	var trace [][]int
+
+	done := n == 0 && m == 0
+	for d := 0; d <= max && !done; d++ {
+		snapshot := make([]int, len(v))
+		copy(snapshot, v)
+		trace = append(trace, snapshot)
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				done = true
+				break
+			}
+		}
+	}
+
+	// Walk the trace backwards from (n, m) to collect the edit script.
+	var reversed []DiffSegment
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			reversed = append(reversed, DiffSegment{Type: DiffEqual, Text: a[x]})
+		}
+		if d > 0 {
+			if x == prevX {
+				reversed = append(reversed, DiffSegment{Type: DiffAdded, Text: b[prevY]})
+			} else {
+				reversed = append(reversed, DiffSegment{Type: DiffRemoved, Text: a[prevX]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	var segments []DiffSegment
+	for i := len(reversed) - 1; i >= 0; i-- {
+		s := reversed[i]
+		if last := len(segments) - 1; last >= 0 && segments[last].Type == s.Type {
+			segments[last].Text += s.Text
+			continue
+		}
+		segments = append(segments, s)
+	}
+
+	return segments
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strings
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	eq := func(s string) DiffSegment { return DiffSegment{Type: DiffEqual, Text: s} }
+	add := func(s string) DiffSegment { return DiffSegment{Type: DiffAdded, Text: s} }
+	rem := func(s string) DiffSegment { return DiffSegment{Type: DiffRemoved, Text: s} }
+
+	for _, test := range []struct {
+		old     any
+		new     any
+		options []any
+		expect  any
+	}{
+		{"", "", nil, []DiffSegment(nil)},
+		{"Hugo rocks", "Hugo rocks", nil, []DiffSegment{eq("Hugo rocks")}},
+		{"", "Hugo", nil, []DiffSegment{add("Hugo")}},
+		{"Hugo", "", nil, []DiffSegment{rem("Hugo")}},
+		{"Hugo is fast", "Hugo is very fast", nil, []DiffSegment{eq("Hugo is "), add("very "), eq("fast")}},
+		{"Hugo is slow.", "Hugo is fast!", nil, []DiffSegment{eq("Hugo is "), rem("slow."), add("fast!")}},
+		{"The quick brown fox", "The brown fox jumps", nil, []DiffSegment{eq("The "), rem("quick "), eq("brown fox"), add(" jumps")}},
+		{42, 43, nil, []DiffSegment{rem("42"), add("43")}},
+		{
+			"a\nb\nc\n", "a\nB\nc\n", []any{map[string]any{"granularity": "line"}},
+			[]DiffSegment{eq("a\n"), rem("b\n"), add("B\n"), eq("c\n")},
+		},
+		{
+			"a b\nc\n", "a c\nc\n", []any{map[string]any{"Granularity": "Line"}},
+			[]DiffSegment{rem("a b\n"), add("a c\n"), eq("c\n")},
+		},
+		// Errors.
+		{"a", "b", []any{map[string]any{"granularity": "char"}}, false},
+		{"a", "b", []any{"line"}, false},
+		{"a", "b", []any{map[string]any{}, map[string]any{}}, false},
+		{t, "b", nil, false},
+	} {
+		errMsg := qt.Commentf("%q => %q", test.old, test.new)
+
+		result, err := ns.Diff(test.old, test.new, test.options...)
+
+		if b, ok := test.expect.(bool); ok && !b {
+			c.Assert(err, qt.Not(qt.IsNil), errMsg)
+			continue
+		}
+		c.Assert(err, qt.IsNil, errMsg)
+		c.Assert(result, qt.DeepEquals, test.expect, errMsg)
+	}
+}
+
+func TestDiffTokensRandom(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	r := rand.New(rand.NewSource(42))
+	words := []string{"a", "b", "c", "d"}
+	randomTokens := func() []string {
+		tokens := make([]string, r.Intn(20))
+		for i := range tokens {
+			tokens[i] = words[r.Intn(len(words))]
+		}
+		return tokens
+	}
+
+	for i := 0; i < 500; i++ {
+		a, b := randomTokens(), randomTokens()
+		segments := diffTokens(a, b)
+
+		var olds, news strings.Builder
+		var edits int
+		for j, s := range segments {
+			if j > 0 {
+				c.Assert(s.Type, qt.Not(qt.Equals), segments[j-1].Type)
+			}
+			switch s.Type {
+			case DiffEqual:
+				olds.WriteString(s.Text)
+				news.WriteString(s.Text)
+			case DiffRemoved:
+				olds.WriteString(s.Text)
+				edits += len(s.Text)
+			case DiffAdded:
+				news.WriteString(s.Text)
+				edits += len(s.Text)
+			}
+		}
+		c.Assert(olds.String(), qt.Equals, strings.Join(a, ""))
+		c.Assert(news.String(), qt.Equals, strings.Join(b, ""))
+		// The edit script is the shortest, len(a)+len(b)-2*LCS.
+		c.Assert(edits, qt.Equals, len(a)+len(b)-2*lcsLen(a, b))
+	}
+}
+
+func lcsLen(a, b []string) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			switch {
+			case a[i-1] == b[j-1]:
+				dp[i][j] = dp[i-1][j-1] + 1
+			case dp[i-1][j] > dp[i][j-1]:
+				dp[i][j] = dp[i-1][j]
+			default:
+				dp[i][j] = dp[i][j-1]
+			}
+		}
+	}
+	return dp[len(a)][len(b)]
+}
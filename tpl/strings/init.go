@@ -228,6 +228,13 @@ func init() {
 			},
 		)
 
+		ns.AddMethodMapping(ctx.Diff,
+			nil,
+			[][2]string{
+				{`{{ range strings.Diff "Hugo is fast" "Hugo is very fast" }}{{ if eq .Type "added" }}<ins>{{ .Text }}</ins>{{ else }}{{ .Text }}{{ end }}{{ end }}`, `Hugo is <ins>very </ins>fast`},
+			},
+		)
+
 		ns.AddMethodMapping(ctx.Repeat,
 			nil,
 			[][2]string{
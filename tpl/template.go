@@ -28,6 +28,7 @@ import (
 	"github.com/gohugoio/hugo/output/layouts"
 
 	"github.com/gohugoio/hugo/output"
+	"golang.org/x/net/html"
 
 	htmltemplate "github.com/gohugoio/hugo/tpl/internal/go_templates/htmltemplate"
 	texttemplate "github.com/gohugoio/hugo/tpl/internal/go_templates/texttemplate"
@@ -222,3 +223,106 @@ func StripHTML(s string) string {
 
 	return s
 }
+
+// plainTextBlockElements are the elements that end a paragraph in
+// HTMLToPlainText.
+var plainTextBlockElements = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true,
+	"dd": true, "details": true, "div": true, "dl": true, "dt": true,
+	"figcaption": true, "figure": true, "footer": true, "form": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"header": true, "hr": true, "li": true, "main": true, "nav": true,
+	"ol": true, "p": true, "pre": true, "section": true, "table": true,
+	"tr": true, "ul": true,
+}
+
+// HTMLToPlainText converts the HTML in s to plain text.
+// Unlike StripHTML, it keeps the line breaks: A <br> becomes a newline and
+// block elements, e.g. paragraphs and list items, are separated by a blank
+// line. Other whitespace is collapsed into a single space, except inside
+// <pre>. HTML entities are decoded and script and style elements removed.
+func HTMLToPlainText(s string) string {
+	var (
+		b        strings.Builder
+		newlines int  // Pending newlines.
+		space    bool // Pending space.
+		pre      int
+		skip     int
+	)
+
+	write := func(text string) {
+		if b.Len() > 0 {
+			if newlines > 0 {
+				// Account for newlines written by <br>.
+				str := b.String()
+				for i := len(str) - 1; i >= 0 && str[i] == '\n' && newlines > 0; i-- {
+					newlines--
+				}
+				b.WriteString(strings.Repeat("\n", newlines))
+			} else if space && !strings.HasSuffix(b.String(), "\n") {
+				b.WriteByte(' ')
+			}
+		}
+		newlines = 0
+		space = false
+		b.WriteString(text)
+	}
+
+	z := html.NewTokenizer(strings.NewReader(s))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			return strings.TrimRight(b.String(), "\n")
+		case html.TextToken:
+			if skip > 0 {
+				continue
+			}
+			text := string(z.Text())
+			if pre > 0 {
+				write(text)
+				continue
+			}
+			fields := strings.FieldsFunc(text, unicode.IsSpace)
+			if len(fields) == 0 {
+				space = space || text != ""
+				continue
+			}
+			if unicode.IsSpace(rune(text[0])) {
+				space = true
+			}
+			write(strings.Join(fields, " "))
+			space = unicode.IsSpace(rune(text[len(text)-1]))
+		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			switch tag {
+			case "script", "style":
+				if tt == html.StartTagToken {
+					skip++
+				} else if tt == html.EndTagToken && skip > 0 {
+					skip--
+				}
+				continue
+			case "pre":
+				if tt == html.StartTagToken {
+					pre++
+				} else if tt == html.EndTagToken && pre > 0 {
+					pre--
+				}
+			case "br":
+				if b.Len() > 0 {
+					space = false
+					write("\n")
+				}
+				continue
+			}
+			if plainTextBlockElements[tag] {
+				if newlines < 2 {
+					newlines = 2
+				}
+				space = false
+			}
+		}
+	}
+}
@@ -29,6 +29,28 @@ func TestExtractBaseof(t *testing.T) {
 	c.Assert(extractBaseOf("template: blog/baseof.html:23:11:"), qt.Equals, "blog/baseof.html")
 }
 
+func TestHTMLToPlainText(t *testing.T) {
+	c := qt.New(t)
+
+	for _, test := range []struct {
+		input, expected string
+	}{
+		{"No Tags", "No Tags"},
+		{"<p>First paragraph.</p>\n\n<p>Second\nparagraph.</p>\n", "First paragraph.\n\nSecond paragraph."},
+		{"<p>Line 1<br>Line 2<br />\nLine 3</p>", "Line 1\nLine 2\nLine 3"},
+		{"<p>A <br> B</p>", "A\nB"},
+		{"<ul>\n<li>One</li>\n<li><em>Two</em> items</li>\n</ul>\n<p>After.</p>", "One\n\nTwo items\n\nAfter."},
+		{"<h2 id=\"a\">Heading</h2>\n<p>Text</p>", "Heading\n\nText"},
+		{"<pre><code>a  b\n  c\n</code></pre>\n<p>d</p>", "a  b\n  c\n\nd"},
+		{"foo&amp;bar &lt;3", "foo&bar <3"},
+		{"a<script>var x = 1;</script> b<style>p {}</style>", "a b"},
+		{"Hello <a href=\"/\">World</a>!", "Hello World!"},
+		{"", ""},
+	} {
+		c.Assert(HTMLToPlainText(test.input), qt.Equals, test.expected, qt.Commentf("%q", test.input))
+	}
+}
+
 func TestStripHTML(t *testing.T) {
 	type test struct {
 		input, expected string
@@ -39,6 +39,11 @@ func init() {
 			},
 		)
 
+		ns.AddMethodMapping(ctx.Try,
+			[]string{"try"},
+			[][2]string{},
+		)
+
 		return ns
 	}
 
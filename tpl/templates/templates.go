@@ -36,3 +36,26 @@ type Namespace struct {
 func (ns *Namespace) Exists(name string) bool {
 	return ns.deps.Tmpl().HasTemplate(name)
 }
+
+// Try executes the given template expression and returns its value and any
+// error in a TryValue instead of failing the build, e.g.
+//
+//	{{ with try (partial "maybe.html" .) }}{{ with .Err }}...{{ else }}{{ .Value }}{{ end }}{{ end }}
+//
+// Only template errors are captured; panics caused by bugs are not.
+// Note that the error is captured only when this is invoked as try.
+func (ns *Namespace) Try(v any, err ...error) *TryValue {
+	tv := &TryValue{Value: v}
+	if len(err) > 0 {
+		tv.Err = err[0]
+	}
+	return tv
+}
+
+// TryValue is the value returned by try.
+type TryValue struct {
+	// Value is the value returned by the expression, nil on error.
+	Value any
+	// Err is the error returned by the expression, if any.
+	Err error
+}
@@ -14,6 +14,7 @@
 package templates_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/gohugoio/hugo/hugolib"
@@ -93,3 +94,100 @@ Home: true
 
 `)
 }
+
+func TestTry(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "page"]
+[params]
+list = ["a", "b"]
+-- layouts/partials/ok.html --
+OK: {{ . }}
+-- layouts/index.html --
+{{ with try (index site.Params.list 1) }}Value: {{ .Value }}|Err: {{ .Err }}{{ end }}
+{{ with try (div 1 0) }}{{ with .Err }}Err: {{ . }}{{ else }}No error{{ end }}{{ end }}
+{{ with try (partial "missing.html" .) }}{{ with .Err }}Partial failed{{ end }}{{ end }}
+{{ with try (partial "ok.html" "p") }}{{ .Value }}{{ end }}
+{{ $v := "x" | try }}Pipe: {{ $v.Value }}
+{{ range $i, $e := slice 1 2 }}{{ with try (div $e $i) }}Range {{ $i }}: {{ if .Err }}failed{{ end }}{{ end }}{{ end }}
+After.
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		"Value: b|Err: \n",
+		"Err: template: index.html:2:13: executing &#34;index.html&#34; at &lt;div 1 0&gt;: error calling div: can&#39;t divide the value by 0",
+		"Partial failed",
+		"OK: p",
+		"Pipe: x",
+		"Range 0: failed",
+		"Range 1: \n",
+		"After.",
+	)
+}
+
+func TestTryWrongNumberOfArgs(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- layouts/index.html --
+{{ try 1 2 }}
+`
+
+	_, err := hugolib.TestE(t, files)
+	if err == nil || !strings.Contains(err.Error(), "wrong number of args for try: want 1 got 2") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestLazyCondAndCoalesce(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "page"]
+-- layouts/partials/counter.html --
+{{ $c := .Store.Get "counter" | default 0 }}{{ .Store.Set "counter" (add $c 1) }}{{ return printf "counted %d" (add $c 1) }}
+-- layouts/index.html --
+Cond true: {{ cond true "yes" (errorf "cond: untaken branch evaluated") }}|
+Cond false: {{ cond false (errorf "cond: untaken branch evaluated") "no" }}|
+Cond pipe: {{ "piped" | cond false "a" }}|
+Cond partial: {{ cond (eq 1 2) (partial "counter.html" .) "skipped" }}|
+Coalesce: {{ coalesce "" 0 "first" (errorf "coalesce: evaluated after first non-empty") }}|
+Coalesce partial: {{ coalesce (partial "counter.html" .) (partial "counter.html" .) }}|
+Coalesce pipe: {{ "piped" | coalesce "" }}|
+Coalesce empty: {{ with coalesce "" 0 false }}{{ . }}{{ else }}none{{ end }}|
+Counter: {{ .Store.Get "counter" }}|
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		"Cond true: yes|",
+		"Cond false: no|",
+		"Cond pipe: piped|",
+		"Cond partial: skipped|",
+		"Coalesce: first|",
+		"Coalesce partial: counted 1|",
+		"Coalesce pipe: piped|",
+		"Coalesce empty: none|",
+		"Counter: 1|",
+	)
+}
+
+func TestCondWrongNumberOfArgs(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- layouts/index.html --
+{{ cond true 1 }}
+`
+
+	_, err := hugolib.TestE(t, files)
+	if err == nil || !strings.Contains(err.Error(), "wrong number of args for cond: want 3 got 2") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -63,6 +63,13 @@ func init() {
 			},
 		)
 
+		ns.AddMethodMapping(ctx.FormatNamed,
+			nil,
+			[][2]string{
+				{`{{ time.FormatNamed "medium" "2015-01-21" }}`, `Jan 21, 2015`},
+			},
+		)
+
 		ns.AddMethodMapping(ctx.Now,
 			[]string{"now"},
 			[][2]string{},
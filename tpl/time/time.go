@@ -66,6 +66,19 @@ func (ns *Namespace) Format(layout string, v any) (string, error) {
 	return ns.timeFormatter.Format(t, layout), nil
 }
 
+// FormatNamed converts the textual representation of the datetime string in v into
+// time.Time if needed and formats it with the named layout for the current language,
+// one of short, medium, long or full. Prefix the name with time_ to format the time
+// of day instead of the date, e.g. time_short.
+func (ns *Namespace) FormatNamed(name string, v any) (string, error) {
+	t, err := htime.ToTimeInDefaultLocationE(v, ns.location)
+	if err != nil {
+		return "", err
+	}
+
+	return ns.timeFormatter.FormatNamed(t, name)
+}
+
 // Now returns the current local time or `clock` time
 func (ns *Namespace) Now() time.Time {
 	return htime.Now()
@@ -0,0 +1,48 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package time_test
+
+import (
+	"testing"
+
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestUnixTimestampsFromJSON(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "page"]
+timeZone = "Europe/Oslo"
+-- assets/events.json --
+[
+  {"name": "seconds", "created": 1700000000},
+  {"name": "milliseconds", "created": 1700000000123},
+  {"name": "iso", "created": "2023-11-14T23:13"}
+]
+-- layouts/index.html --
+{{ range resources.Get "events.json" | transform.Unmarshal }}
+{{ .name }}: {{ time.AsTime .created | time.Format "2006-01-02 15:04:05.000" }}|{{ dateFormat "Jan 2, 2006" .created }}|
+{{ end }}
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		"seconds: 2023-11-14 23:13:20.000|Nov 14, 2023|",
+		"milliseconds: 2023-11-14 23:13:20.123|Nov 14, 2023|",
+		"iso: 2023-11-14 23:13:00.000|Nov 14, 2023|",
+	)
+}
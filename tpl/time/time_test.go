@@ -97,9 +97,13 @@ func TestFormat(t *testing.T) {
 			{"Monday, Jan 2, 2006", "2015-01-21", "Wednesday, Jan 21, 2015"},
 			{"Monday, Jan 2, 2006", time.Date(2015, time.January, 21, 0, 0, 0, 0, time.UTC), "Wednesday, Jan 21, 2015"},
 			{"This isn't a date layout string", "2015-01-21", "This isn't a date layout string"},
-			// The following test case gives either "Tuesday, Jan 20, 2015" or "Monday, Jan 19, 2015" depending on the local time zone
-			{"Monday, Jan 2, 2006", 1421733600, time.Unix(1421733600, 0).Format("Monday, Jan 2, 2006")},
-			{"Monday, Jan 2, 2006", 1421733600.123, false},
+			// Unix timestamps are formatted in the configured time zone.
+			{"Monday, Jan 2, 2006", 1421733600, "Tuesday, Jan 20, 2015"},
+			{time.RFC3339Nano, 1421733600.125, "2015-01-20T06:00:00.125Z"},
+			{time.RFC3339Nano, int64(1421733600125), "2015-01-20T06:00:00.125Z"},
+			{time.RFC3339, "2015-01-20T06:00", "2015-01-20T06:00:00Z"},
+			{time.RFC3339, "20150120T060000+0100", "2015-01-20T06:00:00+01:00"},
+			{"Monday, Jan 2, 2006", "not a date", false},
 			{time.RFC3339, time.Date(2016, time.March, 3, 4, 5, 0, 0, time.UTC), "2016-03-03T04:05:00Z"},
 			{time.RFC1123, time.Date(2016, time.March, 3, 4, 5, 0, 0, time.UTC), "Thu, 03 Mar 2016 04:05:00 UTC"},
 			{time.RFC3339, "Thu, 03 Mar 2016 04:05:00 UTC", "2016-03-03T04:05:00Z"},
@@ -181,3 +185,44 @@ func TestDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestFormatNamed(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	nsEn := New(htime.NewTimeFormatter(translators.GetTranslator("en")), time.UTC)
+	nsDe := New(htime.NewTimeFormatter(translators.GetTranslator("de")), time.UTC)
+
+	for _, test := range []struct {
+		ns     *Namespace
+		name   string
+		value  any
+		expect any
+	}{
+		{nsEn, "short", "2018-06-06", "6/6/18"},
+		{nsDe, "short", "2018-06-06", "06.06.18"},
+		{nsEn, "medium", "2018-06-06", "Jun 6, 2018"},
+		{nsDe, "medium", "2018-06-06", "06.06.2018"},
+		{nsEn, "long", "2018-06-06", "June 6, 2018"},
+		{nsDe, "long", "2018-06-06", "6. Juni 2018"},
+		{nsEn, "Full", "2018-06-06", "Wednesday, June 6, 2018"},
+		{nsDe, "full", "2018-06-06", "Mittwoch, 6. Juni 2018"},
+		{nsEn, "date_medium", "2018-06-06", "Jun 6, 2018"},
+		{nsEn, "time_short", "2018-06-06T14:09:37Z", "2:09 pm"},
+		{nsDe, "time_short", "2018-06-06T14:09:37Z", "14:09"},
+
+		// Failures.
+		{nsEn, "foo", "2018-06-06", false},
+		{nsEn, "time_foo", "2018-06-06", false},
+		{nsEn, "short", "invalid-value", false},
+	} {
+		msg := qt.Commentf("%s %v", test.name, test.value)
+		result, err := test.ns.FormatNamed(test.name, test.value)
+		if b, ok := test.expect.(bool); ok && !b {
+			c.Assert(err, qt.Not(qt.IsNil), msg)
+			continue
+		}
+		c.Assert(err, qt.IsNil, msg)
+		c.Assert(result, qt.Equals, test.expect, msg)
+	}
+}
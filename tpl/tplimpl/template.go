@@ -30,6 +30,8 @@ import (
 	"unicode"
 	"unicode/utf8"
 
+	"github.com/gohugoio/hugo/cache/filecache"
+	"github.com/gohugoio/hugo/common/hugo"
 	"github.com/gohugoio/hugo/common/types"
 	"github.com/gohugoio/hugo/output/layouts"
 
@@ -45,6 +47,7 @@ import (
 
 	htmltemplate "github.com/gohugoio/hugo/tpl/internal/go_templates/htmltemplate"
 	texttemplate "github.com/gohugoio/hugo/tpl/internal/go_templates/texttemplate"
+	"github.com/gohugoio/hugo/tpl/internal/go_templates/texttemplate/parse"
 
 	"github.com/gohugoio/hugo/identity"
 	"github.com/gohugoio/hugo/tpl"
@@ -149,7 +152,7 @@ func newTemplateHandlers(d *deps.Deps) (*tpl.TemplateHandlers, error) {
 		baseof:       make(map[string]templateInfo),
 		needsBaseof:  make(map[string]templateInfo),
 
-		main: newTemplateNamespace(funcMap),
+		main: newTemplateNamespace(funcMap, templatesCache(d)),
 
 		Deps:                d,
 		layoutHandler:       layouts.NewLayoutHandler(),
@@ -184,8 +187,16 @@ func newTemplateHandlers(d *deps.Deps) (*tpl.TemplateHandlers, error) {
 	}, nil
 }
 
-func newTemplateNamespace(funcs map[string]any) *templateNamespace {
+func templatesCache(d *deps.Deps) *filecache.Cache {
+	if d.ResourceSpec == nil {
+		return nil
+	}
+	return d.ResourceSpec.FileCaches.TemplatesCache()
+}
+
+func newTemplateNamespace(funcs map[string]any, cache *filecache.Cache) *templateNamespace {
 	return &templateNamespace{
+		cache:         cache,
 		prototypeHTML: htmltemplate.New("").Funcs(funcs),
 		prototypeText: texttemplate.New("").Funcs(funcs),
 		templateStateMap: &templateStateMap{
@@ -260,7 +271,7 @@ func (t *templateExec) ExecuteWithContext(ctx context.Context, templ tpl.Templat
 
 	execErr := t.executor.ExecuteWithContext(ctx, templ, wr, data)
 	if execErr != nil {
-		execErr = t.addFileContext(templ, execErr)
+		execErr = t.addFileContext(ctx, templ, execErr)
 	}
 	return execErr
 }
@@ -534,7 +545,7 @@ func (t *templateHandler) newTemplateInfo(name, tpl string) templateInfo {
 	}
 }
 
-func (t *templateHandler) addFileContext(templ tpl.Template, inerr error) error {
+func (t *templateHandler) addFileContext(ctx context.Context, templ tpl.Template, inerr error) error {
 	if strings.HasPrefix(templ.Name(), "_internal") {
 		return inerr
 	}
@@ -581,7 +592,13 @@ func (t *templateHandler) addFileContext(templ tpl.Template, inerr error) error
 		return fe, true
 	}
 
-	inerr = fmt.Errorf("execute of template failed: %w", inerr)
+	// Report where the template was called from, e.g. the template that
+	// invoked this partial, but only where the error originated.
+	if stack := texttemplate.CallStack(ctx); len(stack) > 0 && herrors.UnwrapFileError(inerr) == nil {
+		inerr = fmt.Errorf("execute of template failed (called from %s): %w", strings.Join(stack, " <- "), inerr)
+	} else {
+		inerr = fmt.Errorf("execute of template failed: %w", inerr)
+	}
 
 	if err, ok := checkFilename(ts.info, inerr); ok {
 		return err
@@ -951,6 +968,9 @@ type templateNamespace struct {
 	prototypeTextClone *texttemplate.Template
 	prototypeHTMLClone *htmltemplate.Template
 
+	// Cache for the parse trees, may be nil.
+	cache *filecache.Cache
+
 	*templateStateMap
 }
 
@@ -1009,10 +1029,14 @@ func (t *templateNamespace) parse(info templateInfo) (*templateState, error) {
 	if info.isText {
 		prototype := t.prototypeText
 
-		templ, err := prototype.New(info.name).Parse(info.template)
+		templ := prototype.New(info.name)
+		trees, err := t.parseTrees(info, templ.ParseTrees)
 		if err != nil {
 			return nil, err
 		}
+		if _, err := templ.AddParseTrees(trees); err != nil {
+			return nil, err
+		}
 
 		ts := newTemplateState(templ, info, nil)
 
@@ -1023,10 +1047,14 @@ func (t *templateNamespace) parse(info templateInfo) (*templateState, error) {
 
 	prototype := t.prototypeHTML
 
-	templ, err := prototype.New(info.name).Parse(info.template)
+	templ := prototype.New(info.name)
+	trees, err := t.parseTrees(info, templ.ParseTrees)
 	if err != nil {
 		return nil, err
 	}
+	if _, err := templ.AddParseTrees(trees); err != nil {
+		return nil, err
+	}
 
 	ts := newTemplateState(templ, info, nil)
 
@@ -1035,6 +1063,49 @@ func (t *templateNamespace) parse(info templateInfo) (*templateState, error) {
 	return ts, nil
 }
 
+// parseTrees parses the template in info using parse, or, if found, decodes
+// the parse trees from the file cache, which is much faster.
+// Any cache error is ignored and the template parsed as usual.
+func (t *templateNamespace) parseTrees(info templateInfo, parseText func(text string) (map[string]*parse.Tree, error)) (map[string]*parse.Tree, error) {
+	if t.cache == nil {
+		return parseText(info.template)
+	}
+
+	kind := "html"
+	if info.isText {
+		kind = "text"
+	}
+	// The parse trees depend on the Hugo version (the template funcs
+	// available), the template name and its content.
+	key := kind + "_" + helpers.MD5String(hugo.CurrentVersion.String()+"/"+info.name+"/"+info.template)
+
+	var (
+		trees    map[string]*parse.Tree
+		parseErr error
+	)
+	_, b, err := t.cache.GetOrCreateBytes(key, func() ([]byte, error) {
+		trees, parseErr = parseText(info.template)
+		if parseErr != nil {
+			return nil, parseErr
+		}
+		return parse.EncodeTrees(trees)
+	})
+
+	switch {
+	case parseErr != nil:
+		return nil, parseErr
+	case trees != nil:
+		// Cache miss.
+		return trees, nil
+	case err == nil:
+		if trees, err = parse.DecodeTrees(b, info.template); err == nil {
+			return trees, nil
+		}
+	}
+
+	return parseText(info.template)
+}
+
 var _ tpl.IsInternalTemplateProvider = (*templateState)(nil)
 
 type templateState struct {
@@ -252,8 +252,9 @@ func (c *templateContext) collectConfig(n *parse.PipeNode) {
 
 // collectInner determines if the given CommandNode represents a
 // shortcode call to its .Inner.
+// Partials are also checked, as a shortcode may delegate to a partial.
 func (c *templateContext) collectInner(n *parse.CommandNode) {
-	if c.t.typ != templateShortcode {
+	if c.t.typ != templateShortcode && c.t.typ != templatePartial {
 		return
 	}
 	if c.t.parseInfo.IsInner || len(n.Args) == 0 {
@@ -269,7 +270,7 @@ func (c *templateContext) collectInner(n *parse.CommandNode) {
 			idents = nt.Ident
 		}
 
-		if c.hasIdent(idents, "Inner") || c.hasIdent(idents, "InnerDeindent") {
+		if c.hasIdent(idents, "Inner") || c.hasIdent(idents, "InnerDeindent") || c.hasIdent(idents, "InnerRaw") {
 			c.t.parseInfo.IsInner = true
 			break
 		}
@@ -1,6 +1,7 @@
 package tplimpl_test
 
 import (
+	"io/fs"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -8,6 +9,7 @@ import (
 	qt "github.com/frankban/quicktest"
 	"github.com/gohugoio/hugo/hugolib"
 	"github.com/gohugoio/hugo/tpl"
+	"github.com/spf13/afero"
 )
 
 func TestPrintUnusedTemplates(t *testing.T) {
@@ -210,3 +212,42 @@ var a = §§{{.Title }}§§;
 	// This used to fail, but not in >= Hugo 0.121.0.
 	b.Assert(err, qt.IsNil)
 }
+
+func TestTemplatesFileCache(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com"
+disableKinds = ["taxonomy", "term", "page", "rss", "sitemap"]
+-- layouts/index.html --
+{{ define "foo" }}Foo: {{ . }}{{ end }}
+{{ $s := slice 1 2 3 }}
+{{ range $i, $v := $s }}{{ if eq $v 2 }}{{ continue }}{{ end }}{{ $i }}:{{ $v }}|{{ end }}
+{{ with .Title }}Title: {{ . | upper }}{{ else }}No title{{ end }}
+{{ template "foo" 42 }}
+{{/* A comment. */}}
+`
+
+	b := hugolib.TestRunning(t, files)
+
+	want := []string{"0:1|2:3|", "No title", "Foo: 42"}
+	b.AssertFileContent("public/index.html", want...)
+
+	cache := b.H.ResourceSpec.FileCaches.TemplatesCache()
+	var count int
+	b.Assert(afero.Walk(cache.Fs, "", func(path string, info fs.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			count++
+		}
+		return err
+	}), qt.IsNil)
+	b.Assert(count > 0, qt.IsTrue)
+
+	b.EditFileReplaceAll("layouts/index.html", "Foo: ", "Bar: ").Build()
+	b.AssertFileContent("public/index.html", "Bar: 42")
+
+	// The original template is now decoded from the cache.
+	b.EditFileReplaceAll("layouts/index.html", "Bar: ", "Foo: ").Build()
+	b.AssertFileContent("public/index.html", want...)
+}
@@ -83,6 +83,13 @@ func init() {
 			},
 		)
 
+		ns.AddMethodMapping(ctx.JSONScript,
+			nil,
+			[][2]string{
+				{`<script>{{ dict "a" "</script>" | transform.JSONScript }}</script>`, `<script>{"a":"\u003c/script\u003e"}</script>`},
+			},
+		)
+
 		ns.AddMethodMapping(ctx.Markdownify,
 			[]string{"markdownify"},
 			[][2]string{
@@ -90,6 +97,14 @@ func init() {
 			},
 		)
 
+		ns.AddMethodMapping(ctx.Minify,
+			nil,
+			[][2]string{
+				{`{{ "a {  color: red;  }" | transform.Minify "css" }}`, `a{color:red}`},
+				{`{{ "<p>  Hello   world </p>" | safeHTML | transform.Minify "html" }}`, `<p>Hello world</p>`},
+			},
+		)
+
 		ns.AddMethodMapping(ctx.Plainify,
 			[]string{"plainify"},
 			[][2]string{
@@ -0,0 +1,152 @@
+// Copyright 2024 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/types"
+	"github.com/gohugoio/hugo/media"
+	"github.com/gohugoio/hugo/minifiers"
+	"github.com/gohugoio/hugo/resources/resource"
+)
+
+// Minify minifies the given content, a string or a Resource, using the
+// minifier configured for the given media type, e.g. "css", "js", "html"
+// or "text/css". The media type may be omitted for resources.
+// If the content is a Resource or already marked as safe, e.g. template.CSS,
+// the result is returned as template.HTML, template.CSS or template.JS for
+// HTML, CSS and JavaScript so it can be inlined in a template. Other content
+// is returned as a string to be escaped by the template engine.
+func (ns *Namespace) Minify(args ...any) (any, error) {
+	var (
+		mediaType any
+		content   any
+	)
+
+	switch len(args) {
+	case 1:
+		content = args[0]
+	case 2:
+		mediaType, content = args[0], args[1]
+	default:
+		return nil, errors.New("minify takes 1 or 2 arguments")
+	}
+
+	var (
+		mt   media.Type
+		r    io.Reader
+		safe bool
+		err  error
+	)
+
+	if mediaType != nil {
+		mt, err = ns.lookupMediaType(mediaType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch v := content.(type) {
+	case resource.ReadSeekCloserResource:
+		if mediaType == nil {
+			mt = v.MediaType()
+		}
+		rc, err := v.ReadSeekCloser()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		r = rc
+		safe = true
+	default:
+		if mediaType == nil {
+			return nil, errors.New("minify: a media type is required when the content is not a resource")
+		}
+		s, err := types.ToStringE(content)
+		if err != nil {
+			return nil, fmt.Errorf("minify: type %T not supported", content)
+		}
+		r = strings.NewReader(s)
+		switch content.(type) {
+		case template.HTML, template.CSS, template.JS:
+			safe = true
+		}
+	}
+
+	ns.minifierInit.Do(func() {
+		rs := ns.deps.ResourceSpec
+		ns.minifier, ns.minifierErr = minifiers.New(rs.MediaTypes(), rs.OutputFormats(), ns.deps.Conf)
+	})
+	if ns.minifierErr != nil {
+		return nil, ns.minifierErr
+	}
+
+	var b strings.Builder
+	if err := ns.minifier.Minify(mt, &b, r); err != nil {
+		return nil, fmt.Errorf("minify: failed to minify %q: %w", mt.Type, err)
+	}
+	s := b.String()
+
+	if !safe {
+		return s, nil
+	}
+
+	switch {
+	case mt.Type == media.Builtin.CSSType.Type:
+		return template.CSS(s), nil
+	case mt.Type == media.Builtin.JavascriptType.Type:
+		return template.JS(s), nil
+	case mt.Type == media.Builtin.SVGType.Type || ns.isHTML(mt):
+		return template.HTML(s), nil
+	default:
+		return s, nil
+	}
+}
+
+// lookupMediaType looks up the media type given either a full type,
+// e.g. text/css, or a suffix, e.g. css.
+func (ns *Namespace) lookupMediaType(v any) (media.Type, error) {
+	s, err := types.ToStringE(v)
+	if err != nil {
+		return media.Type{}, fmt.Errorf("minify: invalid media type: %w", err)
+	}
+	mediaTypes := ns.deps.ResourceSpec.MediaTypes()
+	if strings.Contains(s, "/") {
+		if mt, found := mediaTypes.GetByType(s); found {
+			return mt, nil
+		}
+		return media.Type{Type: s}, nil
+	}
+	if mt, _, found := mediaTypes.GetFirstBySuffix(strings.TrimPrefix(s, ".")); found {
+		return mt, nil
+	}
+	return media.Type{}, fmt.Errorf("minify: unknown media type %q", s)
+}
+
+func (ns *Namespace) isHTML(mt media.Type) bool {
+	if mt.Type == media.Builtin.HTMLType.Type {
+		return true
+	}
+	for _, f := range ns.deps.ResourceSpec.OutputFormats() {
+		if f.IsHTML && f.MediaType.Type == mt.Type {
+			return true
+		}
+	}
+	return false
+}
@@ -3,8 +3,10 @@ package transform
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"strings"
 
+	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/parser"
 	"github.com/gohugoio/hugo/parser/metadecoders"
 	"github.com/spf13/cast"
@@ -16,8 +18,27 @@ import (
 // It is not a general purpose YAML to TOML converter etc., and may
 // change without notice if it serves a purpose in the docs.
 // Format is one of json, yaml or toml.
-func (ns *Namespace) Remarshal(format string, data any) (string, error) {
-	var meta map[string]any
+// An optional options map may be provided before the data, with the keys
+// indent (a string or a number of spaces) and ordered (preserve the key
+// order of JSON, YAML and TOML input; the keys are sorted otherwise).
+func (ns *Namespace) Remarshal(format string, args ...any) (string, error) {
+	var (
+		data any
+		opts remarshalOptions
+		err  error
+	)
+
+	switch len(args) {
+	case 1:
+		data = args[0]
+	case 2:
+		if opts, err = decodeRemarshalOptions(args[0]); err != nil {
+			return "", err
+		}
+		data = args[1]
+	default:
+		return "", errors.New("remarshal requires a format, optional options and data")
+	}
 
 	format = strings.TrimSpace(strings.ToLower(format))
 
@@ -26,9 +47,17 @@ func (ns *Namespace) Remarshal(format string, data any) (string, error) {
 		return "", err
 	}
 
-	if m, ok := data.(map[string]any); ok {
+	var meta any
+
+	switch m := data.(type) {
+	case map[string]any:
+		// Make it so 1.0 float64 prints as 1 etc.
+		applyMarshalTypes(m)
+		meta = m
+	case metadecoders.OrderedMap:
+		// E.g. .RawFrontMatter. This may be shared, so leave it as is.
 		meta = m
-	} else {
+	default:
 		from, err := cast.ToStringE(data)
 		if err != nil {
 			return "", err
@@ -44,23 +73,73 @@ func (ns *Namespace) Remarshal(format string, data any) (string, error) {
 			return "", errors.New("failed to detect format from content")
 		}
 
-		meta, err = metadecoders.Default.UnmarshalToMap([]byte(from), fromFormat)
-		if err != nil {
-			return "", err
+		if opts.Ordered {
+			m, err := metadecoders.Default.UnmarshalToOrderedMap([]byte(from), fromFormat)
+			if err != nil {
+				return "", err
+			}
+			applyMarshalTypesOrdered(m)
+			meta = m
+		} else {
+			m, err := metadecoders.Default.UnmarshalToMap([]byte(from), fromFormat)
+			if err != nil {
+				return "", err
+			}
+			applyMarshalTypes(m)
+			meta = m
 		}
 	}
 
-	// Make it so 1.0 float64 prints as 1 etc.
-	applyMarshalTypes(meta)
-
 	var result bytes.Buffer
-	if err := parser.InterfaceToConfig(meta, mark, &result); err != nil {
+	if err := parser.InterfaceToConfigIndent(meta, mark, opts.Indent, &result); err != nil {
 		return "", err
 	}
 
 	return result.String(), nil
 }
 
+type remarshalOptions struct {
+	// The indentation to use for each level, e.g. "  " or "\t".
+	// An integer value is interpreted as a number of spaces.
+	Indent string
+
+	// Whether to preserve the key order of the input.
+	Ordered bool
+}
+
+func decodeRemarshalOptions(v any) (remarshalOptions, error) {
+	var opts remarshalOptions
+
+	m, err := maps.ToStringMapE(v)
+	if err != nil {
+		return opts, fmt.Errorf("invalid remarshal options: %w", err)
+	}
+
+	for k, v := range m {
+		switch strings.ToLower(k) {
+		case "indent":
+			switch vv := v.(type) {
+			case string:
+				opts.Indent = vv
+			default:
+				n, err := cast.ToIntE(vv)
+				if err != nil || n < 0 {
+					return opts, fmt.Errorf("invalid indent %v: must be a string or a non-negative number of spaces", v)
+				}
+				opts.Indent = strings.Repeat(" ", n)
+			}
+		case "ordered":
+			if opts.Ordered, err = cast.ToBoolE(v); err != nil {
+				return opts, fmt.Errorf("invalid value for ordered: %w", err)
+			}
+		default:
+			return opts, fmt.Errorf("unknown remarshal option %q", k)
+		}
+	}
+
+	return opts, nil
+}
+
 // The unmarshal/marshal dance is extremely type lossy, and we need
 // to make sure that integer types prints as "43" and not "43.0" in
 // all formats, hence this hack.
@@ -78,6 +157,26 @@ func applyMarshalTypes(m map[string]any) {
 	}
 }
 
+// applyMarshalTypesOrdered is the OrderedMap variant of applyMarshalTypes.
+func applyMarshalTypesOrdered(v any) any {
+	switch t := v.(type) {
+	case metadecoders.OrderedMap:
+		for i, item := range t {
+			t[i].Value = applyMarshalTypesOrdered(item.Value)
+		}
+	case []any:
+		for i, e := range t {
+			t[i] = applyMarshalTypesOrdered(e)
+		}
+	case float64:
+		i := int64(t)
+		if t == float64(i) {
+			return i
+		}
+	}
+	return v
+}
+
 func toFormatMark(format string) (metadecoders.Format, error) {
 	if f := metadecoders.FormatFromString(format); f != "" {
 		return f, nil
@@ -183,6 +183,100 @@ a = "b"
 		c.Assert(converted, qt.Equals, "[params]\n  [params.variables]\n    a = 'b'\n")
 	})
 
+	c.Run("Indent", func(c *qt.C) {
+		input := `{"params": {"variables": {"a": "b"}}}`
+
+		converted, err := ns.Remarshal("json", map[string]any{"indent": 2}, input)
+		c.Assert(err, qt.IsNil)
+		c.Assert(converted, qt.Equals, "{\n  \"params\": {\n    \"variables\": {\n      \"a\": \"b\"\n    }\n  }\n}\n")
+
+		converted, err = ns.Remarshal("toml", map[string]any{"indent": "\t"}, input)
+		c.Assert(err, qt.IsNil)
+		c.Assert(converted, qt.Equals, "[params]\n\t[params.variables]\n\t\ta = 'b'\n")
+
+		_, err = ns.Remarshal("toml", map[string]any{"indent": -1}, input)
+		c.Assert(err, qt.ErrorMatches, "invalid indent.*")
+	})
+
+	c.Run("Ordered", func(c *qt.C) {
+		yamlExample := `title: Test Metadata
+weight: 32
+params:
+  zeta: z
+  alpha: a
+resources:
+- src: '**image-4.png'
+  name: image-4
+`
+
+		jsonExample := `{
+   "title": "Test Metadata",
+   "weight": 32,
+   "params": {
+      "zeta": "z",
+      "alpha": "a"
+   },
+   "resources": [
+      {
+         "src": "**image-4.png",
+         "name": "image-4"
+      }
+   ]
+}
+`
+
+		tomlExample := `title = 'Test Metadata'
+weight = 32
+
+[params]
+  zeta = 'z'
+  alpha = 'a'
+
+[[resources]]
+  src = '**image-4.png'
+  name = 'image-4'
+`
+
+		ordered := map[string]any{"ordered": true}
+
+		variants := []struct {
+			format string
+			data   string
+		}{
+			{"yaml", yamlExample},
+			{"json", jsonExample},
+			{"toml", tomlExample},
+		}
+
+		for _, from := range variants {
+			for _, to := range variants {
+				fromTo := qt.Commentf("%s => %s", from.format, to.format)
+				converted, err := ns.Remarshal(to.format, ordered, from.data)
+				c.Assert(err, qt.IsNil, fromTo)
+				diff := htesting.DiffStrings(to.data, converted)
+				if len(diff) > 0 {
+					t.Errorf("[%s] Expected \n%v\ngot\n%v\ndiff:\n%v", fromTo, to.data, converted, diff)
+				}
+			}
+		}
+
+		// Stable, sorted ordering when not ordered.
+		converted, err := ns.Remarshal("yaml", jsonExample)
+		c.Assert(err, qt.IsNil)
+		c.Assert(converted, qt.Equals, `params:
+  alpha: a
+  zeta: z
+resources:
+- name: image-4
+  src: '**image-4.png'
+title: Test Metadata
+weight: 32
+`)
+
+		_, err = ns.Remarshal("yaml", map[string]any{"foo": true}, jsonExample)
+		c.Assert(err, qt.ErrorMatches, `unknown remarshal option "foo"`)
+	})
+
 	c.Run("Map input", func(c *qt.C) {
 		input := map[string]any{
 			"hello": "world",
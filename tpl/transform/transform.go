@@ -17,20 +17,26 @@ package transform
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"encoding/xml"
+	"fmt"
 	"html"
 	"html/template"
 	"strings"
+	"sync"
 
 	"github.com/gohugoio/hugo/cache/dynacache"
+	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/markup/converter/hooks"
 	"github.com/gohugoio/hugo/markup/highlight"
 	"github.com/gohugoio/hugo/markup/highlight/chromalexers"
+	"github.com/gohugoio/hugo/minifiers"
 	"github.com/gohugoio/hugo/resources"
 	"github.com/gohugoio/hugo/tpl"
 
 	"github.com/gohugoio/hugo/deps"
 	"github.com/gohugoio/hugo/helpers"
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cast"
 )
 
@@ -54,6 +60,10 @@ func New(deps *deps.Deps) *Namespace {
 type Namespace struct {
 	cache *dynacache.Partition[string, *resources.StaleValue[any]]
 	deps  *deps.Deps
+
+	minifierInit sync.Once
+	minifier     minifiers.Client
+	minifierErr  error
 }
 
 // Emojify returns a copy of s with all emoji codes replaced with actual emojis.
@@ -155,6 +165,61 @@ func (ns *Namespace) XMLEscape(s any) (string, error) {
 	return buf.String(), nil
 }
 
+// JSONScript encodes v to JSON that can be safely embedded in a script
+// element, e.g. <script type="application/json">, or used as a JavaScript
+// expression. To pretty print the JSON, pass a map of options as the first
+// argument. Supported options are "prefix" and "indent", see jsonify.
+//
+// The characters <, >, & and the line and paragraph separators U+2028 and
+// U+2029 are escaped, so the JSON cannot close the script element or break
+// out of a JavaScript string. Backticks and ${ are escaped as well, so the
+// JSON can also be embedded in a JavaScript template literal.
+func (ns *Namespace) JSONScript(args ...any) (template.JS, error) {
+	var (
+		v    any
+		opts jsonScriptOpts
+	)
+
+	switch len(args) {
+	case 1:
+		v = args[0]
+	case 2:
+		m, err := maps.ToStringMapE(args[0])
+		if err != nil {
+			return "", fmt.Errorf("options must be a map: %w", err)
+		}
+		if err := mapstructure.WeakDecode(m, &opts); err != nil {
+			return "", fmt.Errorf("failed to decode options: %w", err)
+		}
+		v = args[1]
+	default:
+		return "", fmt.Errorf("expected 1 or 2 arguments, got %d", len(args))
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	// This escapes <, > and &. The line and paragraph separators
+	// are always escaped.
+	enc.SetEscapeHTML(true)
+	enc.SetIndent(opts.Prefix, opts.Indent)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+
+	// The characters below can only appear inside JSON strings, where they
+	// are never preceded by an escaping backslash.
+	b := bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+	b = bytes.ReplaceAll(b, []byte("`"), []byte(`\u0060`))
+	b = bytes.ReplaceAll(b, []byte("${"), []byte(`\u0024{`))
+
+	return template.JS(b), nil
+}
+
+type jsonScriptOpts struct {
+	Prefix string
+	Indent string
+}
+
 // Markdownify renders s from Markdown to HTML.
 func (ns *Namespace) Markdownify(ctx context.Context, s any) (template.HTML, error) {
 	home := ns.deps.Site.Home()
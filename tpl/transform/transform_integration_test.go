@@ -14,6 +14,7 @@
 package transform_test
 
 import (
+	"strings"
 	"testing"
 
 	qt "github.com/frankban/quicktest"
@@ -133,3 +134,89 @@ Scar,"a "dead cat",11
 [[name description age] [Spot a nice dog 3] [Rover a big dog 5] [Felix a "malicious" cat 7] [Bella an "evil" cat 9] [Scar a "dead cat 11]]
 	`)
 }
+
+func TestMinify(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "page"]
+[minify.tdewolff.css]
+precision = 2
+-- assets/critical.css --
+body {
+	margin: 0;
+	width: 33.3333%;
+}
+-- layouts/index.html --
+<style>{{ resources.Get "critical.css" | transform.Minify }}</style>
+<style>{{ "a {  color: red;  }" | safeCSS | transform.Minify "css" }}</style>
+<script>{{ "var   a = 1 ;  var b = 2;" | safeJS | transform.Minify "text/javascript" }}</script>
+{{ $html := printf "<div>\n  <p>  %s  </p>\n</div>" "Hello" }}
+HTML: {{ $html | safeHTML | transform.Minify "html" }}|
+HTML string: {{ $html | transform.Minify "html" }}|
+JSON: {{ "{ \"a\":  1 }" | transform.Minify "json" }}|
+`
+
+	b := hugolib.Test(t, files)
+
+	b.AssertFileContent("public/index.html",
+		"<style>body{margin:0;width:33%}</style>",
+		"<style>a{color:red}</style>",
+		"<script>var a=1,b=2</script>",
+		"HTML: <div><p>Hello</p></div>|",
+		"HTML string: &lt;div&gt;&lt;p&gt;Hello&lt;/p&gt;&lt;/div&gt;|",
+		`JSON: {&#34;a&#34;:1}|`,
+	)
+
+	b, err := hugolib.TestE(t, `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "page"]
+-- layouts/index.html --
+{{ "foo" | transform.Minify "nosuchtype" }}
+`)
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `minify: unknown media type "nosuchtype"`)
+
+	b, err = hugolib.TestE(t, `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "page"]
+-- layouts/index.html --
+{{ transform.Minify "foo" }}
+`)
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `a media type is required`)
+}
+
+func TestJSONScript(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404", "section", "page"]
+-- layouts/index.html --
+{{ $data := dict "title" "</script><script>alert(1)</script>" "amp" "a & b" "seps" "a\u2028b\u2029c" "tmpl" "${x} and ` + "`" + `y` + "`" + `" "n" 42 }}
+<script type="application/json" id="data">{{ transform.JSONScript $data }}</script>
+<script type="application/ld+json">{{ transform.JSONScript (dict "name" "<b>") }}</script>
+<script>const data = {{ transform.JSONScript $data }};</script>
+<script>const indented = {{ transform.JSONScript (dict "indent" "  ") (dict "a" 1) }};</script>
+Attr: <div data-json="{{ transform.JSONScript (dict "a" "\"") }}"></div>
+`
+
+	b := hugolib.Test(t, files)
+
+	data := `{"amp":"a \u0026 b","n":42,"seps":"a\u2028b\u2029c","title":"\u003c/script\u003e\u003cscript\u003ealert(1)\u003c/script\u003e","tmpl":"\u0024{x} and \u0060y\u0060"}`
+
+	b.AssertFileContent("public/index.html",
+		`<script type="application/json" id="data">`+data+`</script>`,
+		`<script type="application/ld+json">{"name":"\u003cb\u003e"}</script>`,
+		`<script>const data = `+data+`;</script>`,
+		"<script>const indented = {\n  \"a\": 1\n};</script>",
+		`Attr: <div data-json="{&#34;a&#34;:&#34;\&#34;&#34;}"></div>`,
+	)
+	b.AssertFileContent("public/index.html", "! </script><script>alert(1)", "! a\u2028b")
+
+	_, err := hugolib.TestE(t, strings.ReplaceAll(files, `transform.JSONScript $data }}</script>`, `transform.JSONScript "a" "b" "c" }}</script>`))
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, "expected 1 or 2 arguments, got 3")
+}
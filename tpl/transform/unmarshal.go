@@ -35,6 +35,7 @@ import (
 // Unmarshal unmarshals the data given, which can be either a string, json.RawMessage
 // or a Resource. Supported formats are JSON, TOML, YAML, and CSV.
 // You can optionally provide an options map as the first argument.
+// Use the format or mediaType option to skip the format detection.
 func (ns *Namespace) Unmarshal(args ...any) (any, error) {
 	if len(args) < 1 || len(args) > 2 {
 		return nil, errors.New("unmarshal takes 1 or 2 arguments")
@@ -72,9 +73,12 @@ func (ns *Namespace) Unmarshal(args ...any) (any, error) {
 		}
 
 		v, err := ns.cache.GetOrCreate(key, func(string) (*resources.StaleValue[any], error) {
-			f := metadecoders.FormatFromStrings(r.MediaType().Suffixes()...)
+			f := decoder.Format
 			if f == "" {
-				return nil, fmt.Errorf("MIME %q not supported", r.MediaType())
+				f = metadecoders.FormatFromStrings(r.MediaType().Suffixes()...)
+				if f == "" {
+					return nil, fmt.Errorf("MIME %q not supported", r.MediaType())
+				}
 			}
 
 			reader, err := r.ReadSeekCloser()
@@ -118,11 +122,17 @@ func (ns *Namespace) Unmarshal(args ...any) (any, error) {
 	}
 
 	key := helpers.MD5String(dataStr)
+	if decoder != metadecoders.Default {
+		key += decoder.OptionsKey()
+	}
 
 	v, err := ns.cache.GetOrCreate(key, func(string) (*resources.StaleValue[any], error) {
-		f := decoder.FormatFromContentString(dataStr)
+		f := decoder.Format
 		if f == "" {
-			return nil, errors.New("unknown format")
+			f = decoder.FormatFromContentString(dataStr)
+			if f == "" {
+				return nil, errors.New("unknown format")
+			}
 		}
 
 		v, err := decoder.Unmarshal([]byte(dataStr), f)
@@ -169,6 +179,22 @@ func decodeDecoder(m map[string]any) (metadecoders.Decoder, error) {
 			}
 			opts.Comment = r
 			delete(m, k)
+		} else if strings.EqualFold(k, "Format") || strings.EqualFold(k, "MediaType") {
+			s, err := cast.ToStringE(v)
+			if err != nil {
+				return opts, err
+			}
+			var f metadecoders.Format
+			if strings.EqualFold(k, "Format") {
+				f = metadecoders.FormatFromString(s)
+			} else {
+				f = metadecoders.FormatFromMediaType(s)
+			}
+			if f == "" {
+				return opts, fmt.Errorf("unsupported %s %q", strings.ToLower(k), s)
+			}
+			opts.Format = f
+			delete(m, k)
 		}
 	}
 
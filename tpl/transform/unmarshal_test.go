@@ -139,7 +139,23 @@ func TestUnmarshal(t *testing.T) {
 a;b;c`, mime: media.Builtin.CSVType}, map[string]any{"DElimiter": ";", "Comment": "%"}, func(r [][]string) {
 			b.Assert([][]string{{"a", "b", "c"}}, qt.DeepEquals, r)
 		}},
+		{testContentResource{key: "r1", content: `slogan = "Hugo Rocks!"`, mime: media.Builtin.TextType}, map[string]any{"format": "toml"}, func(m map[string]any) {
+			assertSlogan(m)
+		}},
+		{testContentResource{key: "r1", content: `slogan = "Hugo Rocks!"`, mime: media.Builtin.TextType}, map[string]any{"mediaType": "application/toml"}, func(m map[string]any) {
+			assertSlogan(m)
+		}},
+		{testContentResource{key: "r1", content: `{ "slogan": "Hugo Rocks!" }`, mime: media.Builtin.TOMLType}, map[string]any{"format": "json"}, func(m map[string]any) {
+			assertSlogan(m)
+		}},
+		{`slogan: "Hugo Rocks!"`, map[string]any{"format": "yaml"}, func(m map[string]any) {
+			assertSlogan(m)
+		}},
 		// errors
+		{testContentResource{key: "r1", content: `slogan = "Hugo Rocks!"`, mime: media.Builtin.TextType}, nil, false},
+		{testContentResource{key: "r1", content: `slogan = "Hugo Rocks!"`, mime: media.Builtin.TextType}, map[string]any{"format": "foo"}, false},
+		{testContentResource{key: "r1", content: `slogan = "Hugo Rocks!"`, mime: media.Builtin.TextType}, map[string]any{"mediaType": "text/plain"}, false},
+		{`a,b,c`, map[string]any{"format": "json"}, false},
 		{"thisisnotavaliddataformat", nil, false},
 		{testContentResource{key: "r1", content: `invalid&toml"`, mime: media.Builtin.TOMLType}, nil, false},
 		{testContentResource{key: "r1", content: `unsupported: MIME"`, mime: media.Builtin.CalendarType}, nil, false},
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/gohugoio/hugo/common/urls"
 	"github.com/gohugoio/hugo/deps"
@@ -186,8 +187,12 @@ func (ns *Namespace) AbsLangURL(s any) (string, error) {
 }
 
 // JoinPath joins the provided elements into a URL string and cleans the result
-// of any ./ or ../ elements. If the argument list is empty, JoinPath returns
-// an empty string.
+// of any ./ or ../ elements and duplicate slashes. If the argument list is empty,
+// JoinPath returns an empty string.
+//
+// The first element is the base and may be a URL with a query and a fragment,
+// which are preserved. A later element starting with a slash resets the path of
+// the base, and a later element that is an absolute URL replaces the base.
 func (ns *Namespace) JoinPath(elements ...any) (string, error) {
 	if len(elements) == 0 {
 		return "", nil
@@ -215,9 +220,32 @@ func (ns *Namespace) JoinPath(elements ...any) (string, error) {
 		}
 	}
 
-	result, err := url.JoinPath(selements[0], selements[1:]...)
+	base, elems := selements[0], selements[1:]
+	resetPath := false
+	for i := len(elems) - 1; i >= 0; i-- {
+		if isAbsURL(elems[i]) {
+			base, elems = elems[i], elems[i+1:]
+			break
+		}
+		if strings.HasPrefix(elems[i], "/") {
+			elems = elems[i:]
+			resetPath = true
+			break
+		}
+	}
+
+	u, err := url.Parse(base)
 	if err != nil {
 		return "", err
 	}
-	return result, nil
+	if resetPath {
+		u.Path, u.RawPath = "/", ""
+	}
+
+	return u.JoinPath(elems...).String(), nil
+}
+
+// isAbsURL reports whether s is an absolute or protocol-relative URL.
+func isAbsURL(s string) bool {
+	return strings.HasPrefix(s, "//") || strings.Contains(s, "://")
 }
@@ -86,12 +86,30 @@ func TestJoinPath(t *testing.T) {
 		{[]any{""}, `/`},
 		{[]any{"a"}, `a`},
 		{[]any{"/a", "b"}, `/a/b`},
-		{[]any{".", "..", "/a", "b"}, `a/b`},
+		{[]any{".", "..", "a", "b"}, `a/b`},
 		{[]any{"https://example.org", "a"}, `https://example.org/a`},
 		{[]any{nil}, `/`},
+		// Slashes.
+		{[]any{"https://example.org/", "/a/", "/b"}, `https://example.org/b`},
+		{[]any{"https://example.org/", "a/", "b/"}, `https://example.org/a/b/`},
+		{[]any{"https://example.org//a//", "b//c"}, `https://example.org/a/b/c`},
+		{[]any{"a/", "", "b"}, `a/b`},
+		{[]any{"a", "b", ""}, `a/b`},
+		{[]any{"//example.org/a", "b"}, `//example.org/a/b`},
+		// Absolute segments.
+		{[]any{".", "..", "/a", "b"}, `/a/b`},
+		{[]any{"a", "/b", "c"}, `/b/c`},
+		{[]any{"https://example.org/docs/", "/a", "b/"}, `https://example.org/a/b/`},
+		{[]any{"https://example.org/docs/", "a", "https://cdn.example.org/x", "y"}, `https://cdn.example.org/x/y`},
+		{[]any{"https://example.org/docs/", "//cdn.example.org", "y"}, `//cdn.example.org/y`},
+		// Query and fragment on the base.
+		{[]any{"https://example.org/a?b=c#d", "e"}, `https://example.org/a/e?b=c#d`},
+		{[]any{"https://example.org/a?b=c#d", "/e", "f"}, `https://example.org/e/f?b=c#d`},
+		{[]any{"/a?b=c", "d/"}, `/a/d/?b=c`},
 		// errors
 		{tstNoStringer{}, false},
 		{[]any{tstNoStringer{}}, false},
+		{[]any{"https://example.org/%zz", "a"}, false},
 	} {
 
 		result, err := ns.JoinPath(test.elements)
@@ -19,18 +19,22 @@ var ar = newAbsURLReplacer()
 
 // NewAbsURLTransformer replaces relative URLs with absolute ones
 // in HTML files, using the baseURL setting.
-func NewAbsURLTransformer(path string) transform.Transformer {
+// If basePath is set, e.g. "/blog", it will be replaced with path in
+// relative URLs starting with it.
+func NewAbsURLTransformer(path, basePath string) transform.Transformer {
 	return func(ft transform.FromTo) error {
-		ar.replaceInHTML(path, ft)
+		ar.replaceInHTML(path, basePath, ft)
 		return nil
 	}
 }
 
 // NewAbsURLInXMLTransformer replaces relative URLs with absolute ones
 // in XML files, using the baseURL setting.
-func NewAbsURLInXMLTransformer(path string) transform.Transformer {
+// If basePath is set, e.g. "/blog", it will be replaced with path in
+// relative URLs starting with it.
+func NewAbsURLInXMLTransformer(path, basePath string) transform.Transformer {
 	return func(ft transform.FromTo) error {
-		ar.replaceInXML(path, ft)
+		ar.replaceInXML(path, basePath, ft)
 		return nil
 	}
 }
@@ -16,6 +16,7 @@ package urlreplacers
 import (
 	"bytes"
 	"io"
+	"strings"
 	"unicode"
 	"unicode/utf8"
 
@@ -31,6 +32,11 @@ type absurllexer struct {
 	// path may be set to a "." relative path
 	path []byte
 
+	// basePath is the path element in baseURL with a trailing slash,
+	// e.g. "/blog/", if any. It is replaced with path in URLs starting
+	// with it, to avoid repeating it.
+	basePath []byte
+
 	pos   int // input position
 	start int // item start position
 
@@ -117,11 +123,20 @@ func checkCandidateBase(l *absurllexer) {
 	if l.pos > l.start {
 		l.emit()
 	}
-	l.pos += relURLPrefixLen
+	l.pos += l.relURLPrefixLen(l.content[l.pos:])
 	l.w.Write(l.path)
 	l.start = l.pos
 }
 
+// relURLPrefixLen returns the length of the prefix to replace in the
+// relative URL u, either the base path or a single slash.
+func (l *absurllexer) relURLPrefixLen(u []byte) int {
+	if len(l.basePath) > 0 && bytes.HasPrefix(u, l.basePath) {
+		return len(l.basePath)
+	}
+	return relURLPrefixLen
+}
+
 func (l *absurllexer) posAfterURL(q []byte) int {
 	if len(q) > 0 {
 		// look for end quote
@@ -174,7 +189,7 @@ func checkCandidateSrcset(l *absurllexer) {
 	for i, f := range fields {
 		if f[0] == '/' {
 			l.w.Write(l.path)
-			l.w.Write(f[1:])
+			l.w.Write(f[l.relURLPrefixLen(f):])
 
 		} else {
 			l.w.Write(f)
@@ -228,7 +243,7 @@ func (l *absurllexer) replace() {
 	}
 }
 
-func doReplace(path string, ct transform.FromTo, quotes [][]byte) {
+func doReplace(path, basePath string, ct transform.FromTo, quotes [][]byte) {
 	lexer := &absurllexer{
 		content: ct.From().Bytes(),
 		w:       ct.To(),
@@ -236,6 +251,10 @@ func doReplace(path string, ct transform.FromTo, quotes [][]byte) {
 		quotes:  quotes,
 	}
 
+	if basePath = strings.Trim(basePath, "/"); basePath != "" {
+		lexer.basePath = []byte("/" + basePath + "/")
+	}
+
 	lexer.replace()
 }
 
@@ -251,10 +270,10 @@ func newAbsURLReplacer() *absURLReplacer {
 	}
 }
 
-func (au *absURLReplacer) replaceInHTML(path string, ct transform.FromTo) {
-	doReplace(path, ct, au.htmlQuotes)
+func (au *absURLReplacer) replaceInHTML(path, basePath string, ct transform.FromTo) {
+	doReplace(path, basePath, ct, au.htmlQuotes)
 }
 
-func (au *absURLReplacer) replaceInXML(path string, ct transform.FromTo) {
-	doReplace(path, ct, au.xmlQuotes)
+func (au *absURLReplacer) replaceInXML(path, basePath string, ct transform.FromTo) {
+	doReplace(path, basePath, ct, au.xmlQuotes)
 }
@@ -123,7 +123,7 @@ var (
 )
 
 func BenchmarkAbsURL(b *testing.B) {
-	tr := transform.New(NewAbsURLTransformer(testBaseURL))
+	tr := transform.New(NewAbsURLTransformer(testBaseURL, ""))
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -132,7 +132,7 @@ func BenchmarkAbsURL(b *testing.B) {
 }
 
 func BenchmarkAbsURLSrcset(b *testing.B) {
-	tr := transform.New(NewAbsURLTransformer(testBaseURL))
+	tr := transform.New(NewAbsURLTransformer(testBaseURL, ""))
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -141,7 +141,7 @@ func BenchmarkAbsURLSrcset(b *testing.B) {
 }
 
 func BenchmarkXMLAbsURLSrcset(b *testing.B) {
-	tr := transform.New(NewAbsURLInXMLTransformer(testBaseURL))
+	tr := transform.New(NewAbsURLInXMLTransformer(testBaseURL, ""))
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -150,13 +150,13 @@ func BenchmarkXMLAbsURLSrcset(b *testing.B) {
 }
 
 func TestAbsURL(t *testing.T) {
-	tr := transform.New(NewAbsURLTransformer(testBaseURL))
+	tr := transform.New(NewAbsURLTransformer(testBaseURL, ""))
 
 	apply(t.Errorf, tr, absURLTests)
 }
 
 func TestAbsURLUnquoted(t *testing.T) {
-	tr := transform.New(NewAbsURLTransformer(testBaseURL))
+	tr := transform.New(NewAbsURLTransformer(testBaseURL, ""))
 
 	apply(t.Errorf, tr, []test{
 		{
@@ -171,25 +171,58 @@ func TestAbsURLUnquoted(t *testing.T) {
 }
 
 func TestRelativeURL(t *testing.T) {
-	tr := transform.New(NewAbsURLTransformer(helpers.GetDottedRelativePath(filepath.FromSlash("/post/sub/"))))
+	tr := transform.New(NewAbsURLTransformer(helpers.GetDottedRelativePath(filepath.FromSlash("/post/sub/")), ""))
 
 	applyWithPath(t.Errorf, tr, relurlTests)
 }
 
+func TestAbsURLBasePath(t *testing.T) {
+	tr := transform.New(NewAbsURLTransformer("http://base/blog/", "/blog"))
+
+	apply(t.Errorf, tr, []test{
+		{
+			content:  `<a href="/blog/">Home</a> <a href="/blog/p1/">P1</a> <a href="/p2/">P2</a> <a href="/blogger/">B</a>`,
+			expected: `<a href="http://base/blog/">Home</a> <a href="http://base/blog/p1/">P1</a> <a href="http://base/blog/p2/">P2</a> <a href="http://base/blog/blogger/">B</a>`,
+		},
+		{
+			content:  `<img srcset="/blog/a.jpg 1x, /b.jpg 2x">`,
+			expected: `<img srcset="http://base/blog/a.jpg 1x, http://base/blog/b.jpg 2x">`,
+		},
+	})
+
+	tr = transform.New(NewAbsURLTransformer(helpers.GetDottedRelativePath("/post/sub/"), "/blog/"))
+
+	apply(t.Errorf, tr, []test{
+		{
+			content:  `<a href="/blog/">Home</a> <a href="/blog/post/p1/">P1</a> <img srcset="/blog/a.jpg 1x">`,
+			expected: `<a href="../../">Home</a> <a href="../../post/p1/">P1</a> <img srcset="../../a.jpg 1x">`,
+		},
+	})
+
+	tr = transform.New(NewAbsURLInXMLTransformer("http://base/blog/", "/blog"))
+
+	apply(t.Errorf, tr, []test{
+		{
+			content:  `&lt;a href=&#34;/blog/p1/&#34;&gt;`,
+			expected: `&lt;a href=&#34;http://base/blog/p1/&#34;&gt;`,
+		},
+	})
+}
+
 func TestAbsURLSrcSet(t *testing.T) {
-	tr := transform.New(NewAbsURLTransformer(testBaseURL))
+	tr := transform.New(NewAbsURLTransformer(testBaseURL, ""))
 
 	apply(t.Errorf, tr, srcsetTests)
 }
 
 func TestAbsXMLURLSrcSet(t *testing.T) {
-	tr := transform.New(NewAbsURLInXMLTransformer(testBaseURL))
+	tr := transform.New(NewAbsURLInXMLTransformer(testBaseURL, ""))
 
 	apply(t.Errorf, tr, srcsetXMLTests)
 }
 
 func BenchmarkXMLAbsURL(b *testing.B) {
-	tr := transform.New(NewAbsURLInXMLTransformer(testBaseURL))
+	tr := transform.New(NewAbsURLInXMLTransformer(testBaseURL, ""))
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -198,7 +231,7 @@ func BenchmarkXMLAbsURL(b *testing.B) {
 }
 
 func TestXMLAbsURL(t *testing.T) {
-	tr := transform.New(NewAbsURLInXMLTransformer(testBaseURL))
+	tr := transform.New(NewAbsURLInXMLTransformer(testBaseURL, ""))
 	apply(t.Errorf, tr, xmlAbsURLTests)
 }
 